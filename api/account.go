@@ -7,6 +7,7 @@ import (
 
 	db "github.com/JaidenShall/simplebank/db/sqlc"
 	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
 	"github.com/lib/pq"
 
 	"github.com/gin-gonic/gin"
@@ -14,6 +15,7 @@ import (
 
 type createAccountRequest struct {
 	Currency string `json:"currency" binding:"required,currency"`
+	Type     string `json:"type" binding:"omitempty,oneof=checking savings"`
 }
 
 func (server *Server) createAccount(ctx *gin.Context) {
@@ -28,6 +30,10 @@ func (server *Server) createAccount(ctx *gin.Context) {
 		Owner:    authPayload.Username,
 		Currency: req.Currency,
 		Balance:  0,
+		Type:     util.AccountChecking,
+	}
+	if req.Type != "" {
+		arg.Type = req.Type
 	}
 
 	account, err := server.store.CreateAccount(ctx, arg)
@@ -78,8 +84,15 @@ func (server *Server) getAccount(ctx *gin.Context) {
 }
 
 type listAccountRequest struct {
-	PageID   int32 `form:"page_id" binding:"required,min=1"`
-	PageSize int32 `form:"page_size" binding:"required,min=5,max=10"`
+	PageSize  int32  `form:"page_size" binding:"omitempty,min=1,max=50"`
+	PageToken string `form:"page_token"`
+	Currency  string `form:"currency" binding:"omitempty,currency"`
+	OrderBy   string `form:"order_by" binding:"omitempty,oneof=created_at balance"`
+}
+
+type listAccountResponse struct {
+	Accounts      []db.Account `json:"accounts"`
+	NextPageToken string       `json:"next_page_token"`
 }
 
 func (server *Server) listAccounts(ctx *gin.Context) {
@@ -90,17 +103,27 @@ func (server *Server) listAccounts(ctx *gin.Context) {
 	}
 
 	authPayload := ctx.MustGet(authorizationPayloadKey).(*token.Payload)
-	arg := db.ListAccountsParams{
-		Owner:  authPayload.Username,
-		Limit:  req.PageSize,
-		Offset: (req.PageID - 1) * req.PageSize,
+	arg := db.ListAccountsPageParams{
+		Owner:     authPayload.Username,
+		Currency:  req.Currency,
+		OrderBy:   req.OrderBy,
+		PageSize:  req.PageSize,
+		PageToken: req.PageToken,
 	}
 
-	accounts, err := server.store.ListAccounts(ctx, arg)
+	result, err := server.store.ListAccountsPage(ctx, arg)
 	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			ctx.JSON(http.StatusBadRequest, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
 
-	ctx.JSON(http.StatusOK, accounts)
+	rsp := listAccountResponse{
+		Accounts:      result.Accounts,
+		NextPageToken: result.NextPageToken,
+	}
+	ctx.JSON(http.StatusOK, rsp)
 }
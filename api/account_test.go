@@ -161,6 +161,7 @@ func TestCreateAccountAPI(t *testing.T) {
 					Owner:    account.Owner,
 					Currency: account.Currency,
 					Balance:  0,
+					Type:     util.AccountChecking,
 				}
 
 				store.EXPECT().
@@ -173,6 +174,30 @@ func TestCreateAccountAPI(t *testing.T) {
 				requireBodyMatchAccount(t, recorder.Body, account)
 			},
 		},
+		{
+			name: "Savings",
+			body: gin.H{
+				"currency": account.Currency,
+				"type":     util.AccountSavings,
+			},
+			authUsername: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.CreateAccountParams{
+					Owner:    account.Owner,
+					Currency: account.Currency,
+					Balance:  0,
+					Type:     util.AccountSavings,
+				}
+
+				store.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(account, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+			},
+		},
 		{
 			name: "NoAuthorization",
 			body: gin.H{
@@ -219,6 +244,22 @@ func TestCreateAccountAPI(t *testing.T) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
 			},
 		},
+		{
+			name: "InvalidType",
+			body: gin.H{
+				"currency": account.Currency,
+				"type":     "invalid",
+			},
+			authUsername: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					CreateAccount(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
 	}
 
 	for i := range testCases {
@@ -259,9 +300,13 @@ func TestListAccountsAPI(t *testing.T) {
 		accounts[i] = randomAccount(user.Username)
 	}
 
+	nextPageToken := util.RandomString(32)
+
 	type Query struct {
-		pageID   int
-		pageSize int
+		pageSize  int
+		pageToken string
+		currency  string
+		orderBy   string
 	}
 
 	testCases := []struct {
@@ -274,55 +319,81 @@ func TestListAccountsAPI(t *testing.T) {
 		{
 			name: "OK",
 			query: Query{
-				pageID:   1,
 				pageSize: n,
 			},
 			authUsername: user.Username,
 			buildStubs: func(store *mockdb.MockStore) {
-				arg := db.ListAccountsParams{
-					Owner:  user.Username,
-					Limit:  int32(n),
-					Offset: 0,
+				arg := db.ListAccountsPageParams{
+					Owner:    user.Username,
+					PageSize: int32(n),
+				}
+
+				store.EXPECT().
+					ListAccountsPage(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ListAccountsPageResult{Accounts: accounts, NextPageToken: nextPageToken}, nil)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				requireBodyMatchAccounts(t, recorder.Body, accounts, nextPageToken)
+			},
+		},
+		{
+			name: "OKWithFilterAndOrder",
+			query: Query{
+				pageSize:  n,
+				pageToken: nextPageToken,
+				currency:  util.USD,
+				orderBy:   db.AccountOrderByBalance,
+			},
+			authUsername: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListAccountsPageParams{
+					Owner:     user.Username,
+					Currency:  util.USD,
+					OrderBy:   db.AccountOrderByBalance,
+					PageSize:  int32(n),
+					PageToken: nextPageToken,
 				}
 
 				store.EXPECT().
-					ListAccounts(gomock.Any(), gomock.Eq(arg)).
+					ListAccountsPage(gomock.Any(), gomock.Eq(arg)).
 					Times(1).
-					Return(accounts, nil)
+					Return(db.ListAccountsPageResult{Accounts: accounts}, nil)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusOK, recorder.Code)
-				requireBodyMatchAccounts(t, recorder.Body, accounts)
+				requireBodyMatchAccounts(t, recorder.Body, accounts, "")
 			},
 		},
 		{
 			name: "InternalError",
 			query: Query{
-				pageID:   1,
 				pageSize: n,
 			},
 			authUsername: user.Username,
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					ListAccounts(gomock.Any(), gomock.Any()).
+					ListAccountsPage(gomock.Any(), gomock.Any()).
 					Times(1).
-					Return([]db.Account{}, sql.ErrConnDone)
+					Return(db.ListAccountsPageResult{}, sql.ErrConnDone)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusInternalServerError, recorder.Code)
 			},
 		},
 		{
-			name: "InvalidPageID",
+			name: "InvalidPageToken",
 			query: Query{
-				pageID:   -1,
-				pageSize: n,
+				pageSize:  n,
+				pageToken: "invalid",
 			},
 			authUsername: user.Username,
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					ListAccounts(gomock.Any(), gomock.Any()).
-					Times(0)
+					ListAccountsPage(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ListAccountsPageResult{}, db.ErrInvalidPageToken)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
 				require.Equal(t, http.StatusBadRequest, recorder.Code)
@@ -331,13 +402,44 @@ func TestListAccountsAPI(t *testing.T) {
 		{
 			name: "InvalidPageSize",
 			query: Query{
-				pageID:   1,
 				pageSize: 100000,
 			},
 			authUsername: user.Username,
 			buildStubs: func(store *mockdb.MockStore) {
 				store.EXPECT().
-					ListAccounts(gomock.Any(), gomock.Any()).
+					ListAccountsPage(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidCurrency",
+			query: Query{
+				pageSize: n,
+				currency: "xyz",
+			},
+			authUsername: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountsPage(gomock.Any(), gomock.Any()).
+					Times(0)
+			},
+			checkResponse: func(recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name: "InvalidOrderBy",
+			query: Query{
+				pageSize: n,
+				orderBy:  "owner",
+			},
+			authUsername: user.Username,
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().
+					ListAccountsPage(gomock.Any(), gomock.Any()).
 					Times(0)
 			},
 			checkResponse: func(recorder *httptest.ResponseRecorder) {
@@ -365,8 +467,16 @@ func TestListAccountsAPI(t *testing.T) {
 
 			// Add query parameters to request URL
 			q := request.URL.Query()
-			q.Add("page_id", fmt.Sprintf("%d", tc.query.pageID))
 			q.Add("page_size", fmt.Sprintf("%d", tc.query.pageSize))
+			if tc.query.pageToken != "" {
+				q.Add("page_token", tc.query.pageToken)
+			}
+			if tc.query.currency != "" {
+				q.Add("currency", tc.query.currency)
+			}
+			if tc.query.orderBy != "" {
+				q.Add("order_by", tc.query.orderBy)
+			}
 			request.URL.RawQuery = q.Encode()
 
 			createAndSetAuthToken(t, request, server.tokenMaker, tc.authUsername)
@@ -396,12 +506,13 @@ func requireBodyMatchAccount(t *testing.T, body *bytes.Buffer, account db.Accoun
 	require.Equal(t, account, gotAccount)
 }
 
-func requireBodyMatchAccounts(t *testing.T, body *bytes.Buffer, accounts []db.Account) {
+func requireBodyMatchAccounts(t *testing.T, body *bytes.Buffer, accounts []db.Account, nextPageToken string) {
 	data, err := io.ReadAll(body)
 	require.NoError(t, err)
 
-	var gotAccounts []db.Account
-	err = json.Unmarshal(data, &gotAccounts)
+	var gotResponse listAccountResponse
+	err = json.Unmarshal(data, &gotResponse)
 	require.NoError(t, err)
-	require.Equal(t, accounts, gotAccounts)
+	require.Equal(t, accounts, gotResponse.Accounts)
+	require.Equal(t, nextPageToken, gotResponse.NextPageToken)
 }
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
 	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/require"
 )
@@ -20,7 +21,7 @@ func addAuthorization(
 	username string,
 	duration time.Duration,
 ) {
-	token, payload, err := tokenMaker.CreateToken(username, duration)
+	token, payload, err := tokenMaker.CreateToken(username, util.CustomerRole, duration)
 	require.NoError(t, err)
 	require.NotEmpty(t, payload)
 
@@ -47,10 +47,25 @@ func (server *Server) createTransfer(ctx *gin.Context) {
 		FromAccountID: req.FromAccountID,
 		ToAccountID:   req.ToAccountID,
 		Amount:        req.Amount,
+		NewPayeeLimit: db.NewPayeeLimitFromConfig(server.config),
 	}
 
 	result, err := server.store.TransferTx(ctx, arg)
 	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		var notActiveErr *db.AccountNotActiveError
+		if errors.As(err, &notActiveErr) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
+		var limitErr *db.SpendingLimitError
+		if errors.As(err, &limitErr) || errors.Is(err, db.ErrNewPayeeLimit) {
+			ctx.JSON(http.StatusForbidden, errorResponse(err))
+			return
+		}
 		ctx.JSON(http.StatusInternalServerError, errorResponse(err))
 		return
 	}
@@ -1,13 +1,13 @@
 package api
 
 import (
-	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/currency"
 	"github.com/go-playground/validator/v10"
 )
 
 var validCurrency validator.Func = func(fieldLevel validator.FieldLevel) bool {
-	if currency, ok := fieldLevel.Field().Interface().(string); ok {
-		return util.IsSupportedCurrency(currency)
+	if code, ok := fieldLevel.Field().Interface().(string); ok {
+		return currency.IsEnabled(code)
 	}
 	return false
 }
@@ -0,0 +1,139 @@
+// Package currency is a registry of the ISO 4217 currencies, of which only an
+// operator-chosen subset is enabled for accounts.
+package currency
+
+import (
+	_ "embed"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Currency describes an ISO 4217 currency
+type Currency struct {
+	Code    string
+	Numeric string
+	// Exponent is the number of minor-unit digits, e.g. 2 for USD and 0 for JPY
+	Exponent int
+	Name     string
+}
+
+// DefaultEnabled are the currencies enabled when the config doesn't choose any
+var DefaultEnabled = []string{"USD", "EUR", "CAD"}
+
+//go:embed iso4217.csv
+var iso4217CSV string
+
+// registry holds every known currency by code
+var registry = mustLoad(iso4217CSV)
+
+var (
+	enabledMu sync.RWMutex
+	enabled   = mustEnabledSet(DefaultEnabled)
+)
+
+// Lookup returns the ISO 4217 currency with the given code, enabled or not
+func Lookup(code string) (Currency, bool) {
+	currency, ok := registry[code]
+	return currency, ok
+}
+
+// All returns every ISO 4217 currency ordered by code
+func All() []Currency {
+	currencies := make([]Currency, 0, len(registry))
+	for _, currency := range registry {
+		currencies = append(currencies, currency)
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i].Code < currencies[j].Code
+	})
+	return currencies
+}
+
+// Enable replaces the set of enabled currencies. Codes that are not in ISO 4217 are rejected
+// and leave the enabled set unchanged. An empty list enables DefaultEnabled.
+func Enable(codes ...string) error {
+	if len(codes) == 0 {
+		codes = DefaultEnabled
+	}
+
+	set, err := enabledSet(codes)
+	if err != nil {
+		return err
+	}
+
+	enabledMu.Lock()
+	defer enabledMu.Unlock()
+	enabled = set
+	return nil
+}
+
+// IsEnabled returns true if accounts can be opened and used in the currency
+func IsEnabled(code string) bool {
+	enabledMu.RLock()
+	defer enabledMu.RUnlock()
+	_, ok := enabled[code]
+	return ok
+}
+
+// Enabled returns the enabled currencies ordered by code
+func Enabled() []Currency {
+	enabledMu.RLock()
+	defer enabledMu.RUnlock()
+
+	currencies := make([]Currency, 0, len(enabled))
+	for code := range enabled {
+		currencies = append(currencies, registry[code])
+	}
+	sort.Slice(currencies, func(i, j int) bool {
+		return currencies[i].Code < currencies[j].Code
+	})
+	return currencies
+}
+
+func enabledSet(codes []string) (map[string]struct{}, error) {
+	set := make(map[string]struct{}, len(codes))
+	for _, code := range codes {
+		code = strings.ToUpper(strings.TrimSpace(code))
+		if _, ok := registry[code]; !ok {
+			return nil, fmt.Errorf("unknown currency %q", code)
+		}
+		set[code] = struct{}{}
+	}
+	return set, nil
+}
+
+func mustEnabledSet(codes []string) map[string]struct{} {
+	set, err := enabledSet(codes)
+	if err != nil {
+		panic(err)
+	}
+	return set
+}
+
+func mustLoad(data string) map[string]Currency {
+	records, err := csv.NewReader(strings.NewReader(data)).ReadAll()
+	if err != nil {
+		panic(fmt.Sprintf("cannot read ISO 4217 data: %s", err))
+	}
+
+	currencies := make(map[string]Currency, len(records))
+	// the first record is the header
+	for _, record := range records[1:] {
+		exponent, err := strconv.Atoi(record[2])
+		if err != nil {
+			panic(fmt.Sprintf("invalid minor units for %s: %s", record[0], err))
+		}
+
+		currencies[record[0]] = Currency{
+			Code:     record[0],
+			Numeric:  record[1],
+			Exponent: exponent,
+			Name:     record[3],
+		}
+	}
+	return currencies
+}
@@ -0,0 +1,75 @@
+package currency
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLookup(t *testing.T) {
+	testCases := []struct {
+		code     string
+		numeric  string
+		exponent int
+	}{
+		{code: "USD", numeric: "840", exponent: 2},
+		{code: "JPY", numeric: "392", exponent: 0},
+		{code: "KWD", numeric: "414", exponent: 3},
+		{code: "CLF", numeric: "990", exponent: 4},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.code, func(t *testing.T) {
+			currency, ok := Lookup(tc.code)
+			require.True(t, ok)
+			require.Equal(t, tc.code, currency.Code)
+			require.Equal(t, tc.numeric, currency.Numeric)
+			require.Equal(t, tc.exponent, currency.Exponent)
+			require.NotEmpty(t, currency.Name)
+		})
+	}
+
+	_, ok := Lookup("XYZ")
+	require.False(t, ok)
+}
+
+func TestAll(t *testing.T) {
+	currencies := All()
+	require.Greater(t, len(currencies), 150)
+
+	numerics := make(map[string]string)
+	for i, currency := range currencies {
+		if i > 0 {
+			require.Less(t, currencies[i-1].Code, currency.Code)
+		}
+		require.Len(t, currency.Numeric, 3)
+		require.NotContains(t, numerics, currency.Numeric, "%s and %s share a numeric code", numerics[currency.Numeric], currency.Code)
+		numerics[currency.Numeric] = currency.Code
+	}
+}
+
+func TestEnable(t *testing.T) {
+	defer func() {
+		require.NoError(t, Enable())
+	}()
+
+	for _, code := range DefaultEnabled {
+		require.True(t, IsEnabled(code))
+	}
+	require.False(t, IsEnabled("JPY"))
+
+	require.NoError(t, Enable("JPY", " kwd "))
+	require.True(t, IsEnabled("JPY"))
+	require.True(t, IsEnabled("KWD"))
+	require.False(t, IsEnabled("USD"))
+	require.Len(t, Enabled(), 2)
+
+	// an unknown code leaves the enabled set as it was
+	require.Error(t, Enable("USD", "XYZ"))
+	require.True(t, IsEnabled("JPY"))
+	require.False(t, IsEnabled("USD"))
+
+	require.NoError(t, Enable())
+	require.True(t, IsEnabled("USD"))
+	require.False(t, IsEnabled("JPY"))
+}
@@ -0,0 +1,87 @@
+package currency
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Format turns an amount in minor units into a decimal string with exactly
+// c.Exponent decimal places, e.g. 1234 USD is "12.34" and 1234 JPY is "1234".
+func (c Currency) Format(amount int64) string {
+	sign := ""
+	magnitude := uint64(amount)
+	if amount < 0 {
+		sign = "-"
+		magnitude = uint64(-(amount + 1)) + 1 // math.MinInt64 has no positive counterpart
+	}
+
+	digits := strconv.FormatUint(magnitude, 10)
+	if c.Exponent == 0 {
+		return sign + digits
+	}
+
+	if len(digits) <= c.Exponent {
+		digits = strings.Repeat("0", c.Exponent-len(digits)+1) + digits
+	}
+	point := len(digits) - c.Exponent
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// Parse turns a decimal string into an amount in minor units. The value may have
+// fewer decimal places than c.Exponent but not more, so it is never rounded.
+func (c Currency) Parse(value string) (int64, error) {
+	negative := strings.HasPrefix(value, "-")
+	unsigned := strings.TrimPrefix(value, "-")
+
+	whole, fraction, hasPoint := strings.Cut(unsigned, ".")
+	if whole == "" || !isDigits(whole) || !isDigits(fraction) || (hasPoint && fraction == "") {
+		return 0, fmt.Errorf("invalid %s amount %q", c.Code, value)
+	}
+	if len(fraction) > c.Exponent {
+		return 0, fmt.Errorf("invalid %s amount %q: at most %d decimal places", c.Code, value, c.Exponent)
+	}
+
+	digits := whole + fraction + strings.Repeat("0", c.Exponent-len(fraction))
+	limit := uint64(math.MaxInt64)
+	if negative {
+		limit++
+	}
+	magnitude, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil || magnitude > limit {
+		return 0, fmt.Errorf("invalid %s amount %q: out of range", c.Code, value)
+	}
+
+	if negative {
+		return int64(-magnitude), nil
+	}
+	return int64(magnitude), nil
+}
+
+// Format formats an amount in minor units of the currency with the given code
+func Format(amount int64, code string) (string, error) {
+	currency, ok := Lookup(code)
+	if !ok {
+		return "", fmt.Errorf("unknown currency %q", code)
+	}
+	return currency.Format(amount), nil
+}
+
+// Parse parses a decimal amount in the currency with the given code into minor units
+func Parse(value string, code string) (int64, error) {
+	currency, ok := Lookup(code)
+	if !ok {
+		return 0, fmt.Errorf("unknown currency %q", code)
+	}
+	return currency.Parse(value)
+}
+
+func isDigits(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,74 @@
+package currency
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormat(t *testing.T) {
+	testCases := []struct {
+		code   string
+		amount int64
+		want   string
+	}{
+		{code: "USD", amount: 1234, want: "12.34"},
+		{code: "USD", amount: 5, want: "0.05"},
+		{code: "USD", amount: 0, want: "0.00"},
+		{code: "USD", amount: -1205, want: "-12.05"},
+		{code: "JPY", amount: 1234, want: "1234"},
+		{code: "JPY", amount: -7, want: "-7"},
+		{code: "KWD", amount: 1234, want: "1.234"},
+		{code: "KWD", amount: 12, want: "0.012"},
+		{code: "USD", amount: math.MinInt64, want: "-92233720368547758.08"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.want, func(t *testing.T) {
+			formatted, err := Format(tc.amount, tc.code)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, formatted)
+
+			parsed, err := Parse(formatted, tc.code)
+			require.NoError(t, err)
+			require.Equal(t, tc.amount, parsed)
+		})
+	}
+
+	_, err := Format(100, "XYZ")
+	require.Error(t, err)
+}
+
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		code  string
+		value string
+		want  int64
+		ok    bool
+	}{
+		{code: "USD", value: "12", want: 1200, ok: true},
+		{code: "USD", value: "12.3", want: 1230, ok: true},
+		{code: "KWD", value: "0.5", want: 500, ok: true},
+		{code: "JPY", value: "500", want: 500, ok: true},
+		{code: "USD", value: "12.345"},
+		{code: "JPY", value: "1.5"},
+		{code: "USD", value: "12."},
+		{code: "USD", value: ".5"},
+		{code: "USD", value: "1,000"},
+		{code: "USD", value: "-"},
+		{code: "USD", value: "92233720368547758.08"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.code+" "+tc.value, func(t *testing.T) {
+			amount, err := Parse(tc.value, tc.code)
+			if !tc.ok {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.want, amount)
+		})
+	}
+}
@@ -0,0 +1,9 @@
+DROP TABLE IF EXISTS "audit_logs";
+
+ALTER TABLE IF EXISTS "accounts" DROP CONSTRAINT IF EXISTS "status_valid";
+
+ALTER TABLE IF EXISTS "accounts" DROP COLUMN IF EXISTS "status";
+
+ALTER TABLE IF EXISTS "users" DROP CONSTRAINT IF EXISTS "role_valid";
+
+ALTER TABLE IF EXISTS "users" DROP COLUMN IF EXISTS "role";
@@ -0,0 +1,32 @@
+ALTER TABLE "users" ADD COLUMN "role" varchar NOT NULL DEFAULT 'customer';
+
+ALTER TABLE "users" ADD CONSTRAINT "role_valid" CHECK ("role" IN ('customer', 'support', 'admin'));
+
+ALTER TABLE "accounts" ADD COLUMN "status" varchar NOT NULL DEFAULT 'active';
+
+ALTER TABLE "accounts" ADD CONSTRAINT "status_valid" CHECK ("status" IN ('active', 'frozen'));
+
+CREATE TABLE "audit_logs" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "target" varchar NOT NULL,
+  "details" jsonb NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "audit_logs" ("actor");
+
+CREATE INDEX ON "audit_logs" ("target");
+
+ALTER TABLE "audit_logs" ADD FOREIGN KEY ("actor") REFERENCES "users" ("username");
+
+COMMENT ON COLUMN "users"."role" IS 'customer, support or admin';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active or frozen';
+
+COMMENT ON COLUMN "audit_logs"."actor" IS 'username of the support or admin user who performed the action';
+
+COMMENT ON COLUMN "audit_logs"."target" IS 'what the action was performed on, such as account:42 or user:alice';
+
+COMMENT ON COLUMN "audit_logs"."details" IS 'the request that triggered the action';
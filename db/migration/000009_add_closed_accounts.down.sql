@@ -0,0 +1,23 @@
+-- closed accounts become frozen, and the frozen accounts can only keep one per owner and currency
+DO $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM "accounts"
+    GROUP BY "owner", "currency"
+    HAVING count(*) > 1
+  ) THEN
+    RAISE EXCEPTION 'some owners have closed an account and opened another one in the same currency, those accounts must be merged before migrating down';
+  END IF;
+END $$;
+
+UPDATE "accounts" SET "status" = 'frozen' WHERE "status" = 'closed';
+
+DROP INDEX IF EXISTS "owner_currency_key";
+
+ALTER TABLE "accounts" ADD CONSTRAINT "owner_currency_key" UNIQUE ("owner", "currency");
+
+ALTER TABLE "accounts" DROP CONSTRAINT IF EXISTS "status_valid";
+
+ALTER TABLE "accounts" ADD CONSTRAINT "status_valid" CHECK ("status" IN ('active', 'frozen'));
+
+COMMENT ON COLUMN "accounts"."status" IS 'active or frozen';
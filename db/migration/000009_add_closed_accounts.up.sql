@@ -0,0 +1,10 @@
+ALTER TABLE "accounts" DROP CONSTRAINT "status_valid";
+
+ALTER TABLE "accounts" ADD CONSTRAINT "status_valid" CHECK ("status" IN ('active', 'frozen', 'closed'));
+
+-- a closed account no longer keeps its owner from opening a new one in the same currency
+ALTER TABLE "accounts" DROP CONSTRAINT "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "status" <> 'closed';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active, frozen or closed; only active accounts can send or receive money';
@@ -0,0 +1,53 @@
+CREATE TABLE "fx_rates" (
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "rate" bigint NOT NULL,
+  "updated_by" varchar NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("from_currency", "to_currency")
+);
+
+CREATE TABLE "fx_quotes" (
+  "id" uuid PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "rate" bigint NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "used_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "fx_rates" ADD CONSTRAINT "rate_positive" CHECK ("rate" > 0);
+
+ALTER TABLE "fx_rates" ADD CONSTRAINT "currencies_differ" CHECK ("from_currency" <> "to_currency");
+
+ALTER TABLE "transfers" ADD COLUMN "to_amount" bigint;
+
+UPDATE "transfers" SET "to_amount" = "amount";
+
+ALTER TABLE "transfers" ALTER COLUMN "to_amount" SET NOT NULL;
+
+ALTER TABLE "transfers" ADD COLUMN "fx_rate" bigint;
+
+ALTER TABLE "transfers" ADD COLUMN "fx_quote_id" uuid;
+
+CREATE INDEX ON "fx_quotes" ("username");
+
+CREATE UNIQUE INDEX ON "transfers" ("fx_quote_id");
+
+ALTER TABLE "fx_rates" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "fx_quotes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("fx_quote_id") REFERENCES "fx_quotes" ("id");
+
+COMMENT ON COLUMN "fx_rates"."rate" IS 'units of to_currency per unit of from_currency, scaled by 10^8';
+
+COMMENT ON COLUMN "fx_quotes"."rate" IS 'the fx_rates rate at the time of the quote';
+
+COMMENT ON COLUMN "fx_quotes"."used_at" IS 'set once a transfer has been made with the quote';
+
+COMMENT ON COLUMN "transfers"."to_amount" IS 'amount credited to the destination account, differs from amount only for fx transfers';
+
+COMMENT ON COLUMN "transfers"."fx_rate" IS 'rate applied to amount to get to_amount, scaled by 10^8';
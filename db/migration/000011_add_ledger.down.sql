@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS "postings";
+
+DROP TABLE IF EXISTS "journals";
+
+DROP TABLE IF EXISTS "system_accounts";
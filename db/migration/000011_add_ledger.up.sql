@@ -0,0 +1,73 @@
+CREATE TABLE "system_accounts" (
+  "id" bigserial PRIMARY KEY,
+  "code" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "journals" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "postings" (
+  "id" bigserial PRIMARY KEY,
+  "journal_id" bigint NOT NULL,
+  "account_id" bigint,
+  "system_account_id" bigint,
+  "currency" varchar NOT NULL,
+  "amount" bigint NOT NULL
+);
+
+ALTER TABLE "system_accounts" ADD CONSTRAINT "code_valid" CHECK ("code" IN ('cash_vault', 'fees_income', 'fx_position', 'suspense'));
+
+ALTER TABLE "postings" ADD CONSTRAINT "one_account" CHECK (("account_id" IS NULL) <> ("system_account_id" IS NULL));
+
+ALTER TABLE "postings" ADD CONSTRAINT "amount_not_zero" CHECK ("amount" <> 0);
+
+CREATE UNIQUE INDEX ON "system_accounts" ("code", "currency");
+
+CREATE INDEX ON "journals" ("transfer_id");
+
+CREATE INDEX ON "postings" ("journal_id");
+
+CREATE INDEX ON "postings" ("account_id");
+
+CREATE INDEX ON "postings" ("system_account_id");
+
+ALTER TABLE "journals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "postings" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+ALTER TABLE "postings" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "postings" ADD FOREIGN KEY ("system_account_id") REFERENCES "system_accounts" ("id");
+
+COMMENT ON COLUMN "system_accounts"."code" IS 'cash_vault, fees_income, fx_position or suspense';
+
+COMMENT ON COLUMN "journals"."kind" IS 'what the journal records, such as deposit, withdrawal or transfer';
+
+COMMENT ON COLUMN "postings"."account_id" IS 'set for customer accounts, exactly one of account_id and system_account_id is set';
+
+COMMENT ON COLUMN "postings"."amount" IS 'added to the account balance; the postings of a journal sum to zero per currency';
+
+-- existing balances were never posted, so book them against suspense in an opening journal
+WITH "opening" AS (
+  INSERT INTO "journals" ("kind") VALUES ('opening_balance') RETURNING "id"
+)
+INSERT INTO "postings" ("journal_id", "account_id", "currency", "amount")
+SELECT "opening"."id", "accounts"."id", "accounts"."currency", "accounts"."balance"
+FROM "opening", "accounts"
+WHERE "accounts"."balance" <> 0;
+
+INSERT INTO "system_accounts" ("code", "currency")
+SELECT DISTINCT 'suspense', "currency" FROM "postings";
+
+INSERT INTO "postings" ("journal_id", "system_account_id", "currency", "amount")
+SELECT "postings"."journal_id", "system_accounts"."id", "postings"."currency", -sum("postings"."amount")
+FROM "postings"
+JOIN "system_accounts" ON "system_accounts"."code" = 'suspense' AND "system_accounts"."currency" = "postings"."currency"
+GROUP BY "postings"."journal_id", "system_accounts"."id", "postings"."currency"
+HAVING sum("postings"."amount") <> 0;
@@ -0,0 +1,33 @@
+CREATE TABLE "holds" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "captured_amount" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "entry_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "settled_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "holds" ADD CONSTRAINT "amount_positive" CHECK ("amount" > 0);
+
+ALTER TABLE "holds" ADD CONSTRAINT "captured_amount_valid" CHECK ("captured_amount" >= 0 AND "captured_amount" <= "amount");
+
+ALTER TABLE "holds" ADD CONSTRAINT "status_valid" CHECK ("status" IN ('active', 'captured', 'released', 'expired'));
+
+CREATE INDEX ON "holds" ("account_id", "status");
+
+CREATE INDEX ON "holds" ("expires_at") WHERE "status" = 'active';
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+COMMENT ON COLUMN "holds"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "holds"."captured_amount" IS 'part of amount that was settled, the rest went back to the available balance';
+
+COMMENT ON COLUMN "holds"."status" IS 'active, captured, released or expired; only active holds reduce the available balance';
+
+COMMENT ON COLUMN "holds"."entry_id" IS 'the entry that debited the account when the hold was captured';
@@ -0,0 +1,84 @@
+CREATE TABLE "scheduled_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "frequency" varchar NOT NULL,
+  "interval_count" int NOT NULL DEFAULT 1,
+  "day_of_month" int NOT NULL DEFAULT 0,
+  "start_at" timestamptz NOT NULL,
+  "end_at" timestamptz,
+  "next_occurrence_at" timestamptz,
+  "next_run_at" timestamptz,
+  "attempts" int NOT NULL DEFAULT 0,
+  "failure_policy" varchar NOT NULL DEFAULT 'skip',
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfer_runs" (
+  "id" bigserial PRIMARY KEY,
+  "scheduled_transfer_id" bigint NOT NULL,
+  "occurrence_at" timestamptz NOT NULL,
+  "attempt" int NOT NULL,
+  "status" varchar NOT NULL,
+  "transfer_id" bigint,
+  "error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "amount_positive" CHECK ("amount" > 0);
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "accounts_differ" CHECK ("from_account_id" <> "to_account_id");
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "frequency_valid" CHECK ("frequency" IN ('once', 'daily', 'weekly', 'monthly'));
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "interval_positive" CHECK ("interval_count" > 0);
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "day_of_month_valid" CHECK ("day_of_month" BETWEEN -1 AND 31);
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "failure_policy_valid" CHECK ("failure_policy" IN ('skip', 'retry'));
+
+ALTER TABLE "scheduled_transfers" ADD CONSTRAINT "status_valid" CHECK ("status" IN ('active', 'paused', 'completed', 'cancelled'));
+
+ALTER TABLE "scheduled_transfer_runs" ADD CONSTRAINT "status_valid" CHECK ("status" IN ('succeeded', 'retrying', 'skipped', 'failed'));
+
+CREATE INDEX ON "scheduled_transfers" ("owner");
+
+CREATE INDEX ON "scheduled_transfers" ("next_run_at") WHERE "status" = 'active';
+
+CREATE INDEX ON "scheduled_transfer_runs" ("scheduled_transfer_id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfer_runs" ADD FOREIGN KEY ("scheduled_transfer_id") REFERENCES "scheduled_transfers" ("id");
+
+ALTER TABLE "scheduled_transfer_runs" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+COMMENT ON COLUMN "scheduled_transfers"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "scheduled_transfers"."frequency" IS 'once, daily, weekly or monthly';
+
+COMMENT ON COLUMN "scheduled_transfers"."interval_count" IS 'number of days, weeks or months between occurrences';
+
+COMMENT ON COLUMN "scheduled_transfers"."day_of_month" IS 'day monthly transfers run on, -1 for the last day of the month, 0 otherwise';
+
+COMMENT ON COLUMN "scheduled_transfers"."next_occurrence_at" IS 'the occurrence the next run is for, null once the schedule has ended';
+
+COMMENT ON COLUMN "scheduled_transfers"."next_run_at" IS 'when the next run is due, later than next_occurrence_at while retrying';
+
+COMMENT ON COLUMN "scheduled_transfers"."attempts" IS 'failed runs of the next occurrence';
+
+COMMENT ON COLUMN "scheduled_transfers"."failure_policy" IS 'skip or retry an occurrence that fails for insufficient funds or an inactive account';
+
+COMMENT ON COLUMN "scheduled_transfers"."status" IS 'active, paused, completed or cancelled';
+
+COMMENT ON COLUMN "scheduled_transfer_runs"."status" IS 'succeeded, retrying, skipped or failed';
+
+COMMENT ON COLUMN "scheduled_transfer_runs"."error" IS 'why the transfer could not be made';
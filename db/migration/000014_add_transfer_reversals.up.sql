@@ -0,0 +1,13 @@
+ALTER TABLE "transfers" ADD COLUMN "reversed_transfer_id" bigint;
+
+ALTER TABLE "transfers" ADD COLUMN "refunded_amount" bigint NOT NULL DEFAULT 0;
+
+ALTER TABLE "transfers" ADD CONSTRAINT "refunded_amount_valid" CHECK ("refunded_amount" >= 0 AND "refunded_amount" <= "to_amount");
+
+CREATE INDEX ON "transfers" ("reversed_transfer_id");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("reversed_transfer_id") REFERENCES "transfers" ("id");
+
+COMMENT ON COLUMN "transfers"."reversed_transfer_id" IS 'the transfer this one refunds or reverses';
+
+COMMENT ON COLUMN "transfers"."refunded_amount" IS 'part of to_amount sent back by refunds and reversals so far';
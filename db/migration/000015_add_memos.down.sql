@@ -0,0 +1,11 @@
+ALTER TABLE IF EXISTS "transfers" DROP COLUMN IF EXISTS "category";
+
+ALTER TABLE IF EXISTS "transfers" DROP COLUMN IF EXISTS "reference";
+
+ALTER TABLE IF EXISTS "transfers" DROP COLUMN IF EXISTS "memo";
+
+ALTER TABLE IF EXISTS "entries" DROP COLUMN IF EXISTS "category";
+
+ALTER TABLE IF EXISTS "entries" DROP COLUMN IF EXISTS "reference";
+
+ALTER TABLE IF EXISTS "entries" DROP COLUMN IF EXISTS "memo";
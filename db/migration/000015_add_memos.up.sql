@@ -0,0 +1,31 @@
+ALTER TABLE "entries" ADD COLUMN "memo" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "entries" ADD COLUMN "reference" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "entries" ADD COLUMN "category" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "memo" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "reference" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "transfers" ADD COLUMN "category" varchar NOT NULL DEFAULT '';
+
+CREATE INDEX ON "entries" USING GIN (to_tsvector('simple', "memo"));
+
+CREATE INDEX ON "entries" ("reference");
+
+CREATE INDEX ON "transfers" USING GIN (to_tsvector('simple', "memo"));
+
+CREATE INDEX ON "transfers" ("reference");
+
+COMMENT ON COLUMN "entries"."memo" IS 'free text shown on statements, searchable';
+
+COMMENT ON COLUMN "entries"."reference" IS 'external reference such as an invoice number';
+
+COMMENT ON COLUMN "entries"."category" IS 'category assigned by the account owner, transfers only set it on the debited entry';
+
+COMMENT ON COLUMN "transfers"."memo" IS 'free text shown on statements, searchable';
+
+COMMENT ON COLUMN "transfers"."reference" IS 'external reference such as an invoice number';
+
+COMMENT ON COLUMN "transfers"."category" IS 'category assigned by the payer';
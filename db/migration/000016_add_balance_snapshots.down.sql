@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS "balance_discrepancies";
+
+DROP TABLE IF EXISTS "balance_snapshots";
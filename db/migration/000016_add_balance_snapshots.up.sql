@@ -0,0 +1,39 @@
+CREATE TABLE "balance_snapshots" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "snapshot_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "entry_sum" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "balance_discrepancies" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "snapshot_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "entry_sum" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "balance_snapshots" ("account_id", "snapshot_date");
+
+CREATE INDEX ON "balance_snapshots" ("snapshot_date");
+
+CREATE UNIQUE INDEX ON "balance_discrepancies" ("account_id", "snapshot_date");
+
+CREATE INDEX ON "balance_discrepancies" ("snapshot_date");
+
+ALTER TABLE "balance_snapshots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "balance_discrepancies" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+COMMENT ON COLUMN "balance_snapshots"."snapshot_date" IS 'the UTC day the snapshot closes';
+
+COMMENT ON COLUMN "balance_snapshots"."balance" IS 'accounts.balance at the end of the day';
+
+COMMENT ON COLUMN "balance_snapshots"."entry_sum" IS 'sum of the entries of the account created up to the end of the day';
+
+COMMENT ON COLUMN "balance_discrepancies"."balance" IS 'accounts.balance at the end of the day';
+
+COMMENT ON COLUMN "balance_discrepancies"."entry_sum" IS 'sum of the entries of the account, different from balance';
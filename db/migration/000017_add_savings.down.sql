@@ -0,0 +1,31 @@
+-- without the account type an owner can only have one open account per currency,
+-- and the interest expense account has to go with the interest
+DO $$
+BEGIN
+  IF EXISTS (
+    SELECT 1 FROM "accounts"
+    WHERE "status" <> 'closed'
+    GROUP BY "owner", "currency"
+    HAVING count(*) > 1
+  ) THEN
+    RAISE EXCEPTION 'some owners have a checking and a savings account open in the same currency, close one of them before migrating down';
+  END IF;
+
+  IF EXISTS (SELECT 1 FROM "system_accounts" WHERE "code" = 'interest_expense') THEN
+    RAISE EXCEPTION 'interest has been paid from the interest_expense system account, its postings must be kept';
+  END IF;
+END $$;
+
+DROP TABLE IF EXISTS "interest_accruals";
+
+ALTER TABLE "system_accounts" DROP CONSTRAINT IF EXISTS "code_valid";
+
+ALTER TABLE "system_accounts" ADD CONSTRAINT "code_valid" CHECK ("code" IN ('cash_vault', 'fees_income', 'fx_position', 'suspense'));
+
+COMMENT ON COLUMN "system_accounts"."code" IS 'cash_vault, fees_income, fx_position or suspense';
+
+DROP INDEX IF EXISTS "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency") WHERE "status" <> 'closed';
+
+ALTER TABLE "accounts" DROP COLUMN IF EXISTS "type";
@@ -0,0 +1,49 @@
+ALTER TABLE "accounts" ADD COLUMN "type" varchar NOT NULL DEFAULT 'checking';
+
+ALTER TABLE "accounts" ADD CONSTRAINT "type_valid" CHECK ("type" IN ('checking', 'savings'));
+
+-- an owner can keep a checking and a savings account in the same currency
+DROP INDEX "owner_currency_key";
+
+CREATE UNIQUE INDEX "owner_currency_key" ON "accounts" ("owner", "currency", "type") WHERE "status" <> 'closed';
+
+COMMENT ON COLUMN "accounts"."type" IS 'checking or savings; savings accounts earn interest';
+
+CREATE TABLE "interest_accruals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "rate" int NOT NULL,
+  "amount" bigint NOT NULL,
+  "remainder" bigint NOT NULL,
+  "entry_id" bigint,
+  "capitalized_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+CREATE INDEX ON "interest_accruals" ("accrual_date") WHERE "capitalized_at" IS NULL;
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+COMMENT ON COLUMN "interest_accruals"."balance" IS 'balance of the daily snapshot the interest is computed on';
+
+COMMENT ON COLUMN "interest_accruals"."rate" IS 'annual rate in basis points';
+
+COMMENT ON COLUMN "interest_accruals"."amount" IS 'whole units of interest earned on the day';
+
+COMMENT ON COLUMN "interest_accruals"."remainder" IS 'fraction of a unit carried to the next day, in units of 1/3650000';
+
+COMMENT ON COLUMN "interest_accruals"."entry_id" IS 'the interest entry the amount was capitalized with, null while accruing or when the month earned nothing';
+
+COMMENT ON COLUMN "interest_accruals"."capitalized_at" IS 'null until the amount is capitalized';
+
+ALTER TABLE "system_accounts" DROP CONSTRAINT "code_valid";
+
+ALTER TABLE "system_accounts" ADD CONSTRAINT "code_valid" CHECK ("code" IN ('cash_vault', 'fees_income', 'fx_position', 'interest_expense', 'suspense'));
+
+COMMENT ON COLUMN "system_accounts"."code" IS 'cash_vault, fees_income, fx_position, interest_expense or suspense';
@@ -0,0 +1,56 @@
+CREATE TABLE "fee_schedules" (
+  "operation" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "flat_amount" bigint NOT NULL DEFAULT 0,
+  "rate" int NOT NULL DEFAULT 0,
+  "min_amount" bigint NOT NULL DEFAULT 0,
+  "max_amount" bigint NOT NULL DEFAULT 0,
+  "free_per_month" int NOT NULL DEFAULT 0,
+  "updated_by" varchar NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("operation", "currency")
+);
+
+CREATE TABLE "fees" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "operation" varchar NOT NULL,
+  "amount" bigint NOT NULL,
+  "transfer_id" bigint,
+  "entry_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "fee_schedules" ADD CONSTRAINT "operation_valid" CHECK ("operation" IN ('transfer', 'withdrawal'));
+
+ALTER TABLE "fee_schedules" ADD CONSTRAINT "amounts_not_negative" CHECK ("flat_amount" >= 0 AND "min_amount" >= 0 AND "max_amount" >= 0);
+
+ALTER TABLE "fee_schedules" ADD CONSTRAINT "rate_valid" CHECK ("rate" BETWEEN 0 AND 10000);
+
+ALTER TABLE "fee_schedules" ADD CONSTRAINT "max_valid" CHECK ("max_amount" = 0 OR "max_amount" >= "min_amount");
+
+ALTER TABLE "fee_schedules" ADD CONSTRAINT "free_per_month_not_negative" CHECK ("free_per_month" >= 0);
+
+ALTER TABLE "fees" ADD CONSTRAINT "amount_not_negative" CHECK ("amount" >= 0);
+
+CREATE INDEX ON "fees" ("account_id", "operation", "created_at");
+
+ALTER TABLE "fee_schedules" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "fees" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "fees" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "fees" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+COMMENT ON COLUMN "fee_schedules"."operation" IS 'transfer or withdrawal';
+
+COMMENT ON COLUMN "fee_schedules"."rate" IS 'percentage of the amount in basis points, rounded half up';
+
+COMMENT ON COLUMN "fee_schedules"."max_amount" IS 'caps the fee, 0 leaves it uncapped';
+
+COMMENT ON COLUMN "fee_schedules"."free_per_month" IS 'operations of an account that are free every calendar month';
+
+COMMENT ON COLUMN "fees"."amount" IS 'charged to the account, 0 for operations in the free allowance';
+
+COMMENT ON COLUMN "fees"."entry_id" IS 'the entry that debited the fee, null when nothing was charged';
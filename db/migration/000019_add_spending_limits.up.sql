@@ -0,0 +1,38 @@
+CREATE TABLE "spending_limits" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "account_id" bigint,
+  "per_transaction_limit" bigint NOT NULL DEFAULT 0,
+  "daily_limit" bigint NOT NULL DEFAULT 0,
+  "monthly_limit" bigint NOT NULL DEFAULT 0,
+  "pending_per_transaction_limit" bigint,
+  "pending_daily_limit" bigint,
+  "pending_monthly_limit" bigint,
+  "pending_effective_at" timestamptz,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "spending_limits" ADD CONSTRAINT "limits_not_negative" CHECK ("per_transaction_limit" >= 0 AND "daily_limit" >= 0 AND "monthly_limit" >= 0);
+
+ALTER TABLE "spending_limits" ADD CONSTRAINT "pending_complete" CHECK (("pending_effective_at" IS NULL) = ("pending_per_transaction_limit" IS NULL) AND ("pending_effective_at" IS NULL) = ("pending_daily_limit" IS NULL) AND ("pending_effective_at" IS NULL) = ("pending_monthly_limit" IS NULL));
+
+CREATE UNIQUE INDEX ON "spending_limits" ("username", "currency") WHERE "account_id" IS NULL;
+
+CREATE UNIQUE INDEX ON "spending_limits" ("account_id") WHERE "account_id" IS NOT NULL;
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
+
+ALTER TABLE "spending_limits" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "spending_limits" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+COMMENT ON COLUMN "spending_limits"."account_id" IS 'null for the limits of all the accounts of the user in the currency';
+
+COMMENT ON COLUMN "spending_limits"."per_transaction_limit" IS 'limits of outbound money in minor units, 0 is no limit';
+
+COMMENT ON COLUMN "spending_limits"."daily_limit" IS 'over the last 24 hours';
+
+COMMENT ON COLUMN "spending_limits"."monthly_limit" IS 'over the last 30 days';
+
+COMMENT ON COLUMN "spending_limits"."pending_effective_at" IS 'when the pending limits replace the current ones, increases wait for a cooling-off period';
@@ -0,0 +1,62 @@
+CREATE TABLE "risk_decisions" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "operation" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "to_account_id" bigint,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "rules" varchar[] NOT NULL DEFAULT '{}',
+  "request" jsonb NOT NULL DEFAULT '{}',
+  "user_agent" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "idempotency_key" varchar,
+  "request_hash" varchar,
+  "review_status" varchar,
+  "reviewed_by" varchar,
+  "reviewed_at" timestamptz,
+  "transfer_id" bigint,
+  "entry_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "risk_decisions" ADD CONSTRAINT "operation_valid" CHECK ("operation" IN ('transfer', 'withdrawal', 'deposit'));
+
+ALTER TABLE "risk_decisions" ADD CONSTRAINT "action_valid" CHECK ("action" IN ('allow', 'review', 'deny'));
+
+ALTER TABLE "risk_decisions" ADD CONSTRAINT "review_status_valid" CHECK (("action" = 'review') = ("review_status" IS NOT NULL) AND "review_status" IN ('pending', 'approved', 'rejected'));
+
+CREATE INDEX ON "risk_decisions" ("username", "created_at");
+
+CREATE INDEX ON "risk_decisions" ("review_status", "id");
+
+CREATE UNIQUE INDEX ON "risk_decisions" ("username", "idempotency_key") WHERE "action" = 'review';
+
+CREATE INDEX ON "sessions" ("username", "user_agent");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+COMMENT ON COLUMN "risk_decisions"."action" IS 'allow, review or deny, the strictest action of the matched rules';
+
+COMMENT ON COLUMN "risk_decisions"."rules" IS 'names of the rules that matched';
+
+COMMENT ON COLUMN "risk_decisions"."request" IS 'the money movement to make once a review is approved';
+
+COMMENT ON COLUMN "risk_decisions"."idempotency_key" IS 'key the client sent, the approved movement is recorded under it';
+
+COMMENT ON COLUMN "risk_decisions"."review_status" IS 'pending, approved or rejected, null unless the action is review';
+
+COMMENT ON COLUMN "risk_decisions"."transfer_id" IS 'the transfer made once the review was approved';
+
+COMMENT ON COLUMN "risk_decisions"."entry_id" IS 'the entry of the withdrawal or deposit made once the review was approved';
@@ -0,0 +1,48 @@
+CREATE TABLE "payment_requests" (
+  "id" bigserial PRIMARY KEY,
+  "requester" varchar NOT NULL,
+  "payer" varchar NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "memo" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "expires_at" timestamptz NOT NULL,
+  "transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+ALTER TABLE "payment_requests" ADD CONSTRAINT "amount_positive" CHECK ("amount" > 0);
+
+ALTER TABLE "payment_requests" ADD CONSTRAINT "users_differ" CHECK ("requester" <> "payer");
+
+ALTER TABLE "payment_requests" ADD CONSTRAINT "status_valid" CHECK ("status" IN ('pending', 'accepted', 'declined', 'expired'));
+
+ALTER TABLE "payment_requests" ADD CONSTRAINT "transfer_when_accepted" CHECK (("status" = 'accepted') = ("transfer_id" IS NOT NULL));
+
+CREATE INDEX ON "payment_requests" ("payer", "id");
+
+CREATE INDEX ON "payment_requests" ("requester", "id");
+
+CREATE INDEX ON "payment_requests" ("expires_at") WHERE "status" = 'pending';
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("requester") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("payer") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+COMMENT ON COLUMN "payment_requests"."requester" IS 'the user asking to be paid';
+
+COMMENT ON COLUMN "payment_requests"."payer" IS 'the user asked to pay';
+
+COMMENT ON COLUMN "payment_requests"."to_account_id" IS 'account of the requester the money is paid into';
+
+COMMENT ON COLUMN "payment_requests"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "payment_requests"."status" IS 'pending, accepted, declined or expired';
+
+COMMENT ON COLUMN "payment_requests"."transfer_id" IS 'the transfer that paid the request, set once accepted';
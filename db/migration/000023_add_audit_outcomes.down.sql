@@ -0,0 +1,5 @@
+ALTER TABLE IF EXISTS "audit_logs" DROP CONSTRAINT IF EXISTS "outcome_valid";
+
+ALTER TABLE IF EXISTS "audit_logs" DROP COLUMN IF EXISTS "error";
+
+ALTER TABLE IF EXISTS "audit_logs" DROP COLUMN IF EXISTS "outcome";
@@ -0,0 +1,9 @@
+ALTER TABLE "audit_logs" ADD COLUMN "outcome" varchar NOT NULL DEFAULT 'succeeded';
+
+ALTER TABLE "audit_logs" ADD COLUMN "error" varchar NOT NULL DEFAULT '';
+
+ALTER TABLE "audit_logs" ADD CONSTRAINT "outcome_valid" CHECK ("outcome" IN ('succeeded', 'failed', 'denied'));
+
+COMMENT ON COLUMN "audit_logs"."outcome" IS 'succeeded, failed or denied';
+
+COMMENT ON COLUMN "audit_logs"."error" IS 'why the action failed or was denied, empty when it succeeded';
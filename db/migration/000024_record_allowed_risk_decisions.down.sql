@@ -0,0 +1,5 @@
+COMMENT ON COLUMN "risk_decisions"."action" IS 'allow, review or deny, the strictest action of the matched rules';
+
+COMMENT ON COLUMN "risk_decisions"."transfer_id" IS 'the transfer made once the review was approved';
+
+COMMENT ON COLUMN "risk_decisions"."entry_id" IS 'the entry of the withdrawal or deposit made once the review was approved';
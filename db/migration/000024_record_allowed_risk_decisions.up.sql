@@ -0,0 +1,5 @@
+COMMENT ON COLUMN "risk_decisions"."action" IS 'allow, review or deny, the strictest action of the matched rules. Allowed decisions are only kept with the movement they allowed';
+
+COMMENT ON COLUMN "risk_decisions"."transfer_id" IS 'the transfer made once allowed or once the review was approved';
+
+COMMENT ON COLUMN "risk_decisions"."entry_id" IS 'the entry of the withdrawal, deposit or hold capture made once allowed or once the review was approved';
@@ -2,9 +2,10 @@
 INSERT INTO accounts (
   owner,
   balance,
-  currency
+  currency,
+  type
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4
 ) RETURNING *;
 
 -- name: GetAccount :one
@@ -18,10 +19,21 @@ FOR NO KEY UPDATE;
 
 -- name: ListAccounts :many
 SELECT * FROM accounts
-WHERE owner = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3;
+WHERE owner = sqlc.arg(owner)
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency))
+  AND (sqlc.narg(after_created_at)::timestamptz IS NULL
+    OR (created_at, id) > (sqlc.narg(after_created_at), sqlc.narg(after_id)::bigint))
+ORDER BY created_at, id
+LIMIT sqlc.arg(page_size);
+
+-- name: ListAccountsByBalance :many
+SELECT * FROM accounts
+WHERE owner = sqlc.arg(owner)
+  AND (sqlc.narg(currency)::varchar IS NULL OR currency = sqlc.narg(currency))
+  AND (sqlc.narg(after_balance)::bigint IS NULL
+    OR (balance, id) > (sqlc.narg(after_balance), sqlc.narg(after_id)::bigint))
+ORDER BY balance, id
+LIMIT sqlc.arg(page_size);
 
 -- name: UpdateAccount :one
 UPDATE accounts
@@ -35,6 +47,27 @@ SET balance = balance + sqlc.arg(amount)
 WHERE id = sqlc.arg(id)
 RETURNING *;
 
--- name: DeleteAccount :exec
-DELETE FROM accounts
-WHERE id = $1;
+-- name: UpdateAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = $2
+WHERE id = $1
+RETURNING *;
+
+-- name: GetAccountCommitments :one
+SELECT
+  (SELECT count(*) FROM holds
+    WHERE holds.account_id = sqlc.arg(account_id)
+      AND holds.status = 'active')::bigint AS active_holds,
+  (SELECT count(*) FROM scheduled_transfers
+    WHERE scheduled_transfers.from_account_id = sqlc.arg(account_id)
+      AND scheduled_transfers.status IN ('active', 'paused'))::bigint AS scheduled_transfers,
+  (SELECT count(*) FROM payment_requests
+    WHERE payment_requests.to_account_id = sqlc.arg(account_id)
+      AND payment_requests.status = 'pending'
+      AND payment_requests.expires_at > now())::bigint AS pending_payment_requests;
+
+-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = $2
+WHERE id = $1
+RETURNING *;
\ No newline at end of file
@@ -0,0 +1,11 @@
+-- name: CreateAuditLog :one
+INSERT INTO audit_logs (
+  actor,
+  action,
+  target,
+  details,
+  outcome,
+  error
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
\ No newline at end of file
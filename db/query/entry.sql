@@ -1,9 +1,12 @@
 -- name: CreateEntry :one
 INSERT INTO entries (
   account_id,
-  amount
+  amount,
+  memo,
+  reference,
+  category
 ) VALUES (
-  $1, $2
+  $1, $2, $3, $4, $5
 ) RETURNING *;
 
 -- name: GetEntry :one
@@ -12,7 +15,14 @@ WHERE id = $1 LIMIT 1;
 
 -- name: ListEntries :many
 SELECT * FROM entries
-WHERE account_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3;
\ No newline at end of file
+WHERE account_id = sqlc.arg(account_id)
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+  AND (sqlc.narg(from_time)::timestamptz IS NULL OR created_at >= sqlc.narg(from_time))
+  AND (sqlc.narg(to_time)::timestamptz IS NULL OR created_at < sqlc.narg(to_time))
+  AND (sqlc.narg(min_amount)::bigint IS NULL OR abs(amount) >= sqlc.narg(min_amount))
+  AND (sqlc.narg(max_amount)::bigint IS NULL OR abs(amount) <= sqlc.narg(max_amount))
+  AND (sqlc.narg(query)::text IS NULL OR to_tsvector('simple', memo) @@ websearch_to_tsquery('simple', sqlc.narg(query)))
+  AND (sqlc.narg(reference)::varchar IS NULL OR reference = sqlc.narg(reference))
+  AND (sqlc.narg(category)::varchar IS NULL OR category = sqlc.narg(category))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
\ No newline at end of file
@@ -0,0 +1,42 @@
+-- name: UpsertFeeSchedule :one
+INSERT INTO fee_schedules (
+  operation,
+  currency,
+  flat_amount,
+  rate,
+  min_amount,
+  max_amount,
+  free_per_month,
+  updated_by
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8
+) ON CONFLICT (operation, currency) DO UPDATE
+SET flat_amount = EXCLUDED.flat_amount,
+  rate = EXCLUDED.rate,
+  min_amount = EXCLUDED.min_amount,
+  max_amount = EXCLUDED.max_amount,
+  free_per_month = EXCLUDED.free_per_month,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = now()
+RETURNING *;
+
+-- name: GetFeeSchedule :one
+SELECT * FROM fee_schedules
+WHERE operation = $1 AND currency = $2 LIMIT 1;
+
+-- name: CreateFee :one
+INSERT INTO fees (
+  account_id,
+  operation,
+  amount,
+  transfer_id,
+  entry_id
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: CountFeesSince :one
+SELECT count(*) FROM fees
+WHERE account_id = sqlc.arg(account_id)
+  AND operation = sqlc.arg(operation)
+  AND created_at >= sqlc.arg(since);
\ No newline at end of file
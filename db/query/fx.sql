@@ -0,0 +1,40 @@
+-- name: UpsertFxRate :one
+INSERT INTO fx_rates (
+  from_currency,
+  to_currency,
+  rate,
+  updated_by
+) VALUES (
+  $1, $2, $3, $4
+) ON CONFLICT (from_currency, to_currency) DO UPDATE
+SET rate = EXCLUDED.rate,
+  updated_by = EXCLUDED.updated_by,
+  updated_at = now()
+RETURNING *;
+
+-- name: GetFxRate :one
+SELECT * FROM fx_rates
+WHERE from_currency = $1 AND to_currency = $2 LIMIT 1;
+
+-- name: CreateFxQuote :one
+INSERT INTO fx_quotes (
+  id,
+  username,
+  from_currency,
+  to_currency,
+  rate,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: GetFxQuoteForUpdate :one
+SELECT * FROM fx_quotes
+WHERE id = $1 LIMIT 1
+FOR UPDATE;
+
+-- name: UseFxQuote :one
+UPDATE fx_quotes
+SET used_at = now()
+WHERE id = $1
+RETURNING *;
\ No newline at end of file
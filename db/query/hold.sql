@@ -0,0 +1,40 @@
+-- name: CreateHold :one
+INSERT INTO holds (
+  account_id,
+  amount,
+  expires_at
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetHold :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1;
+
+-- name: GetHoldForUpdate :one
+SELECT * FROM holds
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetHeldAmount :one
+SELECT COALESCE(sum(amount), 0)::bigint AS held_amount
+FROM holds
+WHERE account_id = $1
+  AND status = 'active'
+  AND expires_at > now();
+
+-- name: ListExpiredHolds :many
+SELECT * FROM holds
+WHERE status = 'active'
+  AND expires_at <= now()
+ORDER BY expires_at
+LIMIT $1;
+
+-- name: SettleHold :one
+UPDATE holds
+SET status = $2,
+  captured_amount = $3,
+  entry_id = $4,
+  settled_at = now()
+WHERE id = $1
+RETURNING *;
\ No newline at end of file
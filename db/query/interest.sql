@@ -0,0 +1,63 @@
+-- name: ListInterestAccrualAccounts :many
+SELECT accounts.id AS account_id,
+  balance_snapshots.balance,
+  COALESCE((
+    SELECT remainder FROM interest_accruals AS previous
+    WHERE previous.account_id = accounts.id
+      AND previous.accrual_date < sqlc.arg(accrual_date)
+    ORDER BY previous.accrual_date DESC
+    LIMIT 1
+  ), 0)::bigint AS remainder
+FROM accounts
+JOIN balance_snapshots ON balance_snapshots.account_id = accounts.id
+  AND balance_snapshots.snapshot_date = sqlc.arg(accrual_date)
+WHERE accounts.type = 'savings'
+  AND accounts.status <> 'closed'
+  AND accounts.id > sqlc.arg(after_id)
+  AND NOT EXISTS (
+    SELECT 1 FROM interest_accruals
+    WHERE interest_accruals.account_id = accounts.id
+      AND interest_accruals.accrual_date = sqlc.arg(accrual_date)
+  )
+ORDER BY accounts.id
+LIMIT sqlc.arg(page_size);
+
+-- name: CreateInterestAccrual :one
+INSERT INTO interest_accruals (
+  account_id,
+  accrual_date,
+  balance,
+  rate,
+  amount,
+  remainder
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListCapitalizableAccounts :many
+SELECT DISTINCT interest_accruals.account_id FROM interest_accruals
+JOIN accounts ON accounts.id = interest_accruals.account_id
+WHERE interest_accruals.capitalized_at IS NULL
+  AND interest_accruals.accrual_date < sqlc.arg(before)
+  AND interest_accruals.account_id > sqlc.arg(after_id)
+  AND accounts.status <> 'closed'
+ORDER BY interest_accruals.account_id
+LIMIT sqlc.arg(page_size);
+
+-- name: GetPendingInterest :one
+SELECT COALESCE(sum(amount), 0)::bigint AS amount FROM interest_accruals
+WHERE account_id = $1
+  AND capitalized_at IS NULL;
+
+-- name: CapitalizeInterestAccruals :many
+UPDATE interest_accruals
+SET capitalized_at = now()
+WHERE account_id = sqlc.arg(account_id)
+  AND capitalized_at IS NULL
+  AND accrual_date < sqlc.arg(before)
+RETURNING *;
+
+-- name: SetInterestAccrualsEntry :exec
+UPDATE interest_accruals
+SET entry_id = sqlc.arg(entry_id)
+WHERE id = ANY(sqlc.arg(ids)::bigint[]);
\ No newline at end of file
@@ -0,0 +1,54 @@
+-- name: CreateJournal :one
+INSERT INTO journals (
+  kind,
+  transfer_id
+) VALUES (
+  $1, $2
+) RETURNING *;
+
+-- name: CreatePosting :one
+INSERT INTO postings (
+  journal_id,
+  account_id,
+  system_account_id,
+  currency,
+  amount
+) VALUES (
+  $1, $2, $3, $4, $5
+) RETURNING *;
+
+-- name: ListJournalPostings :many
+SELECT * FROM postings
+WHERE journal_id = $1
+ORDER BY id;
+
+-- name: CreateSystemAccount :one
+INSERT INTO system_accounts (
+  code,
+  currency
+) VALUES (
+  $1, $2
+) ON CONFLICT (code, currency) DO NOTHING
+RETURNING *;
+
+-- name: GetSystemAccount :one
+SELECT * FROM system_accounts
+WHERE code = $1 AND currency = $2 LIMIT 1;
+
+-- name: ListSystemAccountBalances :many
+SELECT system_accounts.id, system_accounts.code, system_accounts.currency,
+  COALESCE(sum(postings.amount), 0)::bigint AS balance
+FROM system_accounts
+LEFT JOIN postings ON postings.system_account_id = system_accounts.id
+GROUP BY system_accounts.id
+ORDER BY system_accounts.code, system_accounts.currency;
+
+-- name: GetTrialBalance :many
+SELECT currency,
+  count(*) AS postings,
+  COALESCE(sum(amount) FILTER (WHERE amount > 0), 0)::bigint AS credits,
+  COALESCE(-sum(amount) FILTER (WHERE amount < 0), 0)::bigint AS debits,
+  sum(amount)::bigint AS net
+FROM postings
+GROUP BY currency
+ORDER BY currency;
\ No newline at end of file
@@ -0,0 +1,32 @@
+-- name: CreatePayee :one
+INSERT INTO payees (
+  owner,
+  account_id,
+  nickname
+) VALUES (
+  $1, $2, $3
+) RETURNING *;
+
+-- name: GetPayee :one
+SELECT * FROM payees
+WHERE id = $1 LIMIT 1;
+
+-- name: ListPayees :many
+SELECT payees.id, payees.owner, payees.account_id, payees.nickname, payees.created_at, payees.updated_at,
+  accounts.currency, users.full_name
+FROM payees
+JOIN accounts ON accounts.id = payees.account_id
+JOIN users ON users.username = accounts.owner
+WHERE payees.owner = $1
+ORDER BY payees.nickname;
+
+-- name: UpdatePayee :one
+UPDATE payees
+SET nickname = $2,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: DeletePayee :exec
+DELETE FROM payees
+WHERE id = $1;
\ No newline at end of file
@@ -0,0 +1,52 @@
+-- name: CreatePaymentRequest :one
+INSERT INTO payment_requests (
+  requester,
+  payer,
+  to_account_id,
+  amount,
+  currency,
+  memo,
+  expires_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7
+) RETURNING *;
+
+-- name: GetPaymentRequest :one
+SELECT * FROM payment_requests
+WHERE id = $1 LIMIT 1;
+
+-- name: GetPaymentRequestForUpdate :one
+SELECT * FROM payment_requests
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListIncomingPaymentRequests :many
+SELECT * FROM payment_requests
+WHERE payer = sqlc.arg(payer)
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: ListOutgoingPaymentRequests :many
+SELECT * FROM payment_requests
+WHERE requester = sqlc.arg(requester)
+  AND (sqlc.narg(status)::varchar IS NULL OR status = sqlc.narg(status))
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: ListExpiredPaymentRequests :many
+SELECT * FROM payment_requests
+WHERE status = 'pending'
+  AND expires_at <= now()
+ORDER BY expires_at
+LIMIT $1;
+
+-- name: UpdatePaymentRequestStatus :one
+UPDATE payment_requests
+SET status = $2,
+  transfer_id = $3,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
\ No newline at end of file
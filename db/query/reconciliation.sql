@@ -0,0 +1,46 @@
+-- name: ListAccountReconciliations :many
+SELECT accounts.id AS account_id,
+  (accounts.balance - COALESCE(sum(entries.amount) FILTER (WHERE entries.created_at >= sqlc.arg(end_time)), 0))::bigint AS balance,
+  COALESCE(sum(entries.amount) FILTER (WHERE entries.created_at < sqlc.arg(end_time)), 0)::bigint AS entry_sum
+FROM accounts
+LEFT JOIN entries ON entries.account_id = accounts.id
+WHERE accounts.created_at < sqlc.arg(end_time)
+  AND accounts.id > sqlc.arg(after_id)
+GROUP BY accounts.id
+ORDER BY accounts.id
+LIMIT sqlc.arg(page_size);
+
+-- name: CreateBalanceSnapshot :one
+INSERT INTO balance_snapshots (
+  account_id,
+  snapshot_date,
+  balance,
+  entry_sum
+) VALUES (
+  $1, $2, $3, $4
+) ON CONFLICT (account_id, snapshot_date) DO UPDATE
+SET balance = EXCLUDED.balance, entry_sum = EXCLUDED.entry_sum, created_at = now()
+RETURNING *;
+
+-- name: CreateBalanceDiscrepancy :one
+INSERT INTO balance_discrepancies (
+  account_id,
+  snapshot_date,
+  balance,
+  entry_sum
+) VALUES (
+  $1, $2, $3, $4
+) ON CONFLICT (account_id, snapshot_date) DO UPDATE
+SET balance = EXCLUDED.balance, entry_sum = EXCLUDED.entry_sum, created_at = now()
+RETURNING *;
+
+-- name: DeleteBalanceDiscrepancies :exec
+DELETE FROM balance_discrepancies
+WHERE snapshot_date = sqlc.arg(snapshot_date)
+  AND account_id > sqlc.arg(after_id)
+  AND (sqlc.narg(up_to_id)::bigint IS NULL OR account_id <= sqlc.narg(up_to_id));
+
+-- name: ListBalanceDiscrepancies :many
+SELECT * FROM balance_discrepancies
+WHERE snapshot_date = $1
+ORDER BY account_id;
\ No newline at end of file
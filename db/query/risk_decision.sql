@@ -0,0 +1,76 @@
+-- name: CreateRiskDecision :one
+INSERT INTO risk_decisions (
+  username,
+  operation,
+  account_id,
+  to_account_id,
+  amount,
+  currency,
+  action,
+  rules,
+  request,
+  user_agent,
+  client_ip,
+  idempotency_key,
+  request_hash,
+  review_status,
+  transfer_id,
+  entry_id
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+) RETURNING *;
+
+-- name: GetRiskDecision :one
+SELECT * FROM risk_decisions
+WHERE id = $1 LIMIT 1;
+
+-- name: GetRiskDecisionForUpdate :one
+SELECT * FROM risk_decisions
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetRiskReviewByIdempotencyKey :one
+SELECT * FROM risk_decisions
+WHERE username = $1
+  AND idempotency_key = $2
+  AND action = 'review'
+LIMIT 1;
+
+-- name: ListRiskReviews :many
+SELECT * FROM risk_decisions
+WHERE review_status = sqlc.arg(review_status)
+  AND id > sqlc.arg(after_id)
+ORDER BY id
+LIMIT sqlc.arg(page_size);
+
+-- name: ReviewRiskDecision :one
+UPDATE risk_decisions
+SET
+  review_status = $2,
+  reviewed_by = $3,
+  reviewed_at = now(),
+  transfer_id = $4,
+  entry_id = $5
+WHERE id = $1
+RETURNING *;
+
+-- name: CountRiskDecisionsSince :one
+SELECT count(*) FROM risk_decisions
+WHERE username = sqlc.arg(username)
+  AND created_at >= sqlc.arg(since)
+  AND action <> 'deny'
+  AND review_status IS DISTINCT FROM 'rejected';
+
+-- name: HasUserPaidAccount :one
+SELECT EXISTS (
+  SELECT 1 FROM transfers
+  JOIN accounts ON accounts.id = transfers.from_account_id
+  WHERE accounts.owner = sqlc.arg(owner)
+    AND transfers.to_account_id = sqlc.arg(to_account_id)
+) AS paid;
+
+-- name: GetDeviceFirstSeen :one
+SELECT COALESCE(min(created_at), now())::timestamptz AS first_seen
+FROM sessions
+WHERE username = $1
+  AND user_agent = $2;
\ No newline at end of file
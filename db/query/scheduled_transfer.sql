@@ -0,0 +1,85 @@
+-- name: CreateScheduledTransfer :one
+INSERT INTO scheduled_transfers (
+  owner,
+  from_account_id,
+  to_account_id,
+  amount,
+  frequency,
+  interval_count,
+  day_of_month,
+  start_at,
+  end_at,
+  next_occurrence_at,
+  next_run_at,
+  failure_policy
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+) RETURNING *;
+
+-- name: GetScheduledTransfer :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1 LIMIT 1;
+
+-- name: GetScheduledTransferForUpdate :one
+SELECT * FROM scheduled_transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListScheduledTransfers :many
+SELECT * FROM scheduled_transfers
+WHERE owner = $1
+ORDER BY id;
+
+-- name: ListDueScheduledTransfers :many
+SELECT * FROM scheduled_transfers
+WHERE status = 'active'
+  AND next_run_at <= now()
+ORDER BY next_run_at
+LIMIT $1;
+
+-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+  amount = COALESCE(sqlc.narg(amount), amount),
+  end_at = COALESCE(sqlc.narg(end_at), end_at),
+  failure_policy = COALESCE(sqlc.narg(failure_policy), failure_policy),
+  status = COALESCE(sqlc.narg(status), status),
+  updated_at = now()
+WHERE id = sqlc.arg(id)
+  AND status IN ('active', 'paused')
+RETURNING *;
+
+-- name: CancelScheduledTransfersToAccount :execrows
+UPDATE scheduled_transfers
+SET status = 'cancelled',
+  updated_at = now()
+WHERE to_account_id = $1
+  AND status IN ('active', 'paused');
+
+-- name: AdvanceScheduledTransfer :one
+UPDATE scheduled_transfers
+SET next_occurrence_at = $2,
+  next_run_at = $3,
+  attempts = $4,
+  status = $5,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: CreateScheduledTransferRun :one
+INSERT INTO scheduled_transfer_runs (
+  scheduled_transfer_id,
+  occurrence_at,
+  attempt,
+  status,
+  transfer_id,
+  error
+) VALUES (
+  $1, $2, $3, $4, $5, $6
+) RETURNING *;
+
+-- name: ListScheduledTransferRuns :many
+SELECT * FROM scheduled_transfer_runs
+WHERE scheduled_transfer_id = $1
+ORDER BY id DESC
+LIMIT $2;
\ No newline at end of file
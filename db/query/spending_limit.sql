@@ -0,0 +1,60 @@
+-- name: CreateSpendingLimit :one
+INSERT INTO spending_limits (
+  username,
+  currency,
+  account_id,
+  per_transaction_limit,
+  daily_limit,
+  monthly_limit,
+  pending_per_transaction_limit,
+  pending_daily_limit,
+  pending_monthly_limit,
+  pending_effective_at
+) VALUES (
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING *;
+
+-- name: GetAccountSpendingLimitForUpdate :one
+SELECT * FROM spending_limits
+WHERE account_id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: GetUserSpendingLimitForUpdate :one
+SELECT * FROM spending_limits
+WHERE username = $1 AND currency = $2 AND account_id IS NULL LIMIT 1
+FOR NO KEY UPDATE;
+
+-- name: ListSpendingLimits :many
+SELECT * FROM spending_limits
+WHERE username = $1
+ORDER BY account_id NULLS FIRST, currency;
+
+-- name: UpdateSpendingLimit :one
+UPDATE spending_limits
+SET
+  per_transaction_limit = $2,
+  daily_limit = $3,
+  monthly_limit = $4,
+  pending_per_transaction_limit = $5,
+  pending_daily_limit = $6,
+  pending_monthly_limit = $7,
+  pending_effective_at = $8,
+  updated_at = now()
+WHERE id = $1
+RETURNING *;
+
+-- name: SumAccountDebitsSince :one
+SELECT COALESCE(-sum(amount), 0)::bigint AS debited
+FROM entries
+WHERE account_id = sqlc.arg(account_id)
+  AND amount < 0
+  AND created_at >= sqlc.arg(since);
+
+-- name: SumUserDebitsSince :one
+SELECT COALESCE(-sum(entries.amount), 0)::bigint AS debited
+FROM entries
+JOIN accounts ON accounts.id = entries.account_id
+WHERE accounts.owner = sqlc.arg(owner)
+  AND accounts.currency = sqlc.arg(currency)
+  AND entries.amount < 0
+  AND entries.created_at >= sqlc.arg(since);
\ No newline at end of file
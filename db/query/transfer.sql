@@ -1,21 +1,53 @@
+-- name: AddTransferRefundedAmount :one
+UPDATE transfers
+SET refunded_amount = refunded_amount + sqlc.arg(amount)
+WHERE id = sqlc.arg(id)
+RETURNING *;
+
 -- name: CreateTransfer :one
 INSERT INTO transfers (
   from_account_id,
   to_account_id,
-  amount
+  amount,
+  to_amount,
+  fx_rate,
+  fx_quote_id,
+  reversed_transfer_id,
+  memo,
+  reference,
+  category
 ) VALUES (
-  $1, $2, $3
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
 ) RETURNING *;
 
 -- name: GetTransfer :one
 SELECT * FROM transfers
 WHERE id = $1 LIMIT 1;
 
+-- name: GetTransferForUpdate :one
+SELECT * FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE;
+
 -- name: ListTransfers :many
 SELECT * FROM transfers
-WHERE 
-    from_account_id = $1 OR
-    to_account_id = $2
-ORDER BY id
-LIMIT $3
-OFFSET $4;
\ No newline at end of file
+WHERE (from_account_id = sqlc.arg(account_id) OR to_account_id = sqlc.arg(account_id))
+  AND (sqlc.narg(before_id)::bigint IS NULL OR id < sqlc.narg(before_id))
+  AND (sqlc.narg(from_time)::timestamptz IS NULL OR created_at >= sqlc.narg(from_time))
+  AND (sqlc.narg(to_time)::timestamptz IS NULL OR created_at < sqlc.narg(to_time))
+  AND (sqlc.narg(min_amount)::bigint IS NULL OR abs(amount) >= sqlc.narg(min_amount))
+  AND (sqlc.narg(max_amount)::bigint IS NULL OR abs(amount) <= sqlc.narg(max_amount))
+  AND (sqlc.narg(query)::text IS NULL OR to_tsvector('simple', memo) @@ websearch_to_tsquery('simple', sqlc.narg(query)))
+  AND (sqlc.narg(reference)::varchar IS NULL OR reference = sqlc.narg(reference))
+  AND (sqlc.narg(category)::varchar IS NULL OR category = sqlc.narg(category))
+ORDER BY id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: GetPaymentsToAccount :one
+SELECT
+  COALESCE(min(transfers.created_at), now())::timestamptz AS first_paid_at,
+  COALESCE(sum(transfers.amount), 0)::bigint AS total
+FROM transfers
+JOIN accounts ON accounts.id = transfers.from_account_id
+WHERE accounts.owner = sqlc.arg(owner)
+  AND transfers.to_account_id = sqlc.arg(to_account_id);
\ No newline at end of file
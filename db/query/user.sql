@@ -23,4 +23,12 @@ SET
   is_email_verified = COALESCE(sqlc.narg(is_email_verified), is_email_verified)
 WHERE
   username = sqlc.arg(username)
-RETURNING *;
\ No newline at end of file
+RETURNING *;
+
+-- name: SearchUsers :many
+SELECT * FROM users
+WHERE username ILIKE sqlc.arg(pattern)
+  OR email ILIKE sqlc.arg(pattern)
+  OR full_name ILIKE sqlc.arg(pattern)
+ORDER BY username
+LIMIT sqlc.arg(page_size);
\ No newline at end of file
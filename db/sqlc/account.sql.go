@@ -7,13 +7,14 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const addAccountBalance = `-- name: AddAccountBalance :one
 UPDATE accounts
 SET balance = balance + $1
 WHERE id = $2
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, status, type
 `
 
 type AddAccountBalanceParams struct {
@@ -30,6 +31,9 @@ func (q *Queries) AddAccountBalance(ctx context.Context, arg AddAccountBalancePa
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.Type,
 	)
 	return i, err
 }
@@ -38,20 +42,27 @@ const createAccount = `-- name: CreateAccount :one
 INSERT INTO accounts (
   owner,
   balance,
-  currency
+  currency,
+  type
 ) VALUES (
-  $1, $2, $3
-) RETURNING id, owner, balance, currency, created_at
+  $1, $2, $3, $4
+) RETURNING id, owner, balance, currency, created_at, overdraft_limit, status, type
 `
 
 type CreateAccountParams struct {
 	Owner    string `json:"owner"`
 	Balance  int64  `json:"balance"`
 	Currency string `json:"currency"`
+	Type     string `json:"type"`
 }
 
 func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error) {
-	row := q.db.QueryRowContext(ctx, createAccount, arg.Owner, arg.Balance, arg.Currency)
+	row := q.db.QueryRowContext(ctx, createAccount,
+		arg.Owner,
+		arg.Balance,
+		arg.Currency,
+		arg.Type,
+	)
 	var i Account
 	err := row.Scan(
 		&i.ID,
@@ -59,22 +70,15 @@ func (q *Queries) CreateAccount(ctx context.Context, arg CreateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.Type,
 	)
 	return i, err
 }
 
-const deleteAccount = `-- name: DeleteAccount :exec
-DELETE FROM accounts
-WHERE id = $1
-`
-
-func (q *Queries) DeleteAccount(ctx context.Context, id int64) error {
-	_, err := q.db.ExecContext(ctx, deleteAccount, id)
-	return err
-}
-
 const getAccount = `-- name: GetAccount :one
-SELECT id, owner, balance, currency, created_at FROM accounts
+SELECT id, owner, balance, currency, created_at, overdraft_limit, status, type FROM accounts
 WHERE id = $1 LIMIT 1
 `
 
@@ -87,12 +91,42 @@ func (q *Queries) GetAccount(ctx context.Context, id int64) (Account, error) {
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.Type,
 	)
 	return i, err
 }
 
+const getAccountCommitments = `-- name: GetAccountCommitments :one
+SELECT
+  (SELECT count(*) FROM holds
+    WHERE holds.account_id = $1
+      AND holds.status = 'active')::bigint AS active_holds,
+  (SELECT count(*) FROM scheduled_transfers
+    WHERE scheduled_transfers.from_account_id = $1
+      AND scheduled_transfers.status IN ('active', 'paused'))::bigint AS scheduled_transfers,
+  (SELECT count(*) FROM payment_requests
+    WHERE payment_requests.to_account_id = $1
+      AND payment_requests.status = 'pending'
+      AND payment_requests.expires_at > now())::bigint AS pending_payment_requests
+`
+
+type GetAccountCommitmentsRow struct {
+	ActiveHolds            int64 `json:"active_holds"`
+	ScheduledTransfers     int64 `json:"scheduled_transfers"`
+	PendingPaymentRequests int64 `json:"pending_payment_requests"`
+}
+
+func (q *Queries) GetAccountCommitments(ctx context.Context, accountID int64) (GetAccountCommitmentsRow, error) {
+	row := q.db.QueryRowContext(ctx, getAccountCommitments, accountID)
+	var i GetAccountCommitmentsRow
+	err := row.Scan(&i.ActiveHolds, &i.ScheduledTransfers, &i.PendingPaymentRequests)
+	return i, err
+}
+
 const getAccountForUpdate = `-- name: GetAccountForUpdate :one
-SELECT id, owner, balance, currency, created_at FROM accounts
+SELECT id, owner, balance, currency, created_at, overdraft_limit, status, type FROM accounts
 WHERE id = $1 LIMIT 1
 FOR NO KEY UPDATE
 `
@@ -106,26 +140,39 @@ func (q *Queries) GetAccountForUpdate(ctx context.Context, id int64) (Account, e
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.Type,
 	)
 	return i, err
 }
 
 const listAccounts = `-- name: ListAccounts :many
-SELECT id, owner, balance, currency, created_at FROM accounts
+SELECT id, owner, balance, currency, created_at, overdraft_limit, status, type FROM accounts
 WHERE owner = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3
+  AND ($2::varchar IS NULL OR currency = $2)
+  AND ($3::timestamptz IS NULL
+    OR (created_at, id) > ($3, $4::bigint))
+ORDER BY created_at, id
+LIMIT $5
 `
 
 type ListAccountsParams struct {
-	Owner  string `json:"owner"`
-	Limit  int32  `json:"limit"`
-	Offset int32  `json:"offset"`
+	Owner          string         `json:"owner"`
+	Currency       sql.NullString `json:"currency"`
+	AfterCreatedAt sql.NullTime   `json:"after_created_at"`
+	AfterID        sql.NullInt64  `json:"after_id"`
+	PageSize       int32          `json:"page_size"`
 }
 
 func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error) {
-	rows, err := q.db.QueryContext(ctx, listAccounts, arg.Owner, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listAccounts,
+		arg.Owner,
+		arg.Currency,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -139,6 +186,65 @@ func (q *Queries) ListAccounts(ctx context.Context, arg ListAccountsParams) ([]A
 			&i.Balance,
 			&i.Currency,
 			&i.CreatedAt,
+			&i.OverdraftLimit,
+			&i.Status,
+			&i.Type,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listAccountsByBalance = `-- name: ListAccountsByBalance :many
+SELECT id, owner, balance, currency, created_at, overdraft_limit, status, type FROM accounts
+WHERE owner = $1
+  AND ($2::varchar IS NULL OR currency = $2)
+  AND ($3::bigint IS NULL
+    OR (balance, id) > ($3, $4::bigint))
+ORDER BY balance, id
+LIMIT $5
+`
+
+type ListAccountsByBalanceParams struct {
+	Owner        string         `json:"owner"`
+	Currency     sql.NullString `json:"currency"`
+	AfterBalance sql.NullInt64  `json:"after_balance"`
+	AfterID      sql.NullInt64  `json:"after_id"`
+	PageSize     int32          `json:"page_size"`
+}
+
+func (q *Queries) ListAccountsByBalance(ctx context.Context, arg ListAccountsByBalanceParams) ([]Account, error) {
+	rows, err := q.db.QueryContext(ctx, listAccountsByBalance,
+		arg.Owner,
+		arg.Currency,
+		arg.AfterBalance,
+		arg.AfterID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []Account{}
+	for rows.Next() {
+		var i Account
+		if err := rows.Scan(
+			&i.ID,
+			&i.Owner,
+			&i.Balance,
+			&i.Currency,
+			&i.CreatedAt,
+			&i.OverdraftLimit,
+			&i.Status,
+			&i.Type,
 		); err != nil {
 			return nil, err
 		}
@@ -157,7 +263,7 @@ const updateAccount = `-- name: UpdateAccount :one
 UPDATE accounts
 SET balance = $2
 WHERE id = $1
-RETURNING id, owner, balance, currency, created_at
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, status, type
 `
 
 type UpdateAccountParams struct {
@@ -174,6 +280,65 @@ func (q *Queries) UpdateAccount(ctx context.Context, arg UpdateAccountParams) (A
 		&i.Balance,
 		&i.Currency,
 		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.Type,
+	)
+	return i, err
+}
+
+const updateAccountOverdraftLimit = `-- name: UpdateAccountOverdraftLimit :one
+UPDATE accounts
+SET overdraft_limit = $2
+WHERE id = $1
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, status, type
+`
+
+type UpdateAccountOverdraftLimitParams struct {
+	ID             int64 `json:"id"`
+	OverdraftLimit int64 `json:"overdraft_limit"`
+}
+
+func (q *Queries) UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountOverdraftLimit, arg.ID, arg.OverdraftLimit)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.Type,
+	)
+	return i, err
+}
+
+const updateAccountStatus = `-- name: UpdateAccountStatus :one
+UPDATE accounts
+SET status = $2
+WHERE id = $1
+RETURNING id, owner, balance, currency, created_at, overdraft_limit, status, type
+`
+
+type UpdateAccountStatusParams struct {
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+}
+
+func (q *Queries) UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error) {
+	row := q.db.QueryRowContext(ctx, updateAccountStatus, arg.ID, arg.Status)
+	var i Account
+	err := row.Scan(
+		&i.ID,
+		&i.Owner,
+		&i.Balance,
+		&i.Currency,
+		&i.CreatedAt,
+		&i.OverdraftLimit,
+		&i.Status,
+		&i.Type,
 	)
 	return i, err
 }
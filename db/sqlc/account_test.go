@@ -7,17 +7,23 @@ import (
 	"time"
 
 	"github.com/JaidenShall/simplebank/util"
+	"github.com/lib/pq"
 
 	"github.com/stretchr/testify/require"
 )
 
 func createRandomAccount(t *testing.T) Account {
+	return createRandomAccountWithBalance(t, util.RandomMoney())
+}
+
+func createRandomAccountWithBalance(t *testing.T, balance int64) Account {
 	user := createRandomUser(t)
 
 	arg := CreateAccountParams{
 		Owner:    user.Username,
-		Balance:  util.RandomMoney(),
+		Balance:  balance,
 		Currency: util.RandomCurrency(),
+		Type:     util.AccountChecking,
 	}
 
 	account, err := testQueries.CreateAccount(context.Background(), arg)
@@ -27,6 +33,10 @@ func createRandomAccount(t *testing.T) Account {
 	require.Equal(t, arg.Owner, account.Owner)
 	require.Equal(t, arg.Balance, account.Balance)
 	require.Equal(t, arg.Currency, account.Currency)
+	require.Equal(t, arg.Type, account.Type)
+
+	require.Zero(t, account.OverdraftLimit)
+	require.Equal(t, util.AccountActive, account.Status)
 
 	require.NotZero(t, account.ID)
 	require.NotZero(t, account.CreatedAt)
@@ -70,35 +80,292 @@ func TestUpdateAccount(t *testing.T) {
 	require.WithinDuration(t, account1.CreatedAt, account2.CreatedAt, time.Second)
 }
 
-func TestDeleteAccount(t *testing.T) {
+func TestUpdateAccountOverdraftLimit(t *testing.T) {
 	account1 := createRandomAccount(t)
-	err := testQueries.DeleteAccount(context.Background(), account1.ID)
+
+	arg := UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: util.RandomMoney(),
+	}
+
+	account2, err := testQueries.UpdateAccountOverdraftLimit(context.Background(), arg)
 	require.NoError(t, err)
+	require.Equal(t, account1.ID, account2.ID)
+	require.Equal(t, account1.Balance, account2.Balance)
+	require.Equal(t, arg.OverdraftLimit, account2.OverdraftLimit)
+}
 
-	account2, err := testQueries.GetAccount(context.Background(), account1.ID)
+func TestUpdateAccountStatus(t *testing.T) {
+	account1 := createRandomAccount(t)
+
+	account2, err := testQueries.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     account1.ID,
+		Status: util.AccountFrozen,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, account2.ID)
+	require.Equal(t, util.AccountFrozen, account2.Status)
+
+	_, err = testQueries.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     account1.ID,
+		Status: "unknown",
+	})
 	require.Error(t, err)
-	require.EqualError(t, err, sql.ErrNoRows.Error())
-	require.Empty(t, account2)
 }
 
-func TestListAccounts(t *testing.T) {
-	var lastAccount Account
-	for i := 0; i < 10; i++ {
-		lastAccount = createRandomAccount(t)
+func TestAddAccountBalanceBelowOverdraftLimit(t *testing.T) {
+	account := createRandomAccount(t)
+
+	_, err := testQueries.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: -(account.Balance + 1),
+	})
+	require.Error(t, err)
+
+	pqErr, ok := err.(*pq.Error)
+	require.True(t, ok)
+	require.Equal(t, "check_violation", pqErr.Code.Name())
+	require.Equal(t, "balance_non_negative", pqErr.Constraint)
+}
+
+func TestChangeAccountStatusTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 0)
+
+	for _, accountStatus := range []string{util.AccountFrozen, util.AccountFrozen, util.AccountActive, util.AccountClosed} {
+		result, err := store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+			AccountID: account.ID,
+			Status:    accountStatus,
+		})
+		require.NoError(t, err)
+		require.Equal(t, account.ID, result.Account.ID)
+		require.Equal(t, accountStatus, result.Account.Status)
 	}
 
+	// only reopening brings a closed account back
+	_, err := store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    util.AccountActive,
+	})
+	var notActiveErr *AccountNotActiveError
+	require.ErrorAs(t, err, &notActiveErr)
+	require.Equal(t, util.AccountClosed, notActiveErr.Status)
+
+	// the owner can open a new account in the same currency
+	account2, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    account.Owner,
+		Balance:  0,
+		Currency: account.Currency,
+		Type:     util.AccountChecking,
+	})
+	require.NoError(t, err)
+	require.NotEqual(t, account.ID, account2.ID)
+}
+
+func TestChangeAccountStatusTxCloseNotEmpty(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccount(t)
+
+	_, err := store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    util.AccountClosed,
+	})
+	require.ErrorIs(t, err, ErrAccountNotEmpty)
+
+	account2, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.AccountActive, account2.Status)
+}
+
+func TestChangeAccountStatusTxCloseInUse(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 0)
+	payer := createRandomUser(t)
+	request := createRandomPaymentRequest(t, payer.Username, account, 10, time.Now().Add(time.Hour))
+
+	_, err := store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    util.AccountClosed,
+	})
+	require.ErrorIs(t, err, ErrAccountInUse)
+
+	_, err = testQueries.UpdatePaymentRequestStatus(context.Background(), UpdatePaymentRequestStatusParams{
+		ID:     request.ID,
+		Status: util.PaymentRequestDeclined,
+	})
+	require.NoError(t, err)
+
+	// schedules other accounts have into it are cancelled with the close
+	fromAccount := createRandomAccount(t)
+	scheduled := createDueScheduledTransfer(t, fromAccount, account, 10, util.Recurrence{Frequency: util.FrequencyDaily, Interval: 1}, util.FailurePolicySkip)
+
+	result, err := store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    util.AccountClosed,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountClosed, result.Account.Status)
+
+	scheduled, err = testQueries.GetScheduledTransfer(context.Background(), scheduled.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.ScheduleCancelled, scheduled.Status)
+}
+
+func TestReopenAccount(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 0)
+
+	_, err := ReopenAccount(context.Background(), testQueries, account.ID)
+	require.ErrorIs(t, err, ErrAccountNotClosed)
+
+	_, err = store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    util.AccountClosed,
+	})
+	require.NoError(t, err)
+
+	reopened, err := ReopenAccount(context.Background(), testQueries, account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.ID, reopened.ID)
+	require.Equal(t, util.AccountActive, reopened.Status)
+}
+
+func TestChangeAccountStatusTxNotFound(t *testing.T) {
+	store := NewStore(testDB)
+
+	_, err := store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: util.RandomInt(1000000, 2000000),
+		Status:    util.AccountFrozen,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
+
+func TestListAccounts(t *testing.T) {
+	owner, accounts := createRandomAccountsForOwner(t)
+
 	arg := ListAccountsParams{
-		Owner:  lastAccount.Owner,
-		Limit:  5,
-		Offset: 0,
+		Owner:    owner,
+		PageSize: 5,
 	}
 
-	accounts, err := testQueries.ListAccounts(context.Background(), arg)
+	gotAccounts, err := testQueries.ListAccounts(context.Background(), arg)
 	require.NoError(t, err)
-	require.NotEmpty(t, accounts)
+	require.Len(t, gotAccounts, len(accounts))
 
-	for _, account := range accounts {
+	for i, account := range gotAccounts {
 		require.NotEmpty(t, account)
-		require.Equal(t, lastAccount.Owner, account.Owner)
+		require.Equal(t, owner, account.Owner)
+		require.Equal(t, accounts[i].ID, account.ID)
+	}
+
+	// continue after the first account
+	arg.AfterCreatedAt = sql.NullTime{Time: accounts[0].CreatedAt, Valid: true}
+	arg.AfterID = sql.NullInt64{Int64: accounts[0].ID, Valid: true}
+
+	gotAccounts, err = testQueries.ListAccounts(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, gotAccounts, len(accounts)-1)
+	require.Equal(t, accounts[1].ID, gotAccounts[0].ID)
+
+	// only one account per currency
+	arg = ListAccountsParams{
+		Owner:    owner,
+		Currency: sql.NullString{String: util.EUR, Valid: true},
+		PageSize: 5,
+	}
+
+	gotAccounts, err = testQueries.ListAccounts(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, gotAccounts, 1)
+	require.Equal(t, util.EUR, gotAccounts[0].Currency)
+}
+
+func TestListAccountsByBalance(t *testing.T) {
+	owner, _ := createRandomAccountsForOwner(t)
+
+	arg := ListAccountsByBalanceParams{
+		Owner:    owner,
+		PageSize: 5,
+	}
+
+	accounts, err := testQueries.ListAccountsByBalance(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, accounts, 3)
+
+	for i := 1; i < len(accounts); i++ {
+		require.LessOrEqual(t, accounts[i-1].Balance, accounts[i].Balance)
+	}
+}
+
+func TestListAccountsPage(t *testing.T) {
+	store := NewStore(testDB)
+	owner, accounts := createRandomAccountsForOwner(t)
+
+	for _, orderBy := range []string{AccountOrderByCreatedAt, AccountOrderByBalance} {
+		arg := ListAccountsPageParams{
+			Owner:    owner,
+			OrderBy:  orderBy,
+			PageSize: 1,
+		}
+
+		// walk through every page, one account at a time
+		var gotAccounts []Account
+		for {
+			result, err := store.ListAccountsPage(context.Background(), arg)
+			require.NoError(t, err)
+			require.LessOrEqual(t, len(result.Accounts), 1)
+
+			gotAccounts = append(gotAccounts, result.Accounts...)
+			if result.NextPageToken == "" {
+				break
+			}
+			arg.PageToken = result.NextPageToken
+		}
+
+		require.ElementsMatch(t, accounts, gotAccounts)
+	}
+}
+
+func TestListAccountsPageInvalidToken(t *testing.T) {
+	store := NewStore(testDB)
+	owner, _ := createRandomAccountsForOwner(t)
+
+	arg := ListAccountsPageParams{
+		Owner:     owner,
+		PageSize:  1,
+		PageToken: "invalid",
 	}
+
+	_, err := store.ListAccountsPage(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+
+	// a token can't be reused once the filter changes
+	arg.PageToken = ""
+	result, err := store.ListAccountsPage(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, result.NextPageToken)
+
+	arg.PageToken = result.NextPageToken
+	arg.Currency = util.USD
+	_, err = store.ListAccountsPage(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+// createRandomAccountsForOwner creates one account per supported currency for a new user
+func createRandomAccountsForOwner(t *testing.T) (string, []Account) {
+	user := createRandomUser(t)
+
+	var accounts []Account
+	for _, currency := range []string{util.USD, util.EUR, util.CAD} {
+		account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+			Owner:    user.Username,
+			Balance:  util.RandomMoney(),
+			Currency: currency,
+			Type:     util.AccountChecking,
+		})
+		require.NoError(t, err)
+		accounts = append(accounts, account)
+	}
+
+	return user.Username, accounts
 }
@@ -0,0 +1,84 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditTx(t *testing.T) {
+	store := NewStore(testDB)
+	actor := createRandomUser(t)
+	account := createRandomAccount(t)
+
+	arg := CreateAuditLogParams{
+		Actor:   actor.Username,
+		Action:  "freeze_account",
+		Target:  fmt.Sprintf("account:%d", account.ID),
+		Details: json.RawMessage(fmt.Sprintf(`{"account_id":%d}`, account.ID)),
+	}
+
+	var frozen Account
+	result, err := store.AuditTx(context.Background(), AuditTxParams{
+		CreateAuditLogParams: arg,
+		Run: func(q Querier) error {
+			var err error
+			frozen, err = q.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+				ID:     account.ID,
+				Status: util.AccountFrozen,
+			})
+			return err
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountFrozen, frozen.Status)
+
+	auditLog := result.AuditLog
+	require.NotZero(t, auditLog.ID)
+	require.Equal(t, arg.Actor, auditLog.Actor)
+	require.Equal(t, arg.Action, auditLog.Action)
+	require.Equal(t, arg.Target, auditLog.Target)
+	require.JSONEq(t, string(arg.Details), string(auditLog.Details))
+	require.Equal(t, util.AuditSucceeded, auditLog.Outcome)
+	require.Empty(t, auditLog.Error)
+	require.NotZero(t, auditLog.CreatedAt)
+}
+
+func TestAuditTxActionFailed(t *testing.T) {
+	store := NewStore(testDB)
+	actor := createRandomUser(t)
+	account := createRandomAccount(t)
+	actionErr := errors.New("action failed")
+
+	result, err := store.AuditTx(context.Background(), AuditTxParams{
+		CreateAuditLogParams: CreateAuditLogParams{
+			Actor:   actor.Username,
+			Action:  "freeze_account",
+			Target:  fmt.Sprintf("account:%d", account.ID),
+			Details: json.RawMessage(`{}`),
+		},
+		Run: func(q Querier) error {
+			_, err := q.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+				ID:     account.ID,
+				Status: util.AccountFrozen,
+			})
+			require.NoError(t, err)
+			return actionErr
+		},
+	})
+	require.ErrorIs(t, err, actionErr)
+
+	// the change made by the action is rolled back, the attempt is still recorded
+	account2, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.AccountActive, account2.Status)
+
+	require.NotZero(t, result.AuditLog.ID)
+	require.Equal(t, util.AuditFailed, result.AuditLog.Outcome)
+	require.Equal(t, actionErr.Error(), result.AuditLog.Error)
+}
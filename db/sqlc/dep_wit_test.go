@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/JaidenShall/simplebank/util"
 	"github.com/stretchr/testify/require"
 )
 
@@ -34,6 +35,37 @@ func TestDepositTx(t *testing.T) {
 	fmt.Println(">> after deposit:", result.Account.Balance)
 }
 
+func TestDepositTxIdempotent(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccount(t)
+	amount := int64(500)
+
+	arg := DepositTxParams{
+		AccountID: account.ID,
+		Amount:    amount,
+		Idempotency: &IdempotencyParams{
+			Username:    account.Owner,
+			Key:         util.RandomString(32),
+			RequestHash: util.RandomString(64),
+		},
+	}
+
+	result1, err := store.DepositTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	result2, err := store.DepositTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	// the replay returns the original entry and does not deposit again
+	require.Equal(t, result1.Entry.ID, result2.Entry.ID)
+	require.Equal(t, account.Balance+amount, result2.Account.Balance)
+
+	updatedAccount, err := store.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance+amount, updatedAccount.Balance)
+}
+
 func TestWithdrawTx(t *testing.T) {
 	store := NewStore(testDB)
 
@@ -84,5 +116,38 @@ func TestWithdrawTxInsufficientBalance(t *testing.T) {
 	})
 
 	require.Error(t, err)
-	require.Contains(t, err.Error(), "insufficient balance")
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestDepositWithdrawTxAccountNotActive(t *testing.T) {
+	store := NewStore(testDB)
+
+	for _, accountStatus := range []string{util.AccountFrozen, util.AccountClosed} {
+		account := createRandomAccount(t)
+		account, err := testQueries.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+			ID:     account.ID,
+			Status: accountStatus,
+		})
+		require.NoError(t, err)
+
+		_, err = store.DepositTx(context.Background(), DepositTxParams{
+			AccountID: account.ID,
+			Amount:    10,
+		})
+		var notActiveErr *AccountNotActiveError
+		require.ErrorAs(t, err, &notActiveErr)
+		require.Equal(t, account.ID, notActiveErr.AccountID)
+		require.Equal(t, accountStatus, notActiveErr.Status)
+
+		_, err = store.WithdrawTx(context.Background(), WithdrawTxParams{
+			AccountID: account.ID,
+			Amount:    10,
+		})
+		require.ErrorAs(t, err, &notActiveErr)
+
+		// neither transaction touched the balance
+		account2, err := testQueries.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, account2.Balance)
+	}
 }
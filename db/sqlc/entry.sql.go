@@ -7,36 +7,52 @@ package db
 
 import (
 	"context"
+	"database/sql"
 )
 
 const createEntry = `-- name: CreateEntry :one
 INSERT INTO entries (
   account_id,
-  amount
+  amount,
+  memo,
+  reference,
+  category
 ) VALUES (
-  $1, $2
-) RETURNING id, account_id, amount, created_at
+  $1, $2, $3, $4, $5
+) RETURNING id, account_id, amount, created_at, memo, reference, category
 `
 
 type CreateEntryParams struct {
-	AccountID int64 `json:"account_id"`
-	Amount    int64 `json:"amount"`
+	AccountID int64  `json:"account_id"`
+	Amount    int64  `json:"amount"`
+	Memo      string `json:"memo"`
+	Reference string `json:"reference"`
+	Category  string `json:"category"`
 }
 
 func (q *Queries) CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error) {
-	row := q.db.QueryRowContext(ctx, createEntry, arg.AccountID, arg.Amount)
+	row := q.db.QueryRowContext(ctx, createEntry,
+		arg.AccountID,
+		arg.Amount,
+		arg.Memo,
+		arg.Reference,
+		arg.Category,
+	)
 	var i Entry
 	err := row.Scan(
 		&i.ID,
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Memo,
+		&i.Reference,
+		&i.Category,
 	)
 	return i, err
 }
 
 const getEntry = `-- name: GetEntry :one
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, memo, reference, category FROM entries
 WHERE id = $1 LIMIT 1
 `
 
@@ -48,26 +64,54 @@ func (q *Queries) GetEntry(ctx context.Context, id int64) (Entry, error) {
 		&i.AccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.Memo,
+		&i.Reference,
+		&i.Category,
 	)
 	return i, err
 }
 
 const listEntries = `-- name: ListEntries :many
-SELECT id, account_id, amount, created_at FROM entries
+SELECT id, account_id, amount, created_at, memo, reference, category FROM entries
 WHERE account_id = $1
-ORDER BY id
-LIMIT $2
-OFFSET $3
+  AND ($2::bigint IS NULL OR id < $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND ($5::bigint IS NULL OR abs(amount) >= $5)
+  AND ($6::bigint IS NULL OR abs(amount) <= $6)
+  AND ($7::text IS NULL OR to_tsvector('simple', memo) @@ websearch_to_tsquery('simple', $7))
+  AND ($8::varchar IS NULL OR reference = $8)
+  AND ($9::varchar IS NULL OR category = $9)
+ORDER BY id DESC
+LIMIT $10
 `
 
 type ListEntriesParams struct {
-	AccountID int64 `json:"account_id"`
-	Limit     int32 `json:"limit"`
-	Offset    int32 `json:"offset"`
+	AccountID int64          `json:"account_id"`
+	BeforeID  sql.NullInt64  `json:"before_id"`
+	FromTime  sql.NullTime   `json:"from_time"`
+	ToTime    sql.NullTime   `json:"to_time"`
+	MinAmount sql.NullInt64  `json:"min_amount"`
+	MaxAmount sql.NullInt64  `json:"max_amount"`
+	Query     sql.NullString `json:"query"`
+	Reference sql.NullString `json:"reference"`
+	Category  sql.NullString `json:"category"`
+	PageSize  int32          `json:"page_size"`
 }
 
 func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error) {
-	rows, err := q.db.QueryContext(ctx, listEntries, arg.AccountID, arg.Limit, arg.Offset)
+	rows, err := q.db.QueryContext(ctx, listEntries,
+		arg.AccountID,
+		arg.BeforeID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Query,
+		arg.Reference,
+		arg.Category,
+		arg.PageSize,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -80,6 +124,9 @@ func (q *Queries) ListEntries(ctx context.Context, arg ListEntriesParams) ([]Ent
 			&i.AccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.Memo,
+			&i.Reference,
+			&i.Category,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,93 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomEntry(t *testing.T, account Account, amount int64) Entry {
+	arg := CreateEntryParams{
+		AccountID: account.ID,
+		Amount:    amount,
+	}
+
+	entry, err := testQueries.CreateEntry(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, entry)
+
+	require.Equal(t, arg.AccountID, entry.AccountID)
+	require.Equal(t, arg.Amount, entry.Amount)
+
+	require.NotZero(t, entry.ID)
+	require.NotZero(t, entry.CreatedAt)
+
+	return entry
+}
+
+func TestListEntries(t *testing.T) {
+	account := createRandomAccount(t)
+
+	// debits are negative, the amount filter applies to their absolute value
+	amounts := []int64{10, -20, 30, -40, 50}
+	var entries []Entry
+	for _, amount := range amounts {
+		entries = append(entries, createRandomEntry(t, account, amount))
+	}
+
+	arg := ListEntriesParams{
+		AccountID: account.ID,
+		PageSize:  10,
+	}
+
+	gotEntries, err := testQueries.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, gotEntries, len(entries))
+
+	for i, entry := range gotEntries {
+		require.Equal(t, entries[len(entries)-1-i].ID, entry.ID)
+	}
+
+	arg.MinAmount = sql.NullInt64{Int64: 20, Valid: true}
+	arg.MaxAmount = sql.NullInt64{Int64: 40, Valid: true}
+
+	gotEntries, err = testQueries.ListEntries(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, gotEntries, 3)
+	require.Equal(t, int64(-40), gotEntries[0].Amount)
+}
+
+func TestListEntriesPage(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccount(t)
+
+	var entries []Entry
+	for i := 0; i < 5; i++ {
+		entries = append(entries, createRandomEntry(t, account, 10))
+	}
+
+	arg := ListEntriesPageParams{
+		AccountID: account.ID,
+		PageSize:  2,
+	}
+
+	var gotEntries []Entry
+	for {
+		result, err := store.ListEntriesPage(context.Background(), arg)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(result.Entries), 2)
+
+		gotEntries = append(gotEntries, result.Entries...)
+		if result.NextPageToken == "" {
+			break
+		}
+		arg.PageToken = result.NextPageToken
+	}
+	require.ElementsMatch(t, entries, gotEntries)
+
+	arg.PageToken = "invalid"
+	_, err := store.ListEntriesPage(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
@@ -0,0 +1,134 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// ErrInsufficientFunds is returned when a debit would take an account's balance
+// below the overdraft limit it has opted into.
+var ErrInsufficientFunds = errors.New("insufficient funds")
+
+// ErrAccountNotEmpty is returned when closing an account whose balance is not zero.
+var ErrAccountNotEmpty = errors.New("account balance must be zero")
+
+// ErrInterestPending is returned when closing a savings account whose accrued interest
+// hasn't been capitalized yet, closing it would lose the interest.
+var ErrInterestPending = errors.New("account has interest not yet credited")
+
+// ErrAccountInUse is returned when closing an account that still has active holds,
+// scheduled transfers paying out of it or pending payment requests paying into it.
+var ErrAccountInUse = errors.New("account has open holds, scheduled transfers or payment requests")
+
+// ErrAccountNotClosed is returned when reopening an account that isn't closed.
+var ErrAccountNotClosed = errors.New("only closed accounts can be reopened")
+
+// AccountNotActiveError is returned when money is moved in or out of an account
+// that is frozen or closed, or when the status of a closed account is changed.
+type AccountNotActiveError struct {
+	AccountID int64
+	Status    string
+}
+
+func (err *AccountNotActiveError) Error() string {
+	return fmt.Sprintf("account %d is %s", err.AccountID, err.Status)
+}
+
+// SpendingLimitError is returned when a debit would take the money leaving an account,
+// or all the accounts of its owner in its currency, over a spending limit
+type SpendingLimitError struct {
+	// Scope is util.LimitScopeAccount or util.LimitScopeUser
+	Scope string
+	// Limit is util.LimitPerTransaction, util.LimitDaily or util.LimitMonthly
+	Limit string
+	Max   int64
+	// Remaining is what can still be debited within the limit
+	Remaining int64
+}
+
+func (err *SpendingLimitError) Error() string {
+	return fmt.Sprintf("amount exceeds the %s %s spending limit of %d, %d remaining",
+		err.Scope, strings.ReplaceAll(err.Limit, "_", "-"), err.Max, err.Remaining)
+}
+
+// Errors returned by the hold transactions
+var (
+	ErrHoldNotActive      = errors.New("hold is no longer active")
+	ErrHoldExpired        = errors.New("hold has expired")
+	ErrCaptureExceedsHold = errors.New("capture amount exceeds the held amount")
+)
+
+// Errors returned by the reverse transfer transaction
+var (
+	ErrTransferIsReversal      = errors.New("a refund or reversal cannot itself be reversed")
+	ErrTransferFullyRefunded   = errors.New("transfer has already been fully refunded")
+	ErrReversalExceedsTransfer = errors.New("amount exceeds what is left of the transfer")
+)
+
+// ErrRiskReviewNotPending is returned when approving or rejecting a risk decision
+// that was already reviewed or was never held for review
+var ErrRiskReviewNotPending = errors.New("risk decision is not pending review")
+
+// ErrNewPayeeLimit is returned when a transfer to someone else's account the user only
+// started paying recently is over the new payee limit
+var ErrNewPayeeLimit = errors.New("new payee limit exceeded")
+
+// ErrRiskDenied is returned when the risk rules deny a money movement nobody is there to
+// wait on a review of, such as the run of a scheduled transfer
+var ErrRiskDenied = errors.New("denied by the risk rules")
+
+// Errors returned by the payment request transactions
+var (
+	ErrPaymentRequestNotPending = errors.New("payment request is no longer pending")
+	ErrPaymentRequestExpired    = errors.New("payment request has expired")
+)
+
+// TransferBatchLegError is returned when a leg of a transfer batch fails the whole batch
+type TransferBatchLegError struct {
+	Index int
+	Err   error
+}
+
+func (err *TransferBatchLegError) Error() string {
+	return fmt.Sprintf("leg %d: %s", err.Index, err.Err)
+}
+
+func (err *TransferBatchLegError) Unwrap() error {
+	return err.Err
+}
+
+// ErrInvalidPageToken is returned when a page token is malformed or was issued
+// for a different filter or sort order.
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// checkSufficientFunds reports whether the account can be debited by amount
+// without dipping into the held funds
+func checkSufficientFunds(account Account, held int64, amount int64) error {
+	available := account.Balance - held
+	if available+account.OverdraftLimit < amount {
+		return fmt.Errorf("%w: account %d has available balance %d, requested %d",
+			ErrInsufficientFunds, account.ID, available, amount)
+	}
+	return nil
+}
+
+// checkAccountActive reports whether money can be moved in or out of the account
+func checkAccountActive(account Account) error {
+	if account.Status != util.AccountActive {
+		return &AccountNotActiveError{AccountID: account.ID, Status: account.Status}
+	}
+	return nil
+}
+
+// isMoveRejected reports whether a transfer was turned down for insufficient funds,
+// an inactive account, a spending limit, the new payee limit or the risk rules. All are reported
+// before anything is written, so the transaction can go on.
+func isMoveRejected(err error) bool {
+	var notActiveErr *AccountNotActiveError
+	var limitErr *SpendingLimitError
+	return errors.Is(err, ErrInsufficientFunds) || errors.As(err, &notActiveErr) || errors.As(err, &limitErr) ||
+		errors.Is(err, ErrNewPayeeLimit) || errors.Is(err, ErrRiskDenied)
+}
@@ -0,0 +1,115 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/fee"
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// feeSchedule converts a fee schedule row for the fee calculator
+func feeSchedule(schedule FeeSchedule) fee.Schedule {
+	return fee.Schedule{
+		Flat:         schedule.FlatAmount,
+		Rate:         schedule.Rate,
+		Min:          schedule.MinAmount,
+		Max:          schedule.MaxAmount,
+		FreePerMonth: schedule.FreePerMonth,
+	}
+}
+
+// quoteFee returns the fee of an operation moving amount out of the account using q, which
+// must already run inside a transaction holding the lock on the account. The fee follows the
+// schedule of the operation in the currency of the account, ok is false when there is none.
+// The free allowance is counted over the calendar month in UTC.
+// The caller checks the account covers the amount and the fee before writing anything.
+func quoteFee(
+	ctx context.Context,
+	q Querier,
+	account Account,
+	operation string,
+	amount int64,
+) (quote fee.Quote, ok bool, err error) {
+	schedule, err := q.GetFeeSchedule(ctx, GetFeeScheduleParams{
+		Operation: operation,
+		Currency:  account.Currency,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			err = nil
+		}
+		return
+	}
+
+	now := time.Now().UTC()
+	used, err := q.CountFeesSince(ctx, CountFeesSinceParams{
+		AccountID: account.ID,
+		Operation: operation,
+		Since:     time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		return
+	}
+
+	return fee.Calculate(feeSchedule(schedule), amount, used), true, nil
+}
+
+// chargeFee records the fee quoted for an operation of the account using q, once the operation
+// itself has been applied. Free operations are recorded too, so the allowance can be counted.
+// A fee is debited with its own entry and a journal to the fees income account.
+// It returns the fee entry, empty when nothing was charged, and the updated account.
+func chargeFee(
+	ctx context.Context,
+	q Querier,
+	account Account,
+	operation string,
+	quote fee.Quote,
+	transferID sql.NullInt64,
+) (entry Entry, updated Account, err error) {
+	updated = account
+
+	var entryID sql.NullInt64
+	if quote.Amount > 0 {
+		entry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: account.ID,
+			Amount:    -quote.Amount,
+			Memo:      fmt.Sprintf("%s fee", operation),
+		})
+		if err != nil {
+			return
+		}
+		entryID = sql.NullInt64{Int64: entry.ID, Valid: true}
+
+		_, err = postJournal(ctx, q, JournalParams{
+			Kind:       JournalFee,
+			TransferID: transferID,
+			Postings: []PostingParams{
+				{AccountID: account.ID, Currency: account.Currency, Amount: -quote.Amount},
+				{SystemAccount: util.SystemFeesIncome, Currency: account.Currency, Amount: quote.Amount},
+			},
+		})
+		if err != nil {
+			return
+		}
+
+		updated, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     account.ID,
+			Amount: -quote.Amount,
+		})
+		if err != nil {
+			return
+		}
+	}
+
+	_, err = q.CreateFee(ctx, CreateFeeParams{
+		AccountID:  account.ID,
+		Operation:  operation,
+		Amount:     quote.Amount,
+		TransferID: transferID,
+		EntryID:    entryID,
+	})
+	return
+}
@@ -0,0 +1,234 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+// fee schedules apply to every account of a currency, so these tests use one no other test does
+const feeTestCurrency = "JPY"
+
+func setFeeSchedule(t *testing.T, arg UpsertFeeScheduleParams) FeeSchedule {
+	arg.Currency = feeTestCurrency
+	arg.UpdatedBy = createRandomUser(t).Username
+
+	schedule, err := testQueries.UpsertFeeSchedule(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Operation, schedule.Operation)
+	require.Equal(t, arg.FlatAmount, schedule.FlatAmount)
+	require.Equal(t, arg.Rate, schedule.Rate)
+	require.Equal(t, arg.MaxAmount, schedule.MaxAmount)
+	require.Equal(t, arg.FreePerMonth, schedule.FreePerMonth)
+	return schedule
+}
+
+func TestTransferTxFee(t *testing.T) {
+	store := NewStore(testDB)
+
+	setFeeSchedule(t, UpsertFeeScheduleParams{
+		Operation:    util.FeeTransfer,
+		FlatAmount:   10,
+		Rate:         100,
+		MaxAmount:    50,
+		FreePerMonth: 1,
+	})
+
+	account1 := createRandomAccountInCurrency(t, feeTestCurrency, 1000)
+	account2 := createRandomAccountInCurrency(t, feeTestCurrency, 0)
+	arg := TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        200,
+	}
+
+	// the first transfer of the month is free
+	result, err := store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.True(t, result.Fee.Free)
+	require.Zero(t, result.Fee.Amount)
+	require.Zero(t, result.Fee.FreeRemaining)
+	require.Zero(t, result.FeeEntry.ID)
+	require.Equal(t, int64(800), result.FromAccount.Balance)
+
+	// 10 flat plus 1% of 200
+	result, err = store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.False(t, result.Fee.Free)
+	require.Equal(t, int64(12), result.Fee.Amount)
+	require.Equal(t, account1.ID, result.FeeEntry.AccountID)
+	require.Equal(t, int64(-12), result.FeeEntry.Amount)
+	require.Equal(t, int64(588), result.FromAccount.Balance)
+	require.Equal(t, int64(400), result.ToAccount.Balance)
+
+	// the fee must be covered together with the amount
+	arg.Amount = 580
+	_, err = store.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	account1, err = testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(588), account1.Balance)
+
+	count, err := testQueries.CountFeesSince(context.Background(), CountFeesSinceParams{
+		AccountID: account1.ID,
+		Operation: util.FeeTransfer,
+		Since:     time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), count)
+}
+
+func TestWithdrawTxFee(t *testing.T) {
+	store := NewStore(testDB)
+
+	setFeeSchedule(t, UpsertFeeScheduleParams{
+		Operation:  util.FeeWithdrawal,
+		FlatAmount: 100,
+	})
+
+	account := createRandomAccountInCurrency(t, feeTestCurrency, 1000)
+
+	result, err := store.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID: account.ID,
+		Amount:    300,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), result.Fee.Amount)
+	require.Equal(t, int64(-100), result.FeeEntry.Amount)
+	require.Equal(t, int64(600), result.Account.Balance)
+}
+
+func TestFxTransferTxFee(t *testing.T) {
+	store := NewStore(testDB)
+
+	setFeeSchedule(t, UpsertFeeScheduleParams{
+		Operation:  util.FeeTransfer,
+		FlatAmount: 50,
+	})
+
+	fromAccount := createRandomAccountInCurrency(t, feeTestCurrency, 1000)
+	toAccount := createRandomAccountInCurrency(t, util.EUR, 0)
+	createQuote := func() FxQuote {
+		quote, err := testQueries.CreateFxQuote(context.Background(), CreateFxQuoteParams{
+			ID:           uuid.New(),
+			Username:     fromAccount.Owner,
+			FromCurrency: feeTestCurrency,
+			ToCurrency:   util.EUR,
+			Rate:         620_000,
+			ExpiresAt:    time.Now().Add(time.Minute),
+		})
+		require.NoError(t, err)
+		return quote
+	}
+
+	// the fee is charged in the currency of the source account
+	result, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        500,
+		QuoteID:       createQuote().ID,
+		Username:      fromAccount.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(50), result.Fee.Amount)
+	require.Equal(t, int64(-50), result.FeeEntry.Amount)
+	require.Equal(t, int64(450), result.FromAccount.Balance)
+	require.Equal(t, int64(310), result.ToAccount.Balance)
+
+	// the fee must be covered together with the amount
+	_, err = store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        420,
+		QuoteID:       createQuote().ID,
+		Username:      fromAccount.Owner,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+}
+
+func TestTransferBatchTxFee(t *testing.T) {
+	store := NewStore(testDB)
+
+	setFeeSchedule(t, UpsertFeeScheduleParams{
+		Operation:    util.FeeTransfer,
+		FlatAmount:   10,
+		FreePerMonth: 1,
+	})
+
+	fromAccount := createRandomAccountInCurrency(t, feeTestCurrency, 1000)
+	toAccount1 := createRandomAccountInCurrency(t, feeTestCurrency, 0)
+	toAccount2 := createRandomAccountInCurrency(t, feeTestCurrency, 0)
+
+	// the free allowance only covers the first leg
+	result, err := store.TransferBatchTx(context.Background(), TransferBatchTxParams{
+		FromAccountID: fromAccount.ID,
+		Legs: []TransferBatchLeg{
+			{ToAccountID: toAccount1.ID, Amount: 200},
+			{ToAccountID: toAccount2.ID, Amount: 300},
+		},
+		Atomic: true,
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Legs, 2)
+	require.True(t, result.Legs[0].Fee.Free)
+	require.Zero(t, result.Legs[0].Fee.Amount)
+	require.Equal(t, int64(10), result.Legs[1].Fee.Amount)
+	require.Equal(t, int64(490), result.FromAccount.Balance)
+
+	// a leg that can't cover its fee fails like one that can't cover its amount
+	result, err = store.TransferBatchTx(context.Background(), TransferBatchTxParams{
+		FromAccountID: fromAccount.ID,
+		Legs: []TransferBatchLeg{
+			{ToAccountID: toAccount1.ID, Amount: 480},
+			{ToAccountID: toAccount2.ID, Amount: 1},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Legs, 2)
+	require.Empty(t, result.Legs[0].Error)
+	require.Equal(t, int64(10), result.Legs[0].Fee.Amount)
+	require.Contains(t, result.Legs[1].Error, ErrInsufficientFunds.Error())
+	require.Zero(t, result.FromAccount.Balance)
+
+	count, err := testQueries.CountFeesSince(context.Background(), CountFeesSinceParams{
+		AccountID: fromAccount.ID,
+		Operation: util.FeeTransfer,
+		Since:     time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), count)
+}
+
+func TestCaptureHoldTxFee(t *testing.T) {
+	store := NewStore(testDB)
+
+	setFeeSchedule(t, UpsertFeeScheduleParams{
+		Operation:  util.FeeWithdrawal,
+		FlatAmount: 100,
+	})
+
+	account := createRandomAccountInCurrency(t, feeTestCurrency, 1000)
+	hold := createRandomHold(t, account, 800, time.Hour)
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), result.Fee.Amount)
+	require.Equal(t, int64(-100), result.FeeEntry.Amount)
+	require.Equal(t, int64(100), result.Account.Balance)
+
+	// the fee must be covered together with the captured amount
+	account = createRandomAccountInCurrency(t, feeTestCurrency, 1000)
+	hold = createRandomHold(t, account, 950, time.Hour)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.ID})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	hold, err = testQueries.GetHold(context.Background(), hold.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.HoldActive, hold.Status)
+}
@@ -0,0 +1,197 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomAccountInCurrency(t *testing.T, currency string, balance int64) Account {
+	user := createRandomUser(t)
+
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  balance,
+		Currency: currency,
+		Type:     util.AccountChecking,
+	})
+	require.NoError(t, err)
+	return account
+}
+
+func createRandomFxQuote(t *testing.T, username string, rate int64, duration time.Duration) FxQuote {
+	arg := CreateFxQuoteParams{
+		ID:           uuid.New(),
+		Username:     username,
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		Rate:         rate,
+		ExpiresAt:    time.Now().Add(duration),
+	}
+
+	quote, err := testQueries.CreateFxQuote(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.ID, quote.ID)
+	require.Equal(t, arg.Username, quote.Username)
+	require.Equal(t, arg.Rate, quote.Rate)
+	require.WithinDuration(t, arg.ExpiresAt, quote.ExpiresAt, time.Second)
+	require.False(t, quote.UsedAt.Valid)
+
+	return quote
+}
+
+func TestUpsertFxRate(t *testing.T) {
+	user := createRandomUser(t)
+
+	arg := UpsertFxRateParams{
+		FromCurrency: util.CAD,
+		ToCurrency:   util.USD,
+		Rate:         73_000_000,
+		UpdatedBy:    user.Username,
+	}
+	rate1, err := testQueries.UpsertFxRate(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Rate, rate1.Rate)
+
+	arg.Rate = 74_000_000
+	rate2, err := testQueries.UpsertFxRate(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Rate, rate2.Rate)
+	require.False(t, rate2.UpdatedAt.Before(rate1.UpdatedAt))
+
+	rate, err := testQueries.GetFxRate(context.Background(), GetFxRateParams{
+		FromCurrency: util.CAD,
+		ToCurrency:   util.USD,
+	})
+	require.NoError(t, err)
+	require.Equal(t, rate2, rate)
+}
+
+func TestFxTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 1000)
+	toAccount := createRandomAccountInCurrency(t, util.EUR, 0)
+	quote := createRandomFxQuote(t, fromAccount.Owner, 91_734_000, time.Minute)
+
+	result, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        999,
+		QuoteID:       quote.ID,
+		Username:      fromAccount.Owner,
+	})
+	require.NoError(t, err)
+
+	// 999 * 0.91734 = 916.42 is rounded down
+	transfer := result.Transfer
+	require.Equal(t, int64(999), transfer.Amount)
+	require.Equal(t, int64(916), transfer.ToAmount)
+	require.True(t, transfer.FxRate.Valid)
+	require.Equal(t, quote.Rate, transfer.FxRate.Int64)
+	require.True(t, transfer.FxQuoteID.Valid)
+	require.Equal(t, quote.ID, transfer.FxQuoteID.UUID)
+
+	require.Equal(t, int64(-999), result.FromEntry.Amount)
+	require.Equal(t, int64(916), result.ToEntry.Amount)
+	require.Equal(t, int64(1), result.FromAccount.Balance)
+	require.Equal(t, int64(916), result.ToAccount.Balance)
+
+	// the fx position takes the USD in and pays the EUR out
+	requireJournal(t, result.Journal, JournalTransfer,
+		map[int64]int64{fromAccount.ID: -999, toAccount.ID: 916},
+		map[string]int64{
+			systemKey(util.SystemFXPosition, util.USD): 999,
+			systemKey(util.SystemFXPosition, util.EUR): -916,
+		})
+
+	// a quote can only be used once
+	_, err = store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        1,
+		QuoteID:       quote.ID,
+		Username:      fromAccount.Owner,
+	})
+	require.ErrorIs(t, err, ErrFxQuoteUsed)
+}
+
+func TestFxTransferTxQuoteErrors(t *testing.T) {
+	store := NewStore(testDB)
+
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 1000)
+	toAccount := createRandomAccountInCurrency(t, util.EUR, 0)
+	cadAccount := createRandomAccountInCurrency(t, util.CAD, 0)
+
+	testCases := []struct {
+		name        string
+		quote       func() FxQuote
+		toAccountID int64
+		amount      int64
+		username    string
+		wantErr     error
+	}{
+		{
+			name:        "NotFound",
+			quote:       func() FxQuote { return FxQuote{ID: uuid.New()} },
+			toAccountID: toAccount.ID,
+			amount:      100,
+			username:    fromAccount.Owner,
+			wantErr:     ErrFxQuoteNotFound,
+		},
+		{
+			name:        "OtherUser",
+			quote:       func() FxQuote { return createRandomFxQuote(t, toAccount.Owner, util.FXRateScale, time.Minute) },
+			toAccountID: toAccount.ID,
+			amount:      100,
+			username:    fromAccount.Owner,
+			wantErr:     ErrFxQuoteNotFound,
+		},
+		{
+			name:        "Expired",
+			quote:       func() FxQuote { return createRandomFxQuote(t, fromAccount.Owner, util.FXRateScale, -time.Second) },
+			toAccountID: toAccount.ID,
+			amount:      100,
+			username:    fromAccount.Owner,
+			wantErr:     ErrFxQuoteExpired,
+		},
+		{
+			name:        "CurrencyMismatch",
+			quote:       func() FxQuote { return createRandomFxQuote(t, fromAccount.Owner, util.FXRateScale, time.Minute) },
+			toAccountID: cadAccount.ID,
+			amount:      100,
+			username:    fromAccount.Owner,
+			wantErr:     ErrFxQuoteMismatch,
+		},
+		{
+			name:        "AmountTooSmall",
+			quote:       func() FxQuote { return createRandomFxQuote(t, fromAccount.Owner, util.FXRateScale/2, time.Minute) },
+			toAccountID: toAccount.ID,
+			amount:      1,
+			username:    fromAccount.Owner,
+			wantErr:     ErrFxAmountTooSmall,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+				FromAccountID: fromAccount.ID,
+				ToAccountID:   tc.toAccountID,
+				Amount:        tc.amount,
+				QuoteID:       tc.quote().ID,
+				Username:      tc.username,
+			})
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+
+	// nothing was moved
+	account, err := testQueries.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, fromAccount.Balance, account.Balance)
+}
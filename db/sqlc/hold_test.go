@@ -0,0 +1,161 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomHold(t *testing.T, account Account, amount int64, duration time.Duration) Hold {
+	store := NewStore(testDB)
+
+	result, err := store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		AccountID: account.ID,
+		Amount:    amount,
+		ExpiresAt: time.Now().Add(duration),
+	})
+	require.NoError(t, err)
+
+	hold := result.Hold
+	require.NotZero(t, hold.ID)
+	require.Equal(t, account.ID, hold.AccountID)
+	require.Equal(t, amount, hold.Amount)
+	require.Zero(t, hold.CapturedAmount)
+	require.Equal(t, util.HoldActive, hold.Status)
+	require.False(t, hold.EntryID.Valid)
+	require.False(t, hold.SettledAt.Valid)
+
+	return hold
+}
+
+func TestCreateHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 100)
+
+	result, err := store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		AccountID: account.ID,
+		Amount:    60,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(40), result.AvailableBalance)
+	require.Equal(t, int64(100), result.Account.Balance)
+
+	// the held funds can't be spent or held again
+	_, err = store.CreateHoldTx(context.Background(), CreateHoldTxParams{
+		AccountID: account.ID,
+		Amount:    41,
+		ExpiresAt: time.Now().Add(time.Hour),
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = store.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID: account.ID,
+		Amount:    41,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = store.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID: account.ID,
+		Amount:    40,
+	})
+	require.NoError(t, err)
+
+	held, err := testQueries.GetHeldAmount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(60), held)
+}
+
+func TestCaptureHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 100)
+	hold := createRandomHold(t, account, 60, time.Hour)
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: 45,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, util.HoldCaptured, result.Hold.Status)
+	require.Equal(t, int64(45), result.Hold.CapturedAmount)
+	require.True(t, result.Hold.SettledAt.Valid)
+	require.Equal(t, result.Entry.ID, result.Hold.EntryID.Int64)
+	require.Equal(t, int64(-45), result.Entry.Amount)
+	require.Equal(t, int64(55), result.Account.Balance)
+	requireJournal(t, result.Journal, JournalHoldCapture,
+		map[int64]int64{account.ID: -45},
+		map[string]int64{systemKey(util.SystemCashVault, account.Currency): 45})
+
+	// the uncaptured part is available again
+	held, err := testQueries.GetHeldAmount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Zero(t, held)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotActive)
+}
+
+func TestCaptureHoldTxFull(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 100)
+	hold := createRandomHold(t, account, 60, time.Hour)
+
+	_, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: 61,
+	})
+	require.ErrorIs(t, err, ErrCaptureExceedsHold)
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.ID})
+	require.NoError(t, err)
+	require.Equal(t, int64(60), result.Hold.CapturedAmount)
+	require.Equal(t, int64(40), result.Account.Balance)
+}
+
+func TestReleaseHoldTx(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 100)
+	hold := createRandomHold(t, account, 60, time.Hour)
+
+	result, err := store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: hold.ID})
+	require.NoError(t, err)
+	require.Equal(t, util.HoldReleased, result.Hold.Status)
+	require.Zero(t, result.Hold.CapturedAmount)
+	require.True(t, result.Hold.SettledAt.Valid)
+
+	held, err := testQueries.GetHeldAmount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Zero(t, held)
+
+	_, err = store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotActive)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.ID})
+	require.ErrorIs(t, err, ErrHoldNotActive)
+}
+
+func TestExpiredHold(t *testing.T) {
+	store := NewStore(testDB)
+	account := createRandomAccountWithBalance(t, 100)
+	hold := createRandomHold(t, account, 60, -time.Second)
+
+	// an expired hold stops counting even before it is released
+	held, err := testQueries.GetHeldAmount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Zero(t, held)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.ID})
+	require.ErrorIs(t, err, ErrHoldExpired)
+
+	holds, err := testQueries.ListExpiredHolds(context.Background(), 1000)
+	require.NoError(t, err)
+	require.Contains(t, holds, hold)
+
+	result, err := store.ReleaseHoldTx(context.Background(), ReleaseHoldTxParams{HoldID: hold.ID})
+	require.NoError(t, err)
+	require.Equal(t, util.HoldExpired, result.Hold.Status)
+}
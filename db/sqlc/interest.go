@@ -0,0 +1,187 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// interestBatchSize is how many accounts accrue interest in each transaction
+const interestBatchSize = 500
+
+// AccrueInterestParams contains the input parameters of the interest accrual
+type AccrueInterestParams struct {
+	// Date is the UTC day to accrue, only its year, month and day are used
+	Date time.Time `json:"date"`
+	// Rate is the annual rate of savings accounts in basis points
+	Rate int32 `json:"rate"`
+}
+
+// AccrueInterestResult is the result of the interest accrual
+type AccrueInterestResult struct {
+	Date time.Time `json:"date"`
+	// Accounts is the number of accounts that accrued interest
+	Accounts int `json:"accounts"`
+	// Amount is the whole units of interest accrued over all accounts
+	Amount int64 `json:"amount"`
+}
+
+// AccrueInterest records the interest every savings account earns on arg.Date, computed on its
+// balance snapshot of that day, so the snapshots must have been written by ReconcileBalances first.
+// The fraction of a unit left over is carried to the next day of the account, see util.DailyInterest.
+// Accounts that already accrued interest for the day are skipped, so it can safely run again.
+// Accounts accrue in batches, each in its own transaction.
+func (store *SQLStore) AccrueInterest(ctx context.Context, arg AccrueInterestParams) (AccrueInterestResult, error) {
+	date := time.Date(arg.Date.Year(), arg.Date.Month(), arg.Date.Day(), 0, 0, 0, 0, time.UTC)
+	result := AccrueInterestResult{Date: date}
+
+	var afterID int64
+	for {
+		var rows []ListInterestAccrualAccountsRow
+		var amount int64
+		err := store.execTx(ctx, func(q *Queries) error {
+			var err error
+			rows, err = q.ListInterestAccrualAccounts(ctx, ListInterestAccrualAccountsParams{
+				AccrualDate: date,
+				AfterID:     afterID,
+				PageSize:    interestBatchSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			amount = 0
+			for _, row := range rows {
+				accrual, err := q.CreateInterestAccrual(ctx, accrualParams(row, date, arg.Rate))
+				if err != nil {
+					return err
+				}
+				amount += accrual.Amount
+			}
+			return nil
+		})
+		if err != nil {
+			return result, err
+		}
+
+		result.Accounts += len(rows)
+		result.Amount += amount
+		if len(rows) < interestBatchSize {
+			break
+		}
+		afterID = rows[len(rows)-1].AccountID
+	}
+
+	return result, nil
+}
+
+func accrualParams(row ListInterestAccrualAccountsRow, date time.Time, rate int32) CreateInterestAccrualParams {
+	amount, remainder := util.DailyInterest(row.Balance, rate, row.Remainder)
+	return CreateInterestAccrualParams{
+		AccountID:   row.AccountID,
+		AccrualDate: date,
+		Balance:     row.Balance,
+		Rate:        rate,
+		Amount:      amount,
+		Remainder:   remainder,
+	}
+}
+
+// CapitalizeInterestTxParams contains the input parameters of the capitalize interest transaction
+type CapitalizeInterestTxParams struct {
+	AccountID int64 `json:"account_id"`
+	// Before is the first day not capitalized, usually the first day of the current month
+	Before time.Time `json:"before"`
+}
+
+// CapitalizeInterestTxResult is the result of the capitalize interest transaction.
+// Entry and Journal are empty when the accruals add up to nothing.
+type CapitalizeInterestTxResult struct {
+	Account  Account           `json:"account"`
+	Entry    Entry             `json:"entry"`
+	Journal  Journal           `json:"journal"`
+	Accruals []InterestAccrual `json:"accruals"`
+}
+
+// CapitalizeInterestTx credits the account with the interest it accrued before arg.Before and
+// not yet capitalized, within a database transaction. It creates an interest entry, posts
+// a journal from the interest expense account and marks the accruals with the entry.
+// Frozen accounts are credited too, the interest was earned before they were frozen.
+// The fractions of a unit carried by the accruals are left to the next days.
+func (store *SQLStore) CapitalizeInterestTx(ctx context.Context, arg CapitalizeInterestTxParams) (CapitalizeInterestTxResult, error) {
+	var result CapitalizeInterestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Account, err = q.GetAccountForUpdate(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		// marking the accruals returns exactly the ones capitalized, even with another accrual running
+		result.Accruals, err = q.CapitalizeInterestAccruals(ctx, CapitalizeInterestAccrualsParams{
+			AccountID: arg.AccountID,
+			Before:    arg.Before,
+		})
+		if err != nil {
+			return err
+		}
+
+		var amount int64
+		ids := make([]int64, len(result.Accruals))
+		for i, accrual := range result.Accruals {
+			amount += accrual.Amount
+			ids[i] = accrual.ID
+		}
+		if amount == 0 {
+			return nil
+		}
+
+		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: arg.AccountID,
+			Amount:    amount,
+			Memo:      "Interest",
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Journal, err = postJournal(ctx, q, JournalParams{
+			Kind: JournalInterest,
+			Postings: []PostingParams{
+				{AccountID: arg.AccountID, Currency: result.Account.Currency, Amount: amount},
+				{SystemAccount: util.SystemInterestExpense, Currency: result.Account.Currency, Amount: -amount},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     arg.AccountID,
+			Amount: amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		entryID := sql.NullInt64{Int64: result.Entry.ID, Valid: true}
+		err = q.SetInterestAccrualsEntry(ctx, SetInterestAccrualsEntryParams{
+			EntryID: entryID,
+			Ids:     ids,
+		})
+		if err != nil {
+			return err
+		}
+
+		for i := range result.Accruals {
+			result.Accruals[i].EntryID = entryID
+		}
+		return nil
+	})
+
+	return result, err
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccrueAndCapitalizeInterest(t *testing.T) {
+	store := NewStore(testDB)
+
+	user := createRandomUser(t)
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  1_000_000,
+		Currency: util.USD,
+		Type:     util.AccountSavings,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountSavings, account.Type)
+
+	// interest is computed on the snapshot, written by the reconciliation
+	today := time.Now().UTC()
+	_, err = store.ReconcileBalances(context.Background(), ReconcileBalancesParams{Date: today})
+	require.NoError(t, err)
+
+	result, err := store.AccrueInterest(context.Background(), AccrueInterestParams{Date: today, Rate: 365})
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, result.Accounts, 1)
+	require.GreaterOrEqual(t, result.Amount, int64(100))
+
+	// the day is only accrued once
+	result, err = store.AccrueInterest(context.Background(), AccrueInterestParams{Date: today, Rate: 365})
+	require.NoError(t, err)
+	require.Zero(t, result.Accounts)
+
+	tomorrow := time.Date(today.Year(), today.Month(), today.Day()+1, 0, 0, 0, 0, time.UTC)
+	capitalized, err := store.CapitalizeInterestTx(context.Background(), CapitalizeInterestTxParams{
+		AccountID: account.ID,
+		Before:    tomorrow,
+	})
+	require.NoError(t, err)
+	require.Len(t, capitalized.Accruals, 1)
+	require.Equal(t, int64(100), capitalized.Accruals[0].Amount)
+	require.Zero(t, capitalized.Accruals[0].Remainder)
+	require.True(t, capitalized.Accruals[0].CapitalizedAt.Valid)
+	require.Equal(t, capitalized.Entry.ID, capitalized.Accruals[0].EntryID.Int64)
+	require.Equal(t, int64(100), capitalized.Entry.Amount)
+	require.Equal(t, int64(1_000_100), capitalized.Account.Balance)
+	requireJournal(t, capitalized.Journal, JournalInterest,
+		map[int64]int64{account.ID: 100},
+		map[string]int64{systemKey(util.SystemInterestExpense, util.USD): -100})
+
+	// nothing is left to capitalize
+	capitalized, err = store.CapitalizeInterestTx(context.Background(), CapitalizeInterestTxParams{
+		AccountID: account.ID,
+		Before:    tomorrow,
+	})
+	require.NoError(t, err)
+	require.Empty(t, capitalized.Accruals)
+	require.Zero(t, capitalized.Entry.ID)
+	require.Equal(t, int64(1_000_100), capitalized.Account.Balance)
+}
+
+func TestCloseSavingsAccountWithPendingInterest(t *testing.T) {
+	store := NewStore(testDB)
+
+	user := createRandomUser(t)
+	account, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    user.Username,
+		Balance:  1_000_000,
+		Currency: util.USD,
+		Type:     util.AccountSavings,
+	})
+	require.NoError(t, err)
+
+	today := time.Now().UTC()
+	_, err = store.ReconcileBalances(context.Background(), ReconcileBalancesParams{Date: today})
+	require.NoError(t, err)
+	_, err = store.AccrueInterest(context.Background(), AccrueInterestParams{Date: today, Rate: 365})
+	require.NoError(t, err)
+
+	_, err = testQueries.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: -1_000_000,
+	})
+	require.NoError(t, err)
+
+	// the accrued interest hasn't been credited, closing now would lose it
+	_, err = store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    util.AccountClosed,
+	})
+	require.ErrorIs(t, err, ErrInterestPending)
+
+	tomorrow := time.Date(today.Year(), today.Month(), today.Day()+1, 0, 0, 0, 0, time.UTC)
+	capitalized, err := store.CapitalizeInterestTx(context.Background(), CapitalizeInterestTxParams{
+		AccountID: account.ID,
+		Before:    tomorrow,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), capitalized.Account.Balance)
+
+	_, err = testQueries.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     account.ID,
+		Amount: -100,
+	})
+	require.NoError(t, err)
+
+	result, err := store.ChangeAccountStatusTx(context.Background(), ChangeAccountStatusTxParams{
+		AccountID: account.ID,
+		Status:    util.AccountClosed,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.AccountClosed, result.Account.Status)
+}
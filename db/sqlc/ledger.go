@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Kinds of journals recorded by the money transactions
+const (
+	JournalDeposit     = "deposit"
+	JournalWithdrawal  = "withdrawal"
+	JournalTransfer    = "transfer"
+	JournalHoldCapture = "hold_capture"
+	JournalRefund      = "refund"
+	JournalReversal    = "reversal"
+	JournalInterest    = "interest"
+	JournalFee         = "fee"
+)
+
+// ErrUnbalancedJournal is returned when the postings of a journal don't sum to zero in every currency
+var ErrUnbalancedJournal = errors.New("journal is not balanced")
+
+// PostingParams is one leg of a journal. It posts to the customer account AccountID
+// when it is set, and otherwise to the system account with the code SystemAccount.
+type PostingParams struct {
+	AccountID     int64
+	SystemAccount string
+	Currency      string
+	Amount        int64
+}
+
+// JournalParams contains the input parameters of a journal
+type JournalParams struct {
+	Kind       string
+	TransferID sql.NullInt64
+	Postings   []PostingParams
+}
+
+// postJournal records a balanced journal using q, which must already run inside a transaction.
+// It only writes the ledger, the caller keeps the customer account balances in step with it.
+func postJournal(ctx context.Context, q Querier, arg JournalParams) (Journal, error) {
+	err := checkBalanced(arg.Postings)
+	if err != nil {
+		return Journal{}, err
+	}
+
+	journal, err := q.CreateJournal(ctx, CreateJournalParams{
+		Kind:       arg.Kind,
+		TransferID: arg.TransferID,
+	})
+	if err != nil {
+		return Journal{}, err
+	}
+
+	for _, posting := range arg.Postings {
+		createArg := CreatePostingParams{
+			JournalID: journal.ID,
+			Currency:  posting.Currency,
+			Amount:    posting.Amount,
+		}
+
+		if posting.AccountID != 0 {
+			createArg.AccountID = sql.NullInt64{Int64: posting.AccountID, Valid: true}
+		} else {
+			account, err := systemAccount(ctx, q, posting.SystemAccount, posting.Currency)
+			if err != nil {
+				return Journal{}, err
+			}
+			createArg.SystemAccountID = sql.NullInt64{Int64: account.ID, Valid: true}
+		}
+
+		_, err = q.CreatePosting(ctx, createArg)
+		if err != nil {
+			return Journal{}, err
+		}
+	}
+
+	return journal, nil
+}
+
+// checkBalanced reports whether the postings sum to zero in every currency
+func checkBalanced(postings []PostingParams) error {
+	sums := make(map[string]int64)
+	for _, posting := range postings {
+		sums[posting.Currency] += posting.Amount
+	}
+
+	currencies := make([]string, 0, len(sums))
+	for currency := range sums {
+		currencies = append(currencies, currency)
+	}
+	sort.Strings(currencies)
+
+	for _, currency := range currencies {
+		if sums[currency] != 0 {
+			return fmt.Errorf("%w: %s postings sum to %d", ErrUnbalancedJournal, currency, sums[currency])
+		}
+	}
+	return nil
+}
+
+// systemAccount returns the system account with the code in the currency,
+// creating it the first time the currency is used.
+func systemAccount(ctx context.Context, q Querier, code string, currency string) (SystemAccount, error) {
+	arg := GetSystemAccountParams{
+		Code:     code,
+		Currency: currency,
+	}
+
+	account, err := q.GetSystemAccount(ctx, arg)
+	if err != sql.ErrNoRows {
+		return account, err
+	}
+
+	// a concurrent transaction creating the same account makes the insert wait, then do nothing
+	account, err = q.CreateSystemAccount(ctx, CreateSystemAccountParams(arg))
+	if err != sql.ErrNoRows {
+		return account, err
+	}
+	return q.GetSystemAccount(ctx, arg)
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+// systemKey identifies a system account in the expectations of requireJournal
+func systemKey(code string, currency string) string {
+	return code + ":" + currency
+}
+
+// requireJournal checks the postings of the journal against the expected amounts,
+// keyed by customer account id and by systemKey
+func requireJournal(t *testing.T, journal Journal, kind string, accounts map[int64]int64, systemAccounts map[string]int64) {
+	require.NotZero(t, journal.ID)
+	require.Equal(t, kind, journal.Kind)
+
+	systemAmounts := make(map[int64]int64)
+	for key, amount := range systemAccounts {
+		code, currency, _ := strings.Cut(key, ":")
+		systemAccount, err := testQueries.GetSystemAccount(context.Background(), GetSystemAccountParams{
+			Code:     code,
+			Currency: currency,
+		})
+		require.NoError(t, err)
+		systemAmounts[systemAccount.ID] = amount
+	}
+
+	postings, err := testQueries.ListJournalPostings(context.Background(), journal.ID)
+	require.NoError(t, err)
+	require.Len(t, postings, len(accounts)+len(systemAccounts))
+
+	sums := make(map[string]int64)
+	for _, posting := range postings {
+		sums[posting.Currency] += posting.Amount
+
+		if posting.AccountID.Valid {
+			require.Equal(t, accounts[posting.AccountID.Int64], posting.Amount)
+		} else {
+			require.Equal(t, systemAmounts[posting.SystemAccountID.Int64], posting.Amount)
+		}
+	}
+
+	for currency, sum := range sums {
+		require.Zero(t, sum, "%s postings are not balanced", currency)
+	}
+}
+
+func TestDepositWithdrawTxJournal(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccountWithBalance(t, 0)
+
+	deposit, err := store.DepositTx(context.Background(), DepositTxParams{
+		AccountID: account.ID,
+		Amount:    100,
+	})
+	require.NoError(t, err)
+	requireJournal(t, deposit.Journal, JournalDeposit,
+		map[int64]int64{account.ID: 100},
+		map[string]int64{systemKey(util.SystemCashVault, account.Currency): -100})
+
+	withdrawal, err := store.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID: account.ID,
+		Amount:    40,
+	})
+	require.NoError(t, err)
+	requireJournal(t, withdrawal.Journal, JournalWithdrawal,
+		map[int64]int64{account.ID: -40},
+		map[string]int64{systemKey(util.SystemCashVault, account.Currency): 40})
+}
+
+func TestTransferTxJournal(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountInCurrency(t, util.USD, 100)
+	account2 := createRandomAccountInCurrency(t, util.USD, 0)
+
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        30,
+	})
+	require.NoError(t, err)
+	require.Equal(t, sql.NullInt64{Int64: result.Transfer.ID, Valid: true}, result.Journal.TransferID)
+	requireJournal(t, result.Journal, JournalTransfer,
+		map[int64]int64{account1.ID: -30, account2.ID: 30},
+		nil)
+}
+
+func TestTrialBalance(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccountWithBalance(t, 0)
+	_, err := store.DepositTx(context.Background(), DepositTxParams{
+		AccountID: account.ID,
+		Amount:    250,
+	})
+	require.NoError(t, err)
+
+	lines, err := testQueries.GetTrialBalance(context.Background())
+	require.NoError(t, err)
+	require.NotEmpty(t, lines)
+
+	for _, line := range lines {
+		require.Zero(t, line.Net, "%s is not balanced", line.Currency)
+		require.Equal(t, line.Credits, line.Debits)
+		require.Positive(t, line.Postings)
+	}
+
+	balances, err := testQueries.ListSystemAccountBalances(context.Background())
+	require.NoError(t, err)
+
+	var found bool
+	for _, balance := range balances {
+		if balance.Code == util.SystemCashVault && balance.Currency == account.Currency {
+			found = true
+			require.Negative(t, balance.Balance)
+		}
+	}
+	require.True(t, found)
+}
+
+func TestCheckBalanced(t *testing.T) {
+	err := checkBalanced([]PostingParams{
+		{AccountID: 1, Currency: util.USD, Amount: -100},
+		{AccountID: 2, Currency: util.EUR, Amount: 90},
+		{SystemAccount: util.SystemFXPosition, Currency: util.USD, Amount: 100},
+		{SystemAccount: util.SystemFXPosition, Currency: util.EUR, Amount: -90},
+	})
+	require.NoError(t, err)
+
+	err = checkBalanced([]PostingParams{
+		{AccountID: 1, Currency: util.USD, Amount: -100},
+		{AccountID: 2, Currency: util.EUR, Amount: 90},
+	})
+	require.ErrorIs(t, err, ErrUnbalancedJournal)
+}
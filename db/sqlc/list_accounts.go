@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// Columns ListAccountsPage can sort by
+const (
+	AccountOrderByCreatedAt = "created_at"
+	AccountOrderByBalance   = "balance"
+)
+
+// ListAccountsPageParams contains the input parameters of the list accounts page
+type ListAccountsPageParams struct {
+	Owner string
+	// Currency only returns accounts in this currency when set
+	Currency string
+	// OrderBy is one of the AccountOrderBy constants, created_at by default
+	OrderBy   string
+	PageSize  int32
+	PageToken string
+}
+
+// ListAccountsPageResult is the result of the list accounts page
+type ListAccountsPageResult struct {
+	Accounts      []Account
+	NextPageToken string
+}
+
+// accountCursor points at the last account of a page. The filter and sort order
+// are part of it so a token can't be replayed against a different query.
+type accountCursor struct {
+	OrderBy   string    `json:"o"`
+	Currency  string    `json:"c,omitempty"`
+	CreatedAt time.Time `json:"t,omitempty"`
+	Balance   int64     `json:"b,omitempty"`
+	ID        int64     `json:"i"`
+}
+
+// ListAccountsPage returns one page of the owner's accounts using keyset pagination.
+// NextPageToken is empty on the last page.
+func (store *SQLStore) ListAccountsPage(ctx context.Context, arg ListAccountsPageParams) (ListAccountsPageResult, error) {
+	var result ListAccountsPageResult
+
+	orderBy := arg.OrderBy
+	if orderBy == "" {
+		orderBy = AccountOrderByCreatedAt
+	}
+
+	pageSize := arg.PageSize
+	if pageSize <= 0 {
+		pageSize = util.DefaultPageSize
+	}
+
+	var cursor *accountCursor
+	if arg.PageToken != "" {
+		cursor = &accountCursor{}
+		if err := util.DecodePageToken(arg.PageToken, cursor); err != nil {
+			return result, fmt.Errorf("%w: %s", ErrInvalidPageToken, err)
+		}
+		if cursor.OrderBy != orderBy || cursor.Currency != arg.Currency {
+			return result, fmt.Errorf("%w: filter or order changed between pages", ErrInvalidPageToken)
+		}
+	}
+
+	currency := sql.NullString{String: arg.Currency, Valid: arg.Currency != ""}
+
+	// fetch one extra row to find out whether there is another page
+	var accounts []Account
+	var err error
+	switch orderBy {
+	case AccountOrderByCreatedAt:
+		listArg := ListAccountsParams{
+			Owner:    arg.Owner,
+			Currency: currency,
+			PageSize: pageSize + 1,
+		}
+		if cursor != nil {
+			listArg.AfterCreatedAt = sql.NullTime{Time: cursor.CreatedAt, Valid: true}
+			listArg.AfterID = sql.NullInt64{Int64: cursor.ID, Valid: true}
+		}
+		accounts, err = store.ListAccounts(ctx, listArg)
+	case AccountOrderByBalance:
+		listArg := ListAccountsByBalanceParams{
+			Owner:    arg.Owner,
+			Currency: currency,
+			PageSize: pageSize + 1,
+		}
+		if cursor != nil {
+			listArg.AfterBalance = sql.NullInt64{Int64: cursor.Balance, Valid: true}
+			listArg.AfterID = sql.NullInt64{Int64: cursor.ID, Valid: true}
+		}
+		accounts, err = store.ListAccountsByBalance(ctx, listArg)
+	default:
+		return result, fmt.Errorf("unsupported order: %s", orderBy)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if len(accounts) > int(pageSize) {
+		accounts = accounts[:pageSize]
+		last := accounts[len(accounts)-1]
+
+		result.NextPageToken, err = util.EncodePageToken(accountCursor{
+			OrderBy:   orderBy,
+			Currency:  arg.Currency,
+			CreatedAt: last.CreatedAt,
+			Balance:   last.Balance,
+			ID:        last.ID,
+		})
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.Accounts = accounts
+	return result, nil
+}
@@ -0,0 +1,199 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// HistoryFilter narrows down the entries or transfers of an account.
+// Zero values leave the corresponding bound open.
+type HistoryFilter struct {
+	// FromTime is inclusive, ToTime is exclusive
+	FromTime time.Time `json:"from,omitempty"`
+	ToTime   time.Time `json:"to,omitempty"`
+	// MinAmount and MaxAmount are compared against the absolute amount
+	MinAmount int64 `json:"min,omitempty"`
+	MaxAmount int64 `json:"max,omitempty"`
+	// Query is a full-text search on the memo, in web search syntax
+	Query string `json:"q,omitempty"`
+	// Reference and Category must match exactly
+	Reference string `json:"r,omitempty"`
+	Category  string `json:"c,omitempty"`
+}
+
+func (filter HistoryFilter) equal(other HistoryFilter) bool {
+	return filter.FromTime.Equal(other.FromTime) &&
+		filter.ToTime.Equal(other.ToTime) &&
+		filter.MinAmount == other.MinAmount &&
+		filter.MaxAmount == other.MaxAmount &&
+		filter.Query == other.Query &&
+		filter.Reference == other.Reference &&
+		filter.Category == other.Category
+}
+
+// ListEntriesPageParams contains the input parameters of the list entries page
+type ListEntriesPageParams struct {
+	AccountID int64
+	Filter    HistoryFilter
+	PageSize  int32
+	PageToken string
+}
+
+// ListEntriesPageResult is the result of the list entries page
+type ListEntriesPageResult struct {
+	Entries       []Entry
+	NextPageToken string
+}
+
+// ListTransfersPageParams contains the input parameters of the list transfers page
+type ListTransfersPageParams struct {
+	AccountID int64
+	Filter    HistoryFilter
+	PageSize  int32
+	PageToken string
+}
+
+// ListTransfersPageResult is the result of the list transfers page
+type ListTransfersPageResult struct {
+	Transfers     []Transfer
+	NextPageToken string
+}
+
+// historyCursor points at the last row of a history page, newest first
+type historyCursor struct {
+	AccountID int64         `json:"a"`
+	Filter    HistoryFilter `json:"f"`
+	BeforeID  int64         `json:"i"`
+}
+
+// ListEntriesPage returns one page of an account's entries, newest first.
+// NextPageToken is empty on the last page.
+func (store *SQLStore) ListEntriesPage(ctx context.Context, arg ListEntriesPageParams) (ListEntriesPageResult, error) {
+	var result ListEntriesPageResult
+
+	pageSize := historyPageSize(arg.PageSize)
+	beforeID, err := decodeHistoryCursor(arg.PageToken, arg.AccountID, arg.Filter)
+	if err != nil {
+		return result, err
+	}
+
+	fromTime, toTime, minAmount, maxAmount := arg.Filter.params()
+	query, reference, category := arg.Filter.textParams()
+	entries, err := store.ListEntries(ctx, ListEntriesParams{
+		AccountID: arg.AccountID,
+		BeforeID:  beforeID,
+		FromTime:  fromTime,
+		ToTime:    toTime,
+		MinAmount: minAmount,
+		MaxAmount: maxAmount,
+		Query:     query,
+		Reference: reference,
+		Category:  category,
+		PageSize:  pageSize + 1,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if len(entries) > int(pageSize) {
+		entries = entries[:pageSize]
+		result.NextPageToken, err = encodeHistoryCursor(arg.AccountID, arg.Filter, entries[len(entries)-1].ID)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.Entries = entries
+	return result, nil
+}
+
+// ListTransfersPage returns one page of the transfers going in or out of an account,
+// newest first. NextPageToken is empty on the last page.
+func (store *SQLStore) ListTransfersPage(ctx context.Context, arg ListTransfersPageParams) (ListTransfersPageResult, error) {
+	var result ListTransfersPageResult
+
+	pageSize := historyPageSize(arg.PageSize)
+	beforeID, err := decodeHistoryCursor(arg.PageToken, arg.AccountID, arg.Filter)
+	if err != nil {
+		return result, err
+	}
+
+	fromTime, toTime, minAmount, maxAmount := arg.Filter.params()
+	query, reference, category := arg.Filter.textParams()
+	transfers, err := store.ListTransfers(ctx, ListTransfersParams{
+		AccountID: arg.AccountID,
+		BeforeID:  beforeID,
+		FromTime:  fromTime,
+		ToTime:    toTime,
+		MinAmount: minAmount,
+		MaxAmount: maxAmount,
+		Query:     query,
+		Reference: reference,
+		Category:  category,
+		PageSize:  pageSize + 1,
+	})
+	if err != nil {
+		return result, err
+	}
+
+	if len(transfers) > int(pageSize) {
+		transfers = transfers[:pageSize]
+		result.NextPageToken, err = encodeHistoryCursor(arg.AccountID, arg.Filter, transfers[len(transfers)-1].ID)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	result.Transfers = transfers
+	return result, nil
+}
+
+func (filter HistoryFilter) params() (fromTime, toTime sql.NullTime, minAmount, maxAmount sql.NullInt64) {
+	fromTime = sql.NullTime{Time: filter.FromTime, Valid: !filter.FromTime.IsZero()}
+	toTime = sql.NullTime{Time: filter.ToTime, Valid: !filter.ToTime.IsZero()}
+	minAmount = sql.NullInt64{Int64: filter.MinAmount, Valid: filter.MinAmount > 0}
+	maxAmount = sql.NullInt64{Int64: filter.MaxAmount, Valid: filter.MaxAmount > 0}
+	return
+}
+
+func (filter HistoryFilter) textParams() (query, reference, category sql.NullString) {
+	query = sql.NullString{String: filter.Query, Valid: filter.Query != ""}
+	reference = sql.NullString{String: filter.Reference, Valid: filter.Reference != ""}
+	category = sql.NullString{String: filter.Category, Valid: filter.Category != ""}
+	return
+}
+
+func historyPageSize(pageSize int32) int32 {
+	if pageSize <= 0 {
+		return util.DefaultPageSize
+	}
+	return pageSize
+}
+
+func decodeHistoryCursor(token string, accountID int64, filter HistoryFilter) (sql.NullInt64, error) {
+	if token == "" {
+		return sql.NullInt64{}, nil
+	}
+
+	var cursor historyCursor
+	if err := util.DecodePageToken(token, &cursor); err != nil {
+		return sql.NullInt64{}, fmt.Errorf("%w: %s", ErrInvalidPageToken, err)
+	}
+	if cursor.AccountID != accountID || !cursor.Filter.equal(filter) {
+		return sql.NullInt64{}, fmt.Errorf("%w: account or filter changed between pages", ErrInvalidPageToken)
+	}
+
+	return sql.NullInt64{Int64: cursor.BeforeID, Valid: true}, nil
+}
+
+func encodeHistoryCursor(accountID int64, filter HistoryFilter, beforeID int64) (string, error) {
+	return util.EncodePageToken(historyCursor{
+		AccountID: accountID,
+		Filter:    filter,
+		BeforeID:  beforeID,
+	})
+}
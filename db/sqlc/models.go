@@ -5,6 +5,8 @@
 package db
 
 import (
+	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/google/uuid"
@@ -16,6 +18,51 @@ type Account struct {
 	Balance   int64     `json:"balance"`
 	Currency  string    `json:"currency"`
 	CreatedAt time.Time `json:"created_at"`
+	// how far below zero the balance may go, 0 disables overdraft
+	OverdraftLimit int64 `json:"overdraft_limit"`
+	// active, frozen or closed; only active accounts can send or receive money
+	Status string `json:"status"`
+	// checking or savings; savings accounts earn interest
+	Type string `json:"type"`
+}
+
+type AuditLog struct {
+	ID int64 `json:"id"`
+	// username of the support or admin user who performed the action
+	Actor  string `json:"actor"`
+	Action string `json:"action"`
+	// what the action was performed on, such as account:42 or user:alice
+	Target string `json:"target"`
+	// the request that triggered the action
+	Details   json.RawMessage `json:"details"`
+	CreatedAt time.Time       `json:"created_at"`
+	// succeeded, failed or denied
+	Outcome string `json:"outcome"`
+	// why the action failed or was denied, empty when it succeeded
+	Error string `json:"error"`
+}
+
+type BalanceDiscrepancy struct {
+	ID           int64     `json:"id"`
+	AccountID    int64     `json:"account_id"`
+	SnapshotDate time.Time `json:"snapshot_date"`
+	// accounts.balance at the end of the day
+	Balance int64 `json:"balance"`
+	// sum of the entries of the account, different from balance
+	EntrySum  int64     `json:"entry_sum"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+type BalanceSnapshot struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	// the UTC day the snapshot closes
+	SnapshotDate time.Time `json:"snapshot_date"`
+	// accounts.balance at the end of the day
+	Balance int64 `json:"balance"`
+	// sum of the entries of the account created up to the end of the day
+	EntrySum  int64     `json:"entry_sum"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Entry struct {
@@ -24,6 +71,229 @@ type Entry struct {
 	// can be negative or positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// free text shown on statements, searchable
+	Memo string `json:"memo"`
+	// external reference such as an invoice number
+	Reference string `json:"reference"`
+	// category assigned by the account owner, transfers only set it on the debited entry
+	Category string `json:"category"`
+}
+
+type Fee struct {
+	ID        int64  `json:"id"`
+	AccountID int64  `json:"account_id"`
+	Operation string `json:"operation"`
+	// charged to the account, 0 for operations in the free allowance
+	Amount     int64         `json:"amount"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	// the entry that debited the fee, null when nothing was charged
+	EntryID   sql.NullInt64 `json:"entry_id"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type FeeSchedule struct {
+	// transfer or withdrawal
+	Operation  string `json:"operation"`
+	Currency   string `json:"currency"`
+	FlatAmount int64  `json:"flat_amount"`
+	// percentage of the amount in basis points, rounded half up
+	Rate      int32 `json:"rate"`
+	MinAmount int64 `json:"min_amount"`
+	// caps the fee, 0 leaves it uncapped
+	MaxAmount int64 `json:"max_amount"`
+	// operations of an account that are free every calendar month
+	FreePerMonth int32     `json:"free_per_month"`
+	UpdatedBy    string    `json:"updated_by"`
+	UpdatedAt    time.Time `json:"updated_at"`
+}
+
+type FxQuote struct {
+	ID           uuid.UUID `json:"id"`
+	Username     string    `json:"username"`
+	FromCurrency string    `json:"from_currency"`
+	ToCurrency   string    `json:"to_currency"`
+	// the fx_rates rate at the time of the quote
+	Rate      int64     `json:"rate"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// set once a transfer has been made with the quote
+	UsedAt    sql.NullTime `json:"used_at"`
+	CreatedAt time.Time    `json:"created_at"`
+}
+
+type FxRate struct {
+	FromCurrency string `json:"from_currency"`
+	ToCurrency   string `json:"to_currency"`
+	// units of to_currency per unit of from_currency, scaled by 10^8
+	Rate      int64     `json:"rate"`
+	UpdatedBy string    `json:"updated_by"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Hold struct {
+	ID        int64 `json:"id"`
+	AccountID int64 `json:"account_id"`
+	// must be positive
+	Amount int64 `json:"amount"`
+	// part of amount that was settled, the rest went back to the available balance
+	CapturedAmount int64 `json:"captured_amount"`
+	// active, captured, released or expired; only active holds reduce the available balance
+	Status string `json:"status"`
+	// the entry that debited the account when the hold was captured
+	EntryID   sql.NullInt64 `json:"entry_id"`
+	ExpiresAt time.Time     `json:"expires_at"`
+	SettledAt sql.NullTime  `json:"settled_at"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	Username       string `json:"username"`
+	IdempotencyKey string `json:"idempotency_key"`
+	// fingerprint of the request the key was first used with
+	RequestHash string          `json:"request_hash"`
+	Response    json.RawMessage `json:"response"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+type InterestAccrual struct {
+	ID          int64     `json:"id"`
+	AccountID   int64     `json:"account_id"`
+	AccrualDate time.Time `json:"accrual_date"`
+	// balance of the daily snapshot the interest is computed on
+	Balance int64 `json:"balance"`
+	// annual rate in basis points
+	Rate int32 `json:"rate"`
+	// whole units of interest earned on the day
+	Amount int64 `json:"amount"`
+	// fraction of a unit carried to the next day, in units of 1/3650000
+	Remainder int64 `json:"remainder"`
+	// the interest entry the amount was capitalized with, null while accruing or when the month earned nothing
+	EntryID sql.NullInt64 `json:"entry_id"`
+	// null until the amount is capitalized
+	CapitalizedAt sql.NullTime `json:"capitalized_at"`
+	CreatedAt     time.Time    `json:"created_at"`
+}
+
+type Journal struct {
+	ID int64 `json:"id"`
+	// what the journal records, such as deposit, withdrawal or transfer
+	Kind       string        `json:"kind"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	CreatedAt  time.Time     `json:"created_at"`
+}
+
+type Payee struct {
+	ID int64 `json:"id"`
+	// the user who saved the payee, not the owner of the account
+	Owner     string `json:"owner"`
+	AccountID int64  `json:"account_id"`
+	// unique among the payees of the owner
+	Nickname  string    `json:"nickname"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type PaymentRequest struct {
+	ID int64 `json:"id"`
+	// the user asking to be paid
+	Requester string `json:"requester"`
+	// the user asked to pay
+	Payer string `json:"payer"`
+	// account of the requester the money is paid into
+	ToAccountID int64 `json:"to_account_id"`
+	// must be positive
+	Amount   int64  `json:"amount"`
+	Currency string `json:"currency"`
+	Memo     string `json:"memo"`
+	// pending, accepted, declined or expired
+	Status    string    `json:"status"`
+	ExpiresAt time.Time `json:"expires_at"`
+	// the transfer that paid the request, set once accepted
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	CreatedAt  time.Time     `json:"created_at"`
+	UpdatedAt  time.Time     `json:"updated_at"`
+}
+
+type Posting struct {
+	ID        int64 `json:"id"`
+	JournalID int64 `json:"journal_id"`
+	// set for customer accounts, exactly one of account_id and system_account_id is set
+	AccountID       sql.NullInt64 `json:"account_id"`
+	SystemAccountID sql.NullInt64 `json:"system_account_id"`
+	Currency        string        `json:"currency"`
+	// added to the account balance; the postings of a journal sum to zero per currency
+	Amount int64 `json:"amount"`
+}
+
+type RiskDecision struct {
+	ID          int64         `json:"id"`
+	Username    string        `json:"username"`
+	Operation   string        `json:"operation"`
+	AccountID   int64         `json:"account_id"`
+	ToAccountID sql.NullInt64 `json:"to_account_id"`
+	Amount      int64         `json:"amount"`
+	Currency    string        `json:"currency"`
+	// allow, review or deny, the strictest action of the matched rules
+	Action string `json:"action"`
+	// names of the rules that matched
+	Rules []string `json:"rules"`
+	// the money movement to make once a review is approved
+	Request   json.RawMessage `json:"request"`
+	UserAgent string          `json:"user_agent"`
+	ClientIp  string          `json:"client_ip"`
+	// key the client sent, the approved movement is recorded under it
+	IdempotencyKey sql.NullString `json:"idempotency_key"`
+	RequestHash    sql.NullString `json:"request_hash"`
+	// pending, approved or rejected, null unless the action is review
+	ReviewStatus sql.NullString `json:"review_status"`
+	ReviewedBy   sql.NullString `json:"reviewed_by"`
+	ReviewedAt   sql.NullTime   `json:"reviewed_at"`
+	// the transfer made once the review was approved
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	// the entry of the withdrawal or deposit made once the review was approved
+	EntryID   sql.NullInt64 `json:"entry_id"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+type ScheduledTransfer struct {
+	ID            int64  `json:"id"`
+	Owner         string `json:"owner"`
+	FromAccountID int64  `json:"from_account_id"`
+	ToAccountID   int64  `json:"to_account_id"`
+	// must be positive
+	Amount int64 `json:"amount"`
+	// once, daily, weekly or monthly
+	Frequency string `json:"frequency"`
+	// number of days, weeks or months between occurrences
+	IntervalCount int32 `json:"interval_count"`
+	// day monthly transfers run on, -1 for the last day of the month, 0 otherwise
+	DayOfMonth int32        `json:"day_of_month"`
+	StartAt    time.Time    `json:"start_at"`
+	EndAt      sql.NullTime `json:"end_at"`
+	// the occurrence the next run is for, null once the schedule has ended
+	NextOccurrenceAt sql.NullTime `json:"next_occurrence_at"`
+	// when the next run is due, later than next_occurrence_at while retrying
+	NextRunAt sql.NullTime `json:"next_run_at"`
+	// failed runs of the next occurrence
+	Attempts int32 `json:"attempts"`
+	// skip or retry an occurrence that fails for insufficient funds or an inactive account
+	FailurePolicy string `json:"failure_policy"`
+	// active, paused, completed or cancelled
+	Status    string    `json:"status"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type ScheduledTransferRun struct {
+	ID                  int64     `json:"id"`
+	ScheduledTransferID int64     `json:"scheduled_transfer_id"`
+	OccurrenceAt        time.Time `json:"occurrence_at"`
+	Attempt             int32     `json:"attempt"`
+	// succeeded, retrying, skipped or failed
+	Status     string        `json:"status"`
+	TransferID sql.NullInt64 `json:"transfer_id"`
+	// why the transfer could not be made
+	Error     string    `json:"error"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Session struct {
@@ -35,6 +305,38 @@ type Session struct {
 	IsBlocked    bool      `json:"is_blocked"`
 	ExpiresAt    time.Time `json:"expires_at"`
 	CreatedAt    time.Time `json:"created_at"`
+	// id of the login session the refresh token was rotated from
+	FamilyID uuid.UUID `json:"family_id"`
+	// set once the refresh token has been exchanged for a new one
+	ConsumedAt sql.NullTime `json:"consumed_at"`
+}
+
+type SpendingLimit struct {
+	ID       int64  `json:"id"`
+	Username string `json:"username"`
+	Currency string `json:"currency"`
+	// null for the limits of all the accounts of the user in the currency
+	AccountID sql.NullInt64 `json:"account_id"`
+	// limits of outbound money in minor units, 0 is no limit
+	PerTransactionLimit int64 `json:"per_transaction_limit"`
+	// over the last 24 hours
+	DailyLimit int64 `json:"daily_limit"`
+	// over the last 30 days
+	MonthlyLimit               int64         `json:"monthly_limit"`
+	PendingPerTransactionLimit sql.NullInt64 `json:"pending_per_transaction_limit"`
+	PendingDailyLimit          sql.NullInt64 `json:"pending_daily_limit"`
+	PendingMonthlyLimit        sql.NullInt64 `json:"pending_monthly_limit"`
+	// when the pending limits replace the current ones, increases wait for a cooling-off period
+	PendingEffectiveAt sql.NullTime `json:"pending_effective_at"`
+	UpdatedAt          time.Time    `json:"updated_at"`
+}
+
+type SystemAccount struct {
+	ID int64 `json:"id"`
+	// cash_vault, fees_income, fx_position, interest_expense or suspense
+	Code      string    `json:"code"`
+	Currency  string    `json:"currency"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
 type Transfer struct {
@@ -44,6 +346,21 @@ type Transfer struct {
 	// must be positive
 	Amount    int64     `json:"amount"`
 	CreatedAt time.Time `json:"created_at"`
+	// amount credited to the destination account, differs from amount only for fx transfers
+	ToAmount int64 `json:"to_amount"`
+	// rate applied to amount to get to_amount, scaled by 10^8
+	FxRate    sql.NullInt64 `json:"fx_rate"`
+	FxQuoteID uuid.NullUUID `json:"fx_quote_id"`
+	// the transfer this one refunds or reverses
+	ReversedTransferID sql.NullInt64 `json:"reversed_transfer_id"`
+	// part of to_amount sent back by refunds and reversals so far
+	RefundedAmount int64 `json:"refunded_amount"`
+	// free text shown on statements, searchable
+	Memo string `json:"memo"`
+	// external reference such as an invoice number
+	Reference string `json:"reference"`
+	// category assigned by the payer
+	Category string `json:"category"`
 }
 
 type User struct {
@@ -54,6 +371,8 @@ type User struct {
 	PasswordChangedAt time.Time `json:"password_changed_at"`
 	CreatedAt         time.Time `json:"created_at"`
 	IsEmailVerified   bool      `json:"is_email_verified"`
+	// customer, support or admin
+	Role string `json:"role"`
 }
 
 type VerifyEmail struct {
@@ -0,0 +1,52 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// NewPayeeLimit caps what a user sends to someone else's account until Period has passed since
+// they first paid it, so a mistyped account number costs little. A zero Amount caps nothing.
+type NewPayeeLimit struct {
+	Amount int64         `json:"amount"`
+	Period time.Duration `json:"period"`
+}
+
+// NewPayeeLimitFromConfig returns the new payee limit set in config
+func NewPayeeLimitFromConfig(config util.Config) NewPayeeLimit {
+	return NewPayeeLimit{
+		Amount: config.NewPayeeTransferLimit,
+		Period: config.NewPayeeTransferPeriod,
+	}
+}
+
+// checkNewPayeeLimit checks that sending amount from fromAccount to toAccount stays within
+// limit, counting everything the owner of fromAccount already sent to toAccount from any of
+// their accounts. It uses q, which must already run inside a transaction holding the lock on
+// toAccount, so concurrent transfers to the account are counted one after the other.
+// It returns ErrNewPayeeLimit if the limit would be exceeded.
+func checkNewPayeeLimit(ctx context.Context, q Querier, fromAccount Account, toAccount Account, amount int64, limit NewPayeeLimit) error {
+	if limit.Amount <= 0 || fromAccount.Owner == toAccount.Owner {
+		return nil
+	}
+
+	paid, err := q.GetPaymentsToAccount(ctx, GetPaymentsToAccountParams{
+		Owner:       fromAccount.Owner,
+		ToAccountID: toAccount.ID,
+	})
+	if err != nil {
+		return err
+	}
+	if time.Since(paid.FirstPaidAt) >= limit.Period {
+		return nil
+	}
+
+	if paid.Total+amount > limit.Amount {
+		return fmt.Errorf("%w: at most %d can be sent to account %d in the first %s, %d already was",
+			ErrNewPayeeLimit, limit.Amount, toAccount.ID, limit.Period, paid.Total)
+	}
+	return nil
+}
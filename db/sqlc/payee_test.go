@@ -0,0 +1,106 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPayee(t *testing.T, owner string, account Account) Payee {
+	arg := CreatePayeeParams{
+		Owner:     owner,
+		AccountID: account.ID,
+		Nickname:  util.RandomOwner(),
+	}
+
+	payee, err := testQueries.CreatePayee(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, payee.ID)
+	require.Equal(t, arg.Owner, payee.Owner)
+	require.Equal(t, arg.AccountID, payee.AccountID)
+	require.Equal(t, arg.Nickname, payee.Nickname)
+	require.NotZero(t, payee.CreatedAt)
+
+	return payee
+}
+
+func TestCreatePayee(t *testing.T) {
+	user := createRandomUser(t)
+	account := createRandomAccount(t)
+	payee := createRandomPayee(t, user.Username, account)
+
+	// an account is saved once per user
+	_, err := testQueries.CreatePayee(context.Background(), CreatePayeeParams{
+		Owner:     user.Username,
+		AccountID: account.ID,
+		Nickname:  util.RandomOwner(),
+	})
+	require.Error(t, err)
+	require.Equal(t, "unique_violation", err.(*pq.Error).Code.Name())
+
+	// and so is a nickname
+	_, err = testQueries.CreatePayee(context.Background(), CreatePayeeParams{
+		Owner:     user.Username,
+		AccountID: createRandomAccount(t).ID,
+		Nickname:  payee.Nickname,
+	})
+	require.Error(t, err)
+	require.Equal(t, "unique_violation", err.(*pq.Error).Code.Name())
+}
+
+func TestListPayees(t *testing.T) {
+	user := createRandomUser(t)
+	for i := 0; i < 3; i++ {
+		createRandomPayee(t, user.Username, createRandomAccount(t))
+	}
+
+	payees, err := testQueries.ListPayees(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, payees, 3)
+
+	for i, payee := range payees {
+		require.Equal(t, user.Username, payee.Owner)
+
+		account, err := testQueries.GetAccount(context.Background(), payee.AccountID)
+		require.NoError(t, err)
+		require.Equal(t, account.Currency, payee.Currency)
+
+		owner, err := testQueries.GetUser(context.Background(), account.Owner)
+		require.NoError(t, err)
+		require.Equal(t, owner.FullName, payee.FullName)
+
+		if i > 0 {
+			require.LessOrEqual(t, payees[i-1].Nickname, payee.Nickname)
+		}
+	}
+}
+
+func TestUpdatePayee(t *testing.T) {
+	user := createRandomUser(t)
+	payee := createRandomPayee(t, user.Username, createRandomAccount(t))
+
+	nickname := util.RandomOwner()
+	updated, err := testQueries.UpdatePayee(context.Background(), UpdatePayeeParams{
+		ID:       payee.ID,
+		Nickname: nickname,
+	})
+	require.NoError(t, err)
+	require.Equal(t, nickname, updated.Nickname)
+	require.Equal(t, payee.AccountID, updated.AccountID)
+	require.False(t, updated.UpdatedAt.Before(payee.UpdatedAt))
+}
+
+func TestDeletePayee(t *testing.T) {
+	user := createRandomUser(t)
+	payee := createRandomPayee(t, user.Username, createRandomAccount(t))
+
+	err := testQueries.DeletePayee(context.Background(), payee.ID)
+	require.NoError(t, err)
+
+	_, err = testQueries.GetPayee(context.Background(), payee.ID)
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
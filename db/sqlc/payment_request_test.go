@@ -0,0 +1,222 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomPaymentRequest(t *testing.T, payer string, toAccount Account, amount int64, expiresAt time.Time) PaymentRequest {
+	arg := CreatePaymentRequestParams{
+		Requester:   toAccount.Owner,
+		Payer:       payer,
+		ToAccountID: toAccount.ID,
+		Amount:      amount,
+		Currency:    toAccount.Currency,
+		Memo:        "dinner",
+		ExpiresAt:   expiresAt,
+	}
+
+	request, err := testQueries.CreatePaymentRequest(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, request.ID)
+	require.Equal(t, arg.Requester, request.Requester)
+	require.Equal(t, arg.Payer, request.Payer)
+	require.Equal(t, arg.ToAccountID, request.ToAccountID)
+	require.Equal(t, arg.Amount, request.Amount)
+	require.Equal(t, arg.Memo, request.Memo)
+	require.Equal(t, util.PaymentRequestPending, request.Status)
+	require.False(t, request.TransferID.Valid)
+	require.NotZero(t, request.CreatedAt)
+
+	return request
+}
+
+func TestCreatePaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+	toAccount := createRandomAccountInCurrency(t, util.USD, 0)
+	payer := createRandomUser(t)
+
+	arg := CreatePaymentRequestParams{
+		Requester:   toAccount.Owner,
+		Payer:       payer.Username,
+		ToAccountID: toAccount.ID,
+		Amount:      100,
+		Currency:    util.USD,
+		ExpiresAt:   time.Now().Add(time.Hour),
+	}
+
+	// the request isn't made when the callback fails
+	_, err := store.CreatePaymentRequestTx(context.Background(), CreatePaymentRequestTxParams{
+		CreatePaymentRequestParams: arg,
+		AfterCreate: func(request PaymentRequest) error {
+			return errors.New("failed to notify")
+		},
+	})
+	require.Error(t, err)
+
+	requests, err := testQueries.ListIncomingPaymentRequests(context.Background(), ListIncomingPaymentRequestsParams{
+		Payer:    payer.Username,
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Empty(t, requests)
+
+	result, err := store.CreatePaymentRequestTx(context.Background(), CreatePaymentRequestTxParams{
+		CreatePaymentRequestParams: arg,
+		AfterCreate: func(request PaymentRequest) error {
+			require.Equal(t, payer.Username, request.Payer)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestPending, result.PaymentRequest.Status)
+
+	requests, err = testQueries.ListIncomingPaymentRequests(context.Background(), ListIncomingPaymentRequestsParams{
+		Payer:    payer.Username,
+		Status:   sql.NullString{String: util.PaymentRequestPending, Valid: true},
+		PageSize: 10,
+	})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+	require.Equal(t, result.PaymentRequest.ID, requests[0].ID)
+
+	requests, err = testQueries.ListOutgoingPaymentRequests(context.Background(), ListOutgoingPaymentRequestsParams{
+		Requester: toAccount.Owner,
+		PageSize:  10,
+	})
+	require.NoError(t, err)
+	require.Len(t, requests, 1)
+}
+
+func TestAcceptPaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 1000)
+	toAccount := createRandomAccountInCurrency(t, util.USD, 0)
+	request := createRandomPaymentRequest(t, fromAccount.Owner, toAccount, 100, time.Now().Add(time.Hour))
+
+	result, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: fromAccount.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestAccepted, result.PaymentRequest.Status)
+	require.Equal(t, result.Transfer.Transfer.ID, result.PaymentRequest.TransferID.Int64)
+	require.Equal(t, toAccount.ID, result.Transfer.Transfer.ToAccountID)
+	require.Equal(t, int64(100), result.Transfer.Transfer.Amount)
+	require.Equal(t, "dinner", result.Transfer.Transfer.Memo)
+
+	updated, err := testQueries.GetAccount(context.Background(), toAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), updated.Balance)
+
+	// a request is only answered once
+	_, err = store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: fromAccount.ID,
+	})
+	require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+	_, err = store.DeclinePaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{ID: request.ID})
+	require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+}
+
+func TestAcceptPaymentRequestTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 50)
+	toAccount := createRandomAccountInCurrency(t, util.USD, 0)
+	request := createRandomPaymentRequest(t, fromAccount.Owner, toAccount, 100, time.Now().Add(time.Hour))
+
+	_, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: fromAccount.ID,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// the request can still be paid later
+	found, err := testQueries.GetPaymentRequest(context.Background(), request.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestPending, found.Status)
+}
+
+func TestAcceptPaymentRequestTxNewPayeeLimit(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 1000)
+	toAccount := createRandomAccountInCurrency(t, util.USD, 0)
+	request := createRandomPaymentRequest(t, fromAccount.Owner, toAccount, 100, time.Now().Add(time.Hour))
+
+	_, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: fromAccount.ID,
+		NewPayeeLimit: NewPayeeLimit{Amount: 50, Period: 24 * time.Hour},
+	})
+	require.ErrorIs(t, err, ErrNewPayeeLimit)
+
+	found, err := testQueries.GetPaymentRequest(context.Background(), request.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestPending, found.Status)
+}
+
+func TestDeclinePaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+	payer := createRandomUser(t)
+	toAccount := createRandomAccountInCurrency(t, util.USD, 0)
+	request := createRandomPaymentRequest(t, payer.Username, toAccount, 100, time.Now().Add(time.Hour))
+
+	declined, err := store.DeclinePaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{ID: request.ID})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestDeclined, declined.Status)
+	require.False(t, declined.TransferID.Valid)
+
+	updated, err := testQueries.GetAccount(context.Background(), toAccount.ID)
+	require.NoError(t, err)
+	require.Zero(t, updated.Balance)
+}
+
+func TestExpirePaymentRequestTx(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 1000)
+	toAccount := createRandomAccountInCurrency(t, util.USD, 0)
+	pending := createRandomPaymentRequest(t, fromAccount.Owner, toAccount, 100, time.Now().Add(time.Hour))
+	expired := createRandomPaymentRequest(t, fromAccount.Owner, toAccount, 100, time.Now().Add(-time.Minute))
+
+	// a request past its expiry can't be answered even before the task expires it
+	_, err := store.AcceptPaymentRequestTx(context.Background(), AcceptPaymentRequestTxParams{
+		ID:            expired.ID,
+		FromAccountID: fromAccount.ID,
+	})
+	require.ErrorIs(t, err, ErrPaymentRequestExpired)
+	_, err = store.DeclinePaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{ID: expired.ID})
+	require.ErrorIs(t, err, ErrPaymentRequestExpired)
+
+	requests, err := testQueries.ListExpiredPaymentRequests(context.Background(), 1000)
+	require.NoError(t, err)
+	ids := make(map[int64]bool)
+	for _, request := range requests {
+		ids[request.ID] = true
+	}
+	require.True(t, ids[expired.ID])
+	require.False(t, ids[pending.ID])
+
+	_, err = store.ExpirePaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{ID: pending.ID})
+	require.Error(t, err)
+
+	var notified PaymentRequest
+	result, err := store.ExpirePaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{
+		ID: expired.ID,
+		AfterClose: func(request PaymentRequest) error {
+			notified = request
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.PaymentRequestExpired, result.Status)
+	require.Equal(t, result, notified)
+
+	_, err = store.ExpirePaymentRequestTx(context.Background(), ClosePaymentRequestTxParams{ID: expired.ID})
+	require.ErrorIs(t, err, ErrPaymentRequestNotPending)
+}
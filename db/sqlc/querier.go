@@ -6,31 +6,124 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 type Querier interface {
 	AddAccountBalance(ctx context.Context, arg AddAccountBalanceParams) (Account, error)
+	AddTransferRefundedAmount(ctx context.Context, arg AddTransferRefundedAmountParams) (Transfer, error)
+	AdvanceScheduledTransfer(ctx context.Context, arg AdvanceScheduledTransferParams) (ScheduledTransfer, error)
+	BlockSession(ctx context.Context, arg BlockSessionParams) (Session, error)
+	BlockSessionFamily(ctx context.Context, familyID uuid.UUID) (int64, error)
+	BlockUserSessions(ctx context.Context, username string) (int64, error)
+	CancelScheduledTransfersToAccount(ctx context.Context, toAccountID int64) (int64, error)
+	CapitalizeInterestAccruals(ctx context.Context, arg CapitalizeInterestAccrualsParams) ([]InterestAccrual, error)
+	ConsumeSession(ctx context.Context, id uuid.UUID) (Session, error)
+	CountFeesSince(ctx context.Context, arg CountFeesSinceParams) (int64, error)
+	CountRiskDecisionsSince(ctx context.Context, arg CountRiskDecisionsSinceParams) (int64, error)
 	CreateAccount(ctx context.Context, arg CreateAccountParams) (Account, error)
+	CreateAuditLog(ctx context.Context, arg CreateAuditLogParams) (AuditLog, error)
+	CreateBalanceDiscrepancy(ctx context.Context, arg CreateBalanceDiscrepancyParams) (BalanceDiscrepancy, error)
+	CreateBalanceSnapshot(ctx context.Context, arg CreateBalanceSnapshotParams) (BalanceSnapshot, error)
 	CreateEntry(ctx context.Context, arg CreateEntryParams) (Entry, error)
+	CreateFee(ctx context.Context, arg CreateFeeParams) (Fee, error)
+	CreateFxQuote(ctx context.Context, arg CreateFxQuoteParams) (FxQuote, error)
+	CreateHold(ctx context.Context, arg CreateHoldParams) (Hold, error)
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (IdempotencyKey, error)
+	CreateInterestAccrual(ctx context.Context, arg CreateInterestAccrualParams) (InterestAccrual, error)
+	CreateJournal(ctx context.Context, arg CreateJournalParams) (Journal, error)
+	CreatePayee(ctx context.Context, arg CreatePayeeParams) (Payee, error)
+	CreatePaymentRequest(ctx context.Context, arg CreatePaymentRequestParams) (PaymentRequest, error)
+	CreatePosting(ctx context.Context, arg CreatePostingParams) (Posting, error)
+	CreateRiskDecision(ctx context.Context, arg CreateRiskDecisionParams) (RiskDecision, error)
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (ScheduledTransfer, error)
+	CreateScheduledTransferRun(ctx context.Context, arg CreateScheduledTransferRunParams) (ScheduledTransferRun, error)
 	CreateSession(ctx context.Context, arg CreateSessionParams) (Session, error)
+	CreateSpendingLimit(ctx context.Context, arg CreateSpendingLimitParams) (SpendingLimit, error)
+	CreateSystemAccount(ctx context.Context, arg CreateSystemAccountParams) (SystemAccount, error)
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	CreateVerifyEmail(ctx context.Context, arg CreateVerifyEmailParams) (VerifyEmail, error)
-	DeleteAccount(ctx context.Context, id int64) error
+	DeleteBalanceDiscrepancies(ctx context.Context, arg DeleteBalanceDiscrepanciesParams) error
+	DeletePayee(ctx context.Context, id int64) error
 	GetAccount(ctx context.Context, id int64) (Account, error)
+	GetAccountCommitments(ctx context.Context, accountID int64) (GetAccountCommitmentsRow, error)
 	GetAccountForUpdate(ctx context.Context, id int64) (Account, error)
+	GetAccountSpendingLimitForUpdate(ctx context.Context, accountID sql.NullInt64) (SpendingLimit, error)
+	GetDeviceFirstSeen(ctx context.Context, arg GetDeviceFirstSeenParams) (time.Time, error)
 	GetEntry(ctx context.Context, id int64) (Entry, error)
+	GetFeeSchedule(ctx context.Context, arg GetFeeScheduleParams) (FeeSchedule, error)
+	GetFxQuoteForUpdate(ctx context.Context, id uuid.UUID) (FxQuote, error)
+	GetFxRate(ctx context.Context, arg GetFxRateParams) (FxRate, error)
+	GetHeldAmount(ctx context.Context, accountID int64) (int64, error)
+	GetHold(ctx context.Context, id int64) (Hold, error)
+	GetHoldForUpdate(ctx context.Context, id int64) (Hold, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetPayee(ctx context.Context, id int64) (Payee, error)
+	GetPaymentRequest(ctx context.Context, id int64) (PaymentRequest, error)
+	GetPaymentRequestForUpdate(ctx context.Context, id int64) (PaymentRequest, error)
+	GetPaymentsToAccount(ctx context.Context, arg GetPaymentsToAccountParams) (GetPaymentsToAccountRow, error)
+	GetPendingInterest(ctx context.Context, accountID int64) (int64, error)
+	GetRiskDecision(ctx context.Context, id int64) (RiskDecision, error)
+	GetRiskDecisionForUpdate(ctx context.Context, id int64) (RiskDecision, error)
+	GetRiskReviewByIdempotencyKey(ctx context.Context, arg GetRiskReviewByIdempotencyKeyParams) (RiskDecision, error)
+	GetScheduledTransfer(ctx context.Context, id int64) (ScheduledTransfer, error)
+	GetScheduledTransferForUpdate(ctx context.Context, id int64) (ScheduledTransfer, error)
 	GetSession(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSessionForUpdate(ctx context.Context, id uuid.UUID) (Session, error)
+	GetSystemAccount(ctx context.Context, arg GetSystemAccountParams) (SystemAccount, error)
 	GetTransfer(ctx context.Context, id int64) (Transfer, error)
+	GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error)
+	GetTrialBalance(ctx context.Context) ([]GetTrialBalanceRow, error)
 	GetUser(ctx context.Context, username string) (User, error)
+	GetUserSpendingLimitForUpdate(ctx context.Context, arg GetUserSpendingLimitForUpdateParams) (SpendingLimit, error)
+	HasUserPaidAccount(ctx context.Context, arg HasUserPaidAccountParams) (bool, error)
+	ListAccountReconciliations(ctx context.Context, arg ListAccountReconciliationsParams) ([]ListAccountReconciliationsRow, error)
 	ListAccounts(ctx context.Context, arg ListAccountsParams) ([]Account, error)
+	ListAccountsByBalance(ctx context.Context, arg ListAccountsByBalanceParams) ([]Account, error)
+	ListBalanceDiscrepancies(ctx context.Context, snapshotDate time.Time) ([]BalanceDiscrepancy, error)
+	ListCapitalizableAccounts(ctx context.Context, arg ListCapitalizableAccountsParams) ([]int64, error)
+	ListDueScheduledTransfers(ctx context.Context, limit int32) ([]ScheduledTransfer, error)
 	ListEntries(ctx context.Context, arg ListEntriesParams) ([]Entry, error)
+	ListExpiredHolds(ctx context.Context, limit int32) ([]Hold, error)
+	ListExpiredPaymentRequests(ctx context.Context, limit int32) ([]PaymentRequest, error)
+	ListIncomingPaymentRequests(ctx context.Context, arg ListIncomingPaymentRequestsParams) ([]PaymentRequest, error)
+	ListInterestAccrualAccounts(ctx context.Context, arg ListInterestAccrualAccountsParams) ([]ListInterestAccrualAccountsRow, error)
+	ListJournalPostings(ctx context.Context, journalID int64) ([]Posting, error)
+	ListOutgoingPaymentRequests(ctx context.Context, arg ListOutgoingPaymentRequestsParams) ([]PaymentRequest, error)
+	ListPayees(ctx context.Context, owner string) ([]ListPayeesRow, error)
+	ListRiskReviews(ctx context.Context, arg ListRiskReviewsParams) ([]RiskDecision, error)
+	ListScheduledTransferRuns(ctx context.Context, arg ListScheduledTransferRunsParams) ([]ScheduledTransferRun, error)
+	ListScheduledTransfers(ctx context.Context, owner string) ([]ScheduledTransfer, error)
+	ListSessions(ctx context.Context, username string) ([]Session, error)
+	ListSpendingLimits(ctx context.Context, username string) ([]SpendingLimit, error)
+	ListStatementAccounts(ctx context.Context, arg ListStatementAccountsParams) ([]Account, error)
+	ListStatementEntries(ctx context.Context, arg ListStatementEntriesParams) ([]Entry, error)
+	ListSystemAccountBalances(ctx context.Context) ([]ListSystemAccountBalancesRow, error)
 	ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error)
+	ReviewRiskDecision(ctx context.Context, arg ReviewRiskDecisionParams) (RiskDecision, error)
+	SearchUsers(ctx context.Context, arg SearchUsersParams) ([]User, error)
+	SetInterestAccrualsEntry(ctx context.Context, arg SetInterestAccrualsEntryParams) error
+	SettleHold(ctx context.Context, arg SettleHoldParams) (Hold, error)
+	SumAccountDebitsSince(ctx context.Context, arg SumAccountDebitsSinceParams) (int64, error)
+	SumEntriesSince(ctx context.Context, arg SumEntriesSinceParams) (int64, error)
+	SumUserDebitsSince(ctx context.Context, arg SumUserDebitsSinceParams) (int64, error)
 	UpdateAccount(ctx context.Context, arg UpdateAccountParams) (Account, error)
+	UpdateAccountOverdraftLimit(ctx context.Context, arg UpdateAccountOverdraftLimitParams) (Account, error)
+	UpdateAccountStatus(ctx context.Context, arg UpdateAccountStatusParams) (Account, error)
+	UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) (IdempotencyKey, error)
+	UpdatePayee(ctx context.Context, arg UpdatePayeeParams) (Payee, error)
+	UpdatePaymentRequestStatus(ctx context.Context, arg UpdatePaymentRequestStatusParams) (PaymentRequest, error)
+	UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (ScheduledTransfer, error)
+	UpdateSpendingLimit(ctx context.Context, arg UpdateSpendingLimitParams) (SpendingLimit, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateVerifyEmail(ctx context.Context, arg UpdateVerifyEmailParams) (VerifyEmail, error)
+	UpsertFeeSchedule(ctx context.Context, arg UpsertFeeScheduleParams) (FeeSchedule, error)
+	UpsertFxRate(ctx context.Context, arg UpsertFxRateParams) (FxRate, error)
+	UseFxQuote(ctx context.Context, id uuid.UUID) (FxQuote, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,112 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// reconcileBatchSize is how many accounts are reconciled in each transaction
+const reconcileBatchSize = 500
+
+// ReconcileBalancesParams contains the input parameters of the balance reconciliation
+type ReconcileBalancesParams struct {
+	// Date is the UTC day to reconcile, only its year, month and day are used
+	Date time.Time `json:"date"`
+}
+
+// ReconcileBalancesResult is the result of the balance reconciliation
+type ReconcileBalancesResult struct {
+	Date time.Time `json:"date"`
+	// Accounts is the number of accounts snapshotted
+	Accounts      int                  `json:"accounts"`
+	Discrepancies []BalanceDiscrepancy `json:"discrepancies"`
+}
+
+// ReconcileBalances writes the end-of-day balance snapshot of every account that existed at the
+// end of arg.Date and checks it against the sum of the account's entries up to then. Every account
+// whose balance differs from its entry sum gets a discrepancy.
+//
+// The balance at the end of the day is the current balance minus the entries made since, read
+// together with the entry sum in a single statement, so both come from the same snapshot.
+// A wrong balance update therefore shows on every day that gets reconciled, including the days
+// before it happened, and the reconciliation can't tell when it was made.
+// Accounts are reconciled in batches, each in its own transaction.
+// Running it again for the same date replaces the snapshots and discrepancies of that date,
+// so a discrepancy that was fixed in the meantime goes away.
+func (store *SQLStore) ReconcileBalances(ctx context.Context, arg ReconcileBalancesParams) (ReconcileBalancesResult, error) {
+	date := time.Date(arg.Date.Year(), arg.Date.Month(), arg.Date.Day(), 0, 0, 0, 0, time.UTC)
+	result := ReconcileBalancesResult{
+		Date:          date,
+		Discrepancies: []BalanceDiscrepancy{},
+	}
+
+	var afterID int64
+	for {
+		var rows []ListAccountReconciliationsRow
+		err := store.execTx(ctx, func(q *Queries) error {
+			var err error
+			rows, err = q.ListAccountReconciliations(ctx, ListAccountReconciliationsParams{
+				EndTime:  date.AddDate(0, 0, 1),
+				AfterID:  afterID,
+				PageSize: reconcileBatchSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			// the last batch also clears accounts past the last one listed
+			upToID := sql.NullInt64{}
+			if len(rows) == reconcileBatchSize {
+				upToID = sql.NullInt64{Int64: rows[len(rows)-1].AccountID, Valid: true}
+			}
+			err = q.DeleteBalanceDiscrepancies(ctx, DeleteBalanceDiscrepanciesParams{
+				SnapshotDate: date,
+				AfterID:      afterID,
+				UpToID:       upToID,
+			})
+			if err != nil {
+				return err
+			}
+
+			for _, row := range rows {
+				_, err = q.CreateBalanceSnapshot(ctx, CreateBalanceSnapshotParams{
+					AccountID:    row.AccountID,
+					SnapshotDate: date,
+					Balance:      row.Balance,
+					EntrySum:     row.EntrySum,
+				})
+				if err != nil {
+					return err
+				}
+
+				if row.Balance == row.EntrySum {
+					continue
+				}
+
+				discrepancy, err := q.CreateBalanceDiscrepancy(ctx, CreateBalanceDiscrepancyParams{
+					AccountID:    row.AccountID,
+					SnapshotDate: date,
+					Balance:      row.Balance,
+					EntrySum:     row.EntrySum,
+				})
+				if err != nil {
+					return err
+				}
+				result.Discrepancies = append(result.Discrepancies, discrepancy)
+			}
+			return nil
+		})
+		if err != nil {
+			return result, err
+		}
+
+		result.Accounts += len(rows)
+		if len(rows) < reconcileBatchSize {
+			break
+		}
+		afterID = rows[len(rows)-1].AccountID
+	}
+
+	return result, nil
+}
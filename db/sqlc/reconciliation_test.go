@@ -0,0 +1,62 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReconcileBalances(t *testing.T) {
+	store := NewStore(testDB)
+
+	// the balance of a random account was never entered, so it can't be reconciled
+	unbalanced := createRandomAccountInCurrency(t, util.USD, 1000)
+	balanced := createRandomAccountInCurrency(t, util.USD, 0)
+	_, err := store.DepositTx(context.Background(), DepositTxParams{AccountID: balanced.ID, Amount: 500})
+	require.NoError(t, err)
+
+	today := time.Now().UTC()
+	result, err := store.ReconcileBalances(context.Background(), ReconcileBalancesParams{Date: today})
+	require.NoError(t, err)
+	require.Equal(t, time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC), result.Date)
+	require.GreaterOrEqual(t, result.Accounts, 2)
+
+	discrepancies := map[int64]BalanceDiscrepancy{}
+	for _, discrepancy := range result.Discrepancies {
+		discrepancies[discrepancy.AccountID] = discrepancy
+	}
+	require.Contains(t, discrepancies, unbalanced.ID)
+	require.NotContains(t, discrepancies, balanced.ID)
+	require.Equal(t, int64(1000), discrepancies[unbalanced.ID].Balance)
+	require.Equal(t, int64(0), discrepancies[unbalanced.ID].EntrySum)
+
+	stored, err := store.ListBalanceDiscrepancies(context.Background(), result.Date)
+	require.NoError(t, err)
+	require.Len(t, stored, len(result.Discrepancies))
+
+	// once the balance is fixed, reconciling the day again drops its discrepancy
+	_, err = testQueries.AddAccountBalance(context.Background(), AddAccountBalanceParams{
+		ID:     unbalanced.ID,
+		Amount: -1000,
+	})
+	require.NoError(t, err)
+
+	result, err = store.ReconcileBalances(context.Background(), ReconcileBalancesParams{Date: today})
+	require.NoError(t, err)
+	stored, err = store.ListBalanceDiscrepancies(context.Background(), result.Date)
+	require.NoError(t, err)
+	require.Len(t, stored, len(result.Discrepancies))
+	for _, discrepancy := range stored {
+		require.NotEqual(t, unbalanced.ID, discrepancy.AccountID)
+	}
+
+	// the day before, neither account existed yet
+	result, err = store.ReconcileBalances(context.Background(), ReconcileBalancesParams{Date: today.AddDate(0, 0, -1)})
+	require.NoError(t, err)
+	for _, discrepancy := range result.Discrepancies {
+		require.NotEqual(t, unbalanced.ID, discrepancy.AccountID)
+	}
+}
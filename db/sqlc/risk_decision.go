@@ -0,0 +1,142 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// ReviewRiskTxParams identifies a money movement held for review and who reviews it
+type ReviewRiskTxParams struct {
+	ID         int64  `json:"id"`
+	ReviewedBy string `json:"reviewed_by"`
+}
+
+// ApproveRiskReview makes the money movement held by a risk decision using q, which must
+// already run inside a transaction, and marks the decision approved with the transfer or entry
+// that was made. The movement goes through every check it would have gone through when it was
+// requested, so it fails the same way if the account can no longer cover it.
+// When the client sent an idempotency key the movement is recorded under it, so a retry of the
+// original request gets the result back. It returns ErrRiskReviewNotPending if the decision
+// isn't waiting for review.
+func ApproveRiskReview(ctx context.Context, q Querier, arg ReviewRiskTxParams) (RiskDecision, error) {
+	decision, err := lockPendingRiskReview(ctx, q, arg.ID)
+	if err != nil {
+		return decision, err
+	}
+
+	var idempotency *IdempotencyParams
+	if decision.IdempotencyKey.Valid {
+		idempotency = &IdempotencyParams{
+			Username:    decision.Username,
+			Key:         decision.IdempotencyKey.String,
+			RequestHash: decision.RequestHash.String,
+		}
+	}
+
+	review := ReviewRiskDecisionParams{
+		ID:           decision.ID,
+		ReviewStatus: sql.NullString{String: util.RiskReviewApproved, Valid: true},
+		ReviewedBy:   sql.NullString{String: arg.ReviewedBy, Valid: true},
+	}
+
+	switch decision.Operation {
+	case util.RiskTransfer:
+		var params TransferTxParams
+		var result TransferTxResult
+		err = json.Unmarshal(decision.Request, &params)
+		if err != nil {
+			return decision, fmt.Errorf("failed to unmarshal held transfer: %w", err)
+		}
+
+		err = execIdempotent(ctx, q, idempotency, &result, func() error {
+			return transfer(ctx, q, params, &result)
+		})
+		review.TransferID = sql.NullInt64{Int64: result.Transfer.ID, Valid: true}
+	case util.RiskWithdrawal:
+		var params WithdrawTxParams
+		var result WithdrawTxResult
+		err = json.Unmarshal(decision.Request, &params)
+		if err != nil {
+			return decision, fmt.Errorf("failed to unmarshal held withdrawal: %w", err)
+		}
+
+		err = execIdempotent(ctx, q, idempotency, &result, func() error {
+			return withdraw(ctx, q, params, &result)
+		})
+		review.EntryID = sql.NullInt64{Int64: result.Entry.ID, Valid: true}
+	case util.RiskDeposit:
+		var params DepositTxParams
+		var result DepositTxResult
+		err = json.Unmarshal(decision.Request, &params)
+		if err != nil {
+			return decision, fmt.Errorf("failed to unmarshal held deposit: %w", err)
+		}
+
+		err = execIdempotent(ctx, q, idempotency, &result, func() error {
+			return deposit(ctx, q, params, &result)
+		})
+		review.EntryID = sql.NullInt64{Int64: result.Entry.ID, Valid: true}
+	default:
+		return decision, fmt.Errorf("unsupported operation %s", decision.Operation)
+	}
+	if err != nil {
+		return decision, err
+	}
+
+	return q.ReviewRiskDecision(ctx, review)
+}
+
+// recordRiskDecision keeps the decision of the risk rules allowing a money movement using q,
+// inside the transaction making the movement, so a movement that fails leaves no decision
+// counting toward the velocity rule. decision is nil when the movement wasn't screened.
+func recordRiskDecision(ctx context.Context, q Querier, decision *CreateRiskDecisionParams, transferID int64, entryID int64) error {
+	if decision == nil {
+		return nil
+	}
+
+	arg := *decision
+	arg.TransferID = sql.NullInt64{Int64: transferID, Valid: transferID != 0}
+	arg.EntryID = sql.NullInt64{Int64: entryID, Valid: entryID != 0}
+	_, err := q.CreateRiskDecision(ctx, arg)
+	return err
+}
+
+// RejectRiskReview marks a money movement held for review as rejected using q, which must
+// already run inside a transaction. Nothing is moved. It returns ErrRiskReviewNotPending
+// if the decision isn't waiting for review.
+func RejectRiskReview(ctx context.Context, q Querier, arg ReviewRiskTxParams) (RiskDecision, error) {
+	decision, err := lockPendingRiskReview(ctx, q, arg.ID)
+	if err != nil {
+		return decision, err
+	}
+
+	return q.ReviewRiskDecision(ctx, ReviewRiskDecisionParams{
+		ID:           decision.ID,
+		ReviewStatus: sql.NullString{String: util.RiskReviewRejected, Valid: true},
+		ReviewedBy:   sql.NullString{String: arg.ReviewedBy, Valid: true},
+	})
+}
+
+func lockPendingRiskReview(ctx context.Context, q Querier, id int64) (RiskDecision, error) {
+	decision, err := q.GetRiskDecisionForUpdate(ctx, id)
+	if err != nil {
+		return decision, err
+	}
+
+	if decision.ReviewStatus.String != util.RiskReviewPending {
+		return decision, fmt.Errorf("%w: decision %d is %s", ErrRiskReviewNotPending, decision.ID, reviewState(decision))
+	}
+	return decision, nil
+}
+
+// reviewState describes where a decision stands for error messages
+func reviewState(decision RiskDecision) string {
+	if decision.ReviewStatus.Valid {
+		return decision.ReviewStatus.String
+	}
+	return "not held for review"
+}
@@ -0,0 +1,219 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomRiskReview(t *testing.T, operation string, account Account, request interface{}, key string) RiskDecision {
+	body, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	arg := CreateRiskDecisionParams{
+		Username:     account.Owner,
+		Operation:    operation,
+		AccountID:    account.ID,
+		Amount:       100,
+		Currency:     account.Currency,
+		Action:       util.RiskReview,
+		Rules:        []string{"large_amount", "new_payee"},
+		Request:      body,
+		UserAgent:    "test-agent",
+		ClientIp:     "127.0.0.1",
+		ReviewStatus: sql.NullString{String: util.RiskReviewPending, Valid: true},
+	}
+	if key != "" {
+		arg.IdempotencyKey = sql.NullString{String: key, Valid: true}
+		arg.RequestHash = sql.NullString{String: "hash", Valid: true}
+	}
+
+	decision, err := testQueries.CreateRiskDecision(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.Rules, decision.Rules)
+	require.Equal(t, util.RiskReviewPending, decision.ReviewStatus.String)
+	require.False(t, decision.ReviewedAt.Valid)
+	return decision
+}
+
+func reviewHeld(t *testing.T, review func(context.Context, Querier, ReviewRiskTxParams) (RiskDecision, error), id int64) (RiskDecision, error) {
+	store := NewStore(testDB)
+	actor := createRandomUser(t)
+
+	var decision RiskDecision
+	_, err := store.AuditTx(context.Background(), AuditTxParams{
+		CreateAuditLogParams: CreateAuditLogParams{
+			Actor:   actor.Username,
+			Action:  "test_review",
+			Target:  "risk_review",
+			Details: []byte("{}"),
+		},
+		Run: func(q Querier) error {
+			var err error
+			decision, err = review(context.Background(), q, ReviewRiskTxParams{ID: id, ReviewedBy: actor.Username})
+			return err
+		},
+	})
+	return decision, err
+}
+
+func TestApproveRiskReviewTransfer(t *testing.T) {
+	account1 := createRandomAccountInCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountInCurrency(t, util.USD, 0)
+	key := util.RandomString(16)
+
+	held := createRandomRiskReview(t, util.RiskTransfer, account1, TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+		Annotation:    Annotation{Memo: "rent"},
+	}, key)
+
+	found, err := testQueries.GetRiskReviewByIdempotencyKey(context.Background(), GetRiskReviewByIdempotencyKeyParams{
+		Username:       account1.Owner,
+		IdempotencyKey: held.IdempotencyKey,
+	})
+	require.NoError(t, err)
+	require.Equal(t, held.ID, found.ID)
+
+	decision, err := reviewHeld(t, ApproveRiskReview, held.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.RiskReviewApproved, decision.ReviewStatus.String)
+	require.True(t, decision.ReviewedBy.Valid)
+	require.WithinDuration(t, time.Now(), decision.ReviewedAt.Time, time.Minute)
+	require.True(t, decision.TransferID.Valid)
+
+	transfer, err := testQueries.GetTransfer(context.Background(), decision.TransferID.Int64)
+	require.NoError(t, err)
+	require.Equal(t, account1.ID, transfer.FromAccountID)
+	require.Equal(t, account2.ID, transfer.ToAccountID)
+	require.Equal(t, int64(100), transfer.Amount)
+	require.Equal(t, "rent", transfer.Memo)
+
+	// a retry of the original request gets the approved transfer back
+	store := NewStore(testDB)
+	result, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+		Idempotency:   &IdempotencyParams{Username: account1.Owner, Key: key, RequestHash: "hash"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, transfer.ID, result.Transfer.ID)
+
+	// a decision is only reviewed once
+	_, err = reviewHeld(t, ApproveRiskReview, held.ID)
+	require.ErrorIs(t, err, ErrRiskReviewNotPending)
+	_, err = reviewHeld(t, RejectRiskReview, held.ID)
+	require.ErrorIs(t, err, ErrRiskReviewNotPending)
+}
+
+func TestApproveRiskReviewWithdrawalFails(t *testing.T) {
+	account := createRandomAccountInCurrency(t, util.USD, 50)
+
+	held := createRandomRiskReview(t, util.RiskWithdrawal, account, WithdrawTxParams{
+		AccountID: account.ID,
+		Amount:    100,
+	}, "")
+
+	// the account can't cover the withdrawal anymore, the decision stays pending
+	_, err := reviewHeld(t, ApproveRiskReview, held.ID)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	decision, err := testQueries.GetRiskDecision(context.Background(), held.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.RiskReviewPending, decision.ReviewStatus.String)
+}
+
+func TestApproveRiskReviewDeposit(t *testing.T) {
+	account := createRandomAccountInCurrency(t, util.USD, 0)
+
+	held := createRandomRiskReview(t, util.RiskDeposit, account, DepositTxParams{
+		AccountID: account.ID,
+		Amount:    100,
+	}, "")
+
+	decision, err := reviewHeld(t, ApproveRiskReview, held.ID)
+	require.NoError(t, err)
+	require.True(t, decision.EntryID.Valid)
+
+	updated, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), updated.Balance)
+}
+
+func TestRejectRiskReview(t *testing.T) {
+	account := createRandomAccountInCurrency(t, util.USD, 1000)
+
+	held := createRandomRiskReview(t, util.RiskWithdrawal, account, WithdrawTxParams{
+		AccountID: account.ID,
+		Amount:    100,
+	}, "")
+
+	decision, err := reviewHeld(t, RejectRiskReview, held.ID)
+	require.NoError(t, err)
+	require.Equal(t, util.RiskReviewRejected, decision.ReviewStatus.String)
+	require.False(t, decision.EntryID.Valid)
+
+	updated, err := testQueries.GetAccount(context.Background(), account.ID)
+	require.NoError(t, err)
+	require.Equal(t, account.Balance, updated.Balance)
+
+	reviews, err := testQueries.ListRiskReviews(context.Background(), ListRiskReviewsParams{
+		ReviewStatus: sql.NullString{String: util.RiskReviewPending, Valid: true},
+		AfterID:      held.ID - 1,
+		PageSize:     1,
+	})
+	require.NoError(t, err)
+	for _, review := range reviews {
+		require.NotEqual(t, held.ID, review.ID)
+	}
+}
+
+func TestRiskHistoryQueries(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	paid, err := testQueries.HasUserPaidAccount(context.Background(), HasUserPaidAccountParams{
+		Owner:       account1.Owner,
+		ToAccountID: account2.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, paid)
+
+	createRandomTransfer(t, account1, account2, 10)
+	paid, err = testQueries.HasUserPaidAccount(context.Background(), HasUserPaidAccountParams{
+		Owner:       account1.Owner,
+		ToAccountID: account2.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, paid)
+
+	// a user agent never logged in with is first seen now
+	firstSeen, err := testQueries.GetDeviceFirstSeen(context.Background(), GetDeviceFirstSeenParams{
+		Username:  account1.Owner,
+		UserAgent: util.RandomString(12),
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), firstSeen, time.Minute)
+
+	count, err := testQueries.CountRiskDecisionsSince(context.Background(), CountRiskDecisionsSinceParams{
+		Username: account1.Owner,
+		Since:    time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	createRandomRiskReview(t, util.RiskWithdrawal, account1, WithdrawTxParams{AccountID: account1.ID, Amount: 100}, "")
+	count, err = testQueries.CountRiskDecisionsSince(context.Background(), CountRiskDecisionsSinceParams{
+		Username: account1.Owner,
+		Since:    time.Now().Add(-time.Hour),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
@@ -0,0 +1,41 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// RiskHistory answers the risk rules about the past operations of a user from the database
+type RiskHistory struct {
+	q Querier
+}
+
+// NewRiskHistory creates a RiskHistory reading with q
+func NewRiskHistory(q Querier) RiskHistory {
+	return RiskHistory{q: q}
+}
+
+// IsNewPayee reports whether the user never sent money to the account
+func (history RiskHistory) IsNewPayee(ctx context.Context, username string, accountID int64) (bool, error) {
+	paid, err := history.q.HasUserPaidAccount(ctx, HasUserPaidAccountParams{
+		Owner:       username,
+		ToAccountID: accountID,
+	})
+	return !paid, err
+}
+
+// DeviceFirstSeen returns when the user first logged in with the user agent
+func (history RiskHistory) DeviceFirstSeen(ctx context.Context, username string, userAgent string) (time.Time, error) {
+	return history.q.GetDeviceFirstSeen(ctx, GetDeviceFirstSeenParams{
+		Username:  username,
+		UserAgent: userAgent,
+	})
+}
+
+// CountOperations returns how many operations of the user were screened since the time
+func (history RiskHistory) CountOperations(ctx context.Context, username string, since time.Time) (int64, error) {
+	return history.q.CountRiskDecisionsSince(ctx, CountRiskDecisionsSinceParams{
+		Username: username,
+		Since:    since,
+	})
+}
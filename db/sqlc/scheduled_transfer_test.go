@@ -0,0 +1,291 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+// createDueScheduledTransfer creates a scheduled transfer whose first occurrence was an hour ago
+func createDueScheduledTransfer(t *testing.T, fromAccount, toAccount Account, amount int64, recurrence util.Recurrence, failurePolicy string) ScheduledTransfer {
+	occurrence := time.Now().Add(-time.Hour).Truncate(time.Second)
+
+	arg := CreateScheduledTransferParams{
+		Owner:            fromAccount.Owner,
+		FromAccountID:    fromAccount.ID,
+		ToAccountID:      toAccount.ID,
+		Amount:           amount,
+		Frequency:        recurrence.Frequency,
+		IntervalCount:    recurrence.Interval,
+		DayOfMonth:       recurrence.DayOfMonth,
+		StartAt:          occurrence,
+		NextOccurrenceAt: sql.NullTime{Time: occurrence, Valid: true},
+		NextRunAt:        sql.NullTime{Time: occurrence, Valid: true},
+		FailurePolicy:    failurePolicy,
+	}
+
+	scheduled, err := testQueries.CreateScheduledTransfer(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotZero(t, scheduled.ID)
+	require.Equal(t, arg.Owner, scheduled.Owner)
+	require.Equal(t, arg.Amount, scheduled.Amount)
+	require.Equal(t, arg.Frequency, scheduled.Frequency)
+	require.Equal(t, util.ScheduleActive, scheduled.Status)
+	require.Zero(t, scheduled.Attempts)
+
+	return scheduled
+}
+
+func TestRunScheduledTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 100)
+	toAccount := createRandomAccountInCurrency(t, fromAccount.Currency, 0)
+
+	daily := util.Recurrence{Frequency: util.FrequencyDaily, Interval: 1}
+	scheduled := createDueScheduledTransfer(t, fromAccount, toAccount, 30, daily, util.FailurePolicySkip)
+
+	result, err := store.RunScheduledTransferTx(context.Background(), RunScheduledTransferTxParams{ID: scheduled.ID})
+	require.NoError(t, err)
+
+	require.Equal(t, util.RunSucceeded, result.Run.Status)
+	require.Equal(t, int32(1), result.Run.Attempt)
+	require.Equal(t, result.Transfer.Transfer.ID, result.Run.TransferID.Int64)
+	require.Equal(t, int64(70), result.Transfer.FromAccount.Balance)
+	require.Equal(t, int64(30), result.Transfer.ToAccount.Balance)
+
+	next := scheduled.NextOccurrenceAt.Time.AddDate(0, 0, 1)
+	require.Equal(t, util.ScheduleActive, result.ScheduledTransfer.Status)
+	require.WithinDuration(t, next, result.ScheduledTransfer.NextOccurrenceAt.Time, time.Second)
+	require.WithinDuration(t, next, result.ScheduledTransfer.NextRunAt.Time, time.Second)
+
+	// the next occurrence is tomorrow
+	_, err = store.RunScheduledTransferTx(context.Background(), RunScheduledTransferTxParams{ID: scheduled.ID})
+	require.ErrorIs(t, err, ErrScheduledTransferNotDue)
+
+	runs, err := testQueries.ListScheduledTransferRuns(context.Background(), ListScheduledTransferRunsParams{
+		ScheduledTransferID: scheduled.ID,
+		Limit:               10,
+	})
+	require.NoError(t, err)
+	require.Len(t, runs, 1)
+	require.Equal(t, result.Run, runs[0])
+}
+
+func TestRunScheduledTransferTxOnce(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 100)
+	toAccount := createRandomAccountInCurrency(t, fromAccount.Currency, 0)
+
+	once := util.Recurrence{Frequency: util.FrequencyOnce, Interval: 1}
+	scheduled := createDueScheduledTransfer(t, fromAccount, toAccount, 30, once, util.FailurePolicySkip)
+
+	result, err := store.RunScheduledTransferTx(context.Background(), RunScheduledTransferTxParams{ID: scheduled.ID})
+	require.NoError(t, err)
+	require.Equal(t, util.RunSucceeded, result.Run.Status)
+	require.Equal(t, util.ScheduleCompleted, result.ScheduledTransfer.Status)
+	require.False(t, result.ScheduledTransfer.NextOccurrenceAt.Valid)
+	require.False(t, result.ScheduledTransfer.NextRunAt.Valid)
+}
+
+func TestRunScheduledTransferTxSkip(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 10)
+	toAccount := createRandomAccountInCurrency(t, fromAccount.Currency, 0)
+
+	weekly := util.Recurrence{Frequency: util.FrequencyWeekly, Interval: 1}
+	scheduled := createDueScheduledTransfer(t, fromAccount, toAccount, 30, weekly, util.FailurePolicySkip)
+
+	result, err := store.RunScheduledTransferTx(context.Background(), RunScheduledTransferTxParams{
+		ID:         scheduled.ID,
+		MaxRetries: 3,
+		RetryDelay: time.Hour,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, util.RunSkipped, result.Run.Status)
+	require.False(t, result.Run.TransferID.Valid)
+	require.Contains(t, result.Run.Error, ErrInsufficientFunds.Error())
+
+	next := scheduled.NextOccurrenceAt.Time.AddDate(0, 0, 7)
+	require.Equal(t, util.ScheduleActive, result.ScheduledTransfer.Status)
+	require.WithinDuration(t, next, result.ScheduledTransfer.NextOccurrenceAt.Time, time.Second)
+	require.Zero(t, result.ScheduledTransfer.Attempts)
+
+	account, err := testQueries.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), account.Balance)
+}
+
+func TestRunScheduledTransferTxRiskDenied(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 100)
+	toAccount := createRandomAccountInCurrency(t, fromAccount.Currency, 0)
+
+	weekly := util.Recurrence{Frequency: util.FrequencyWeekly, Interval: 1}
+	scheduled := createDueScheduledTransfer(t, fromAccount, toAccount, 30, weekly, util.FailurePolicySkip)
+
+	result, err := store.RunScheduledTransferTx(context.Background(), RunScheduledTransferTxParams{
+		ID:         scheduled.ID,
+		MaxRetries: 3,
+		RetryDelay: time.Hour,
+		Screen: func(q Querier, screened ScheduledTransfer) (*CreateRiskDecisionParams, error) {
+			require.Equal(t, scheduled.ID, screened.ID)
+			return nil, ErrRiskDenied
+		},
+	})
+	require.NoError(t, err)
+
+	// a denied run is skipped like one without the funds
+	require.Equal(t, util.RunSkipped, result.Run.Status)
+	require.False(t, result.Run.TransferID.Valid)
+	require.Contains(t, result.Run.Error, ErrRiskDenied.Error())
+	require.Equal(t, util.ScheduleActive, result.ScheduledTransfer.Status)
+
+	account, err := testQueries.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), account.Balance)
+}
+
+func TestRunScheduledTransferTxRiskDecisionRecordedWithTransfer(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 20)
+	toAccount := createRandomAccountInCurrency(t, fromAccount.Currency, 0)
+
+	weekly := util.Recurrence{Frequency: util.FrequencyWeekly, Interval: 1}
+	scheduled := createDueScheduledTransfer(t, fromAccount, toAccount, 30, weekly, util.FailurePolicyRetry)
+	arg := RunScheduledTransferTxParams{
+		ID:         scheduled.ID,
+		MaxRetries: 3,
+		// retry straight away so the test doesn't have to wait
+		RetryDelay: -time.Second,
+		Screen: func(q Querier, screened ScheduledTransfer) (*CreateRiskDecisionParams, error) {
+			return &CreateRiskDecisionParams{
+				Username:    screened.Owner,
+				Operation:   util.RiskTransfer,
+				AccountID:   screened.FromAccountID,
+				ToAccountID: sql.NullInt64{Int64: screened.ToAccountID, Valid: true},
+				Amount:      screened.Amount,
+				Currency:    fromAccount.Currency,
+				Action:      util.RiskAllow,
+				Rules:       []string{},
+				Request:     []byte("{}"),
+			}, nil
+		},
+	}
+	since := time.Now().Add(-time.Minute)
+
+	// the run fails for want of funds, so the decision allowing it isn't kept
+	result, err := store.RunScheduledTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, util.RunRetrying, result.Run.Status)
+
+	count, err := testQueries.CountRiskDecisionsSince(context.Background(), CountRiskDecisionsSinceParams{
+		Username: fromAccount.Owner,
+		Since:    since,
+	})
+	require.NoError(t, err)
+	require.Zero(t, count)
+
+	_, err = testQueries.AddAccountBalance(context.Background(), AddAccountBalanceParams{ID: fromAccount.ID, Amount: 10})
+	require.NoError(t, err)
+
+	result, err = store.RunScheduledTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, util.RunSucceeded, result.Run.Status)
+
+	count, err = testQueries.CountRiskDecisionsSince(context.Background(), CountRiskDecisionsSinceParams{
+		Username: fromAccount.Owner,
+		Since:    since,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestRunScheduledTransferTxNewPayeeLimit(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 100)
+	toAccount := createRandomAccountInCurrency(t, fromAccount.Currency, 0)
+
+	weekly := util.Recurrence{Frequency: util.FrequencyWeekly, Interval: 1}
+	scheduled := createDueScheduledTransfer(t, fromAccount, toAccount, 30, weekly, util.FailurePolicySkip)
+
+	result, err := store.RunScheduledTransferTx(context.Background(), RunScheduledTransferTxParams{
+		ID:            scheduled.ID,
+		MaxRetries:    3,
+		RetryDelay:    time.Hour,
+		NewPayeeLimit: NewPayeeLimit{Amount: 20, Period: 24 * time.Hour},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.RunSkipped, result.Run.Status)
+	require.Contains(t, result.Run.Error, ErrNewPayeeLimit.Error())
+
+	account, err := testQueries.GetAccount(context.Background(), fromAccount.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(100), account.Balance)
+}
+
+func TestRunScheduledTransferTxRetry(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 10)
+	toAccount := createRandomAccountInCurrency(t, fromAccount.Currency, 0)
+
+	monthly := util.Recurrence{Frequency: util.FrequencyMonthly, Interval: 1, DayOfMonth: util.LastDayOfMonth}
+	scheduled := createDueScheduledTransfer(t, fromAccount, toAccount, 30, monthly, util.FailurePolicyRetry)
+	arg := RunScheduledTransferTxParams{
+		ID:         scheduled.ID,
+		MaxRetries: 1,
+		// retry straight away so the test doesn't have to wait
+		RetryDelay: -time.Second,
+	}
+
+	result, err := store.RunScheduledTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, util.RunRetrying, result.Run.Status)
+	require.Equal(t, int32(1), result.ScheduledTransfer.Attempts)
+	require.Equal(t, scheduled.NextOccurrenceAt.Time, result.ScheduledTransfer.NextOccurrenceAt.Time)
+
+	result, err = store.RunScheduledTransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, util.RunFailed, result.Run.Status)
+	require.Equal(t, int32(2), result.Run.Attempt)
+	require.Zero(t, result.ScheduledTransfer.Attempts)
+
+	next, _ := monthly.Next(scheduled.NextOccurrenceAt.Time)
+	require.WithinDuration(t, next, result.ScheduledTransfer.NextOccurrenceAt.Time, time.Second)
+}
+
+func TestRunScheduledTransferTxPaused(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 100)
+	toAccount := createRandomAccountInCurrency(t, fromAccount.Currency, 0)
+
+	daily := util.Recurrence{Frequency: util.FrequencyDaily, Interval: 1}
+	scheduled := createDueScheduledTransfer(t, fromAccount, toAccount, 30, daily, util.FailurePolicySkip)
+
+	paused, err := testQueries.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Status: sql.NullString{String: util.SchedulePaused, Valid: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, util.SchedulePaused, paused.Status)
+
+	_, err = store.RunScheduledTransferTx(context.Background(), RunScheduledTransferTxParams{ID: scheduled.ID})
+	require.ErrorIs(t, err, ErrScheduledTransferNotDue)
+
+	// a cancelled schedule can't be resumed
+	_, err = testQueries.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Status: sql.NullString{String: util.ScheduleCancelled, Valid: true},
+	})
+	require.NoError(t, err)
+
+	_, err = testQueries.UpdateScheduledTransfer(context.Background(), UpdateScheduledTransferParams{
+		ID:     scheduled.ID,
+		Status: sql.NullString{String: util.ScheduleActive, Valid: true},
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+}
@@ -0,0 +1,214 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomSession(t *testing.T, user User) Session {
+	id := uuid.New()
+	arg := CreateSessionParams{
+		ID:           id,
+		Username:     user.Username,
+		RefreshToken: util.RandomString(32),
+		UserAgent:    util.RandomString(10),
+		ClientIp:     "127.0.0.1",
+		IsBlocked:    false,
+		ExpiresAt:    time.Now().Add(time.Hour),
+		FamilyID:     id,
+	}
+
+	session, err := testQueries.CreateSession(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, session)
+
+	require.Equal(t, arg.ID, session.ID)
+	require.Equal(t, arg.Username, session.Username)
+	require.Equal(t, arg.RefreshToken, session.RefreshToken)
+	require.Equal(t, arg.FamilyID, session.FamilyID)
+	require.False(t, session.IsBlocked)
+	require.False(t, session.ConsumedAt.Valid)
+	require.NotZero(t, session.CreatedAt)
+
+	return session
+}
+
+func TestListSessions(t *testing.T) {
+	user := createRandomUser(t)
+
+	var sessions []Session
+	for i := 0; i < 3; i++ {
+		sessions = append(sessions, createRandomSession(t, user))
+	}
+
+	_, err := testQueries.BlockSession(context.Background(), BlockSessionParams{
+		ID:       sessions[0].ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+
+	// blocked sessions are not listed
+	gotSessions, err := testQueries.ListSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, gotSessions, 2)
+	for _, session := range gotSessions {
+		require.NotEqual(t, sessions[0].ID, session.ID)
+		require.Equal(t, user.Username, session.Username)
+	}
+}
+
+func TestBlockSession(t *testing.T) {
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	// only the owner can block the session
+	otherUser := createRandomUser(t)
+	_, err := testQueries.BlockSession(context.Background(), BlockSessionParams{
+		ID:       session.ID,
+		Username: otherUser.Username,
+	})
+	require.ErrorIs(t, err, sql.ErrNoRows)
+
+	blockedSession, err := testQueries.BlockSession(context.Background(), BlockSessionParams{
+		ID:       session.ID,
+		Username: user.Username,
+	})
+	require.NoError(t, err)
+	require.True(t, blockedSession.IsBlocked)
+}
+
+func TestBlockUserSessions(t *testing.T) {
+	user := createRandomUser(t)
+	for i := 0; i < 3; i++ {
+		createRandomSession(t, user)
+	}
+
+	revoked, err := testQueries.BlockUserSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Equal(t, int64(3), revoked)
+
+	sessions, err := testQueries.ListSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+}
+
+func TestRotateSessionTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	user := createRandomUser(t)
+	session1 := createRandomSession(t, user)
+
+	result, err := store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		OldSessionID: session1.ID,
+		NewSession:   randomRotatedSession(),
+	})
+	require.NoError(t, err)
+	require.True(t, result.OldSession.ConsumedAt.Valid)
+
+	session2 := result.Session
+	require.Equal(t, user.Username, session2.Username)
+	require.Equal(t, session1.FamilyID, session2.FamilyID)
+	require.False(t, session2.ConsumedAt.Valid)
+
+	// consumed sessions are no longer listed as devices
+	sessions, err := store.ListSessions(context.Background(), user.Username)
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.Equal(t, session2.ID, sessions[0].ID)
+
+	// presenting the first token again blocks the whole family
+	_, err = store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		OldSessionID: session1.ID,
+		NewSession:   randomRotatedSession(),
+	})
+	require.ErrorIs(t, err, ErrRefreshTokenReused)
+
+	session2, err = store.GetSession(context.Background(), session2.ID)
+	require.NoError(t, err)
+	require.True(t, session2.IsBlocked)
+
+	_, err = store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		OldSessionID: session2.ID,
+		NewSession:   randomRotatedSession(),
+	})
+	require.ErrorIs(t, err, ErrSessionBlocked)
+}
+
+func TestRotateSessionTxKeepsFamilyExpiry(t *testing.T) {
+	store := NewStore(testDB)
+
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	// however often the family refreshes, it ends when the login session does
+	for range 3 {
+		newSession := randomRotatedSession()
+		newSession.ExpiresAt = session.ExpiresAt.Add(24 * time.Hour)
+
+		result, err := store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+			OldSessionID: session.ID,
+			NewSession:   newSession,
+		})
+		require.NoError(t, err)
+		require.WithinDuration(t, session.ExpiresAt, result.Session.ExpiresAt, time.Second)
+		session = result.Session
+	}
+
+	// an earlier expiry is kept as it is
+	newSession := randomRotatedSession()
+	newSession.ExpiresAt = time.Now().Add(time.Minute)
+	result, err := store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+		OldSessionID: session.ID,
+		NewSession:   newSession,
+	})
+	require.NoError(t, err)
+	require.WithinDuration(t, newSession.ExpiresAt, result.Session.ExpiresAt, time.Second)
+}
+
+func TestRotateSessionTxConcurrent(t *testing.T) {
+	store := NewStore(testDB)
+
+	user := createRandomUser(t)
+	session := createRandomSession(t, user)
+
+	n := 5
+	errs := make(chan error)
+
+	for range n {
+		go func() {
+			_, err := store.RotateSessionTx(context.Background(), RotateSessionTxParams{
+				OldSessionID: session.ID,
+				NewSession:   randomRotatedSession(),
+			})
+			errs <- err
+		}()
+	}
+
+	// exactly one refresh wins, the others count as reuse
+	succeeded := 0
+	for range n {
+		err := <-errs
+		if err == nil {
+			succeeded++
+			continue
+		}
+		require.ErrorIs(t, err, ErrRefreshTokenReused)
+	}
+	require.Equal(t, 1, succeeded)
+}
+
+func randomRotatedSession() CreateSessionParams {
+	return CreateSessionParams{
+		ID:           uuid.New(),
+		RefreshToken: util.RandomString(32),
+		UserAgent:    util.RandomString(10),
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    time.Now().Add(time.Hour),
+	}
+}
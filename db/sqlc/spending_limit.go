@@ -0,0 +1,219 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// Limits returns the limits in force at now, the pending ones once their cooling-off period is over
+func (limit SpendingLimit) Limits(now time.Time) util.SpendingLimits {
+	if pending, ok := limit.pendingLimits(); ok && !now.Before(limit.PendingEffectiveAt.Time) {
+		return pending
+	}
+	return util.SpendingLimits{
+		PerTransaction: limit.PerTransactionLimit,
+		Daily:          limit.DailyLimit,
+		Monthly:        limit.MonthlyLimit,
+	}
+}
+
+// PendingLimits returns the limits still waiting at now for their cooling-off period to end
+func (limit SpendingLimit) PendingLimits(now time.Time) (util.SpendingLimits, bool) {
+	pending, ok := limit.pendingLimits()
+	if !ok || !now.Before(limit.PendingEffectiveAt.Time) {
+		return util.SpendingLimits{}, false
+	}
+	return pending, true
+}
+
+func (limit SpendingLimit) pendingLimits() (util.SpendingLimits, bool) {
+	if !limit.PendingEffectiveAt.Valid {
+		return util.SpendingLimits{}, false
+	}
+	return util.SpendingLimits{
+		PerTransaction: limit.PendingPerTransactionLimit.Int64,
+		Daily:          limit.PendingDailyLimit.Int64,
+		Monthly:        limit.PendingMonthlyLimit.Int64,
+	}, true
+}
+
+// UpdateSpendingLimitTxParams contains the input parameters of the update spending limit transaction
+type UpdateSpendingLimitTxParams struct {
+	Username string `json:"username"`
+	Currency string `json:"currency"`
+	// AccountID is set for the limits of one account of the user,
+	// otherwise the limits apply to all the accounts of the user in the currency
+	AccountID sql.NullInt64       `json:"account_id"`
+	Limits    util.SpendingLimits `json:"limits"`
+	// CoolingOff is how long increases wait before they take effect
+	CoolingOff time.Duration `json:"cooling_off"`
+}
+
+// UpdateSpendingLimitTxResult is the result of the update spending limit transaction
+type UpdateSpendingLimitTxResult struct {
+	SpendingLimit SpendingLimit `json:"spending_limit"`
+}
+
+// UpdateSpendingLimitTx sets spending limits within a database transaction.
+// Lower limits take effect at once. When any limit is raised or removed, the new limits are
+// kept pending until the cooling-off period is over and meanwhile the lower of the limits in
+// force and the new ones apply, so a stolen session can't lift a limit and use it straight away.
+// An update replaces any pending limits, which cancels increases that haven't taken effect yet.
+func (store *SQLStore) UpdateSpendingLimitTx(ctx context.Context, arg UpdateSpendingLimitTxParams) (UpdateSpendingLimitTxResult, error) {
+	var result UpdateSpendingLimitTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var current SpendingLimit
+		var err error
+		if arg.AccountID.Valid {
+			current, err = q.GetAccountSpendingLimitForUpdate(ctx, arg.AccountID)
+		} else {
+			current, err = q.GetUserSpendingLimitForUpdate(ctx, GetUserSpendingLimitForUpdateParams{
+				Username: arg.Username,
+				Currency: arg.Currency,
+			})
+		}
+		exists := err == nil
+		if err != nil && err != sql.ErrNoRows {
+			return err
+		}
+
+		now := time.Now()
+		var inForce util.SpendingLimits
+		if exists {
+			inForce = current.Limits(now)
+		}
+
+		update := UpdateSpendingLimitParams{
+			ID:                  current.ID,
+			PerTransactionLimit: arg.Limits.PerTransaction,
+			DailyLimit:          arg.Limits.Daily,
+			MonthlyLimit:        arg.Limits.Monthly,
+		}
+		if inForce.Raises(arg.Limits) {
+			tightest := inForce.Tightest(arg.Limits)
+			update.PerTransactionLimit = tightest.PerTransaction
+			update.DailyLimit = tightest.Daily
+			update.MonthlyLimit = tightest.Monthly
+			update.PendingPerTransactionLimit = sql.NullInt64{Int64: arg.Limits.PerTransaction, Valid: true}
+			update.PendingDailyLimit = sql.NullInt64{Int64: arg.Limits.Daily, Valid: true}
+			update.PendingMonthlyLimit = sql.NullInt64{Int64: arg.Limits.Monthly, Valid: true}
+			update.PendingEffectiveAt = sql.NullTime{Time: now.Add(arg.CoolingOff), Valid: true}
+		}
+
+		if exists {
+			result.SpendingLimit, err = q.UpdateSpendingLimit(ctx, update)
+			return err
+		}
+
+		result.SpendingLimit, err = q.CreateSpendingLimit(ctx, CreateSpendingLimitParams{
+			Username:                   arg.Username,
+			Currency:                   arg.Currency,
+			AccountID:                  arg.AccountID,
+			PerTransactionLimit:        update.PerTransactionLimit,
+			DailyLimit:                 update.DailyLimit,
+			MonthlyLimit:               update.MonthlyLimit,
+			PendingPerTransactionLimit: update.PendingPerTransactionLimit,
+			PendingDailyLimit:          update.PendingDailyLimit,
+			PendingMonthlyLimit:        update.PendingMonthlyLimit,
+			PendingEffectiveAt:         update.PendingEffectiveAt,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// checkSpendingLimits reports whether amount can be debited from the account within its own
+// limits and those of all the accounts of its owner in its currency, using q, which must already
+// run inside a transaction holding the lock on the account. The daily and monthly limits are
+// checked against the debits of the rolling window. The limits of the owner are locked too,
+// so debits from their other accounts wait for this one.
+// It returns a SpendingLimitError if a limit would be exceeded.
+func checkSpendingLimits(ctx context.Context, q Querier, account Account, amount int64) error {
+	now := time.Now()
+
+	limit, err := q.GetAccountSpendingLimitForUpdate(ctx, sql.NullInt64{Int64: account.ID, Valid: true})
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+	if err == nil {
+		err = checkLimits(util.LimitScopeAccount, limit.Limits(now), amount, now, func(since time.Time) (int64, error) {
+			return q.SumAccountDebitsSince(ctx, SumAccountDebitsSinceParams{
+				AccountID: account.ID,
+				Since:     since,
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	limit, err = q.GetUserSpendingLimitForUpdate(ctx, GetUserSpendingLimitForUpdateParams{
+		Username: account.Owner,
+		Currency: account.Currency,
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	}
+	return checkLimits(util.LimitScopeUser, limit.Limits(now), amount, now, func(since time.Time) (int64, error) {
+		return q.SumUserDebitsSince(ctx, SumUserDebitsSinceParams{
+			Owner:    account.Owner,
+			Currency: account.Currency,
+			Since:    since,
+		})
+	})
+}
+
+// checkLimits checks amount against limits, debited returns what was debited since a time
+func checkLimits(
+	scope string,
+	limits util.SpendingLimits,
+	amount int64,
+	now time.Time,
+	debited func(since time.Time) (int64, error),
+) error {
+	if limits.PerTransaction != 0 && amount > limits.PerTransaction {
+		return &SpendingLimitError{
+			Scope:     scope,
+			Limit:     util.LimitPerTransaction,
+			Max:       limits.PerTransaction,
+			Remaining: limits.PerTransaction,
+		}
+	}
+
+	windows := []struct {
+		limit  string
+		max    int64
+		window time.Duration
+	}{
+		{util.LimitDaily, limits.Daily, util.DailyLimitWindow},
+		{util.LimitMonthly, limits.Monthly, util.MonthlyLimitWindow},
+	}
+	for _, w := range windows {
+		if w.max == 0 {
+			continue
+		}
+
+		spent, err := debited(now.Add(-w.window))
+		if err != nil {
+			return err
+		}
+		if spent+amount > w.max {
+			return &SpendingLimitError{
+				Scope:     scope,
+				Limit:     w.limit,
+				Max:       w.max,
+				Remaining: max(w.max-spent, 0),
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,154 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func setSpendingLimit(t *testing.T, account Account, scope string, limits util.SpendingLimits, coolingOff time.Duration) SpendingLimit {
+	store := NewStore(testDB)
+
+	arg := UpdateSpendingLimitTxParams{
+		Username:   account.Owner,
+		Currency:   account.Currency,
+		Limits:     limits,
+		CoolingOff: coolingOff,
+	}
+	if scope == util.LimitScopeAccount {
+		arg.AccountID = sql.NullInt64{Int64: account.ID, Valid: true}
+	}
+
+	result, err := store.UpdateSpendingLimitTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, arg.AccountID, result.SpendingLimit.AccountID)
+	return result.SpendingLimit
+}
+
+func TestUpdateSpendingLimitTx(t *testing.T) {
+	account := createRandomAccount(t)
+
+	// a first limit only lowers what was unlimited
+	limit := setSpendingLimit(t, account, util.LimitScopeAccount, util.SpendingLimits{PerTransaction: 1000}, time.Hour)
+	require.Equal(t, util.SpendingLimits{PerTransaction: 1000}, limit.Limits(time.Now()))
+	_, ok := limit.PendingLimits(time.Now())
+	require.False(t, ok)
+
+	// an increase waits for the cooling-off period, a decrease in the same update doesn't
+	raised := util.SpendingLimits{PerTransaction: 2000, Daily: 5000}
+	limit = setSpendingLimit(t, account, util.LimitScopeAccount, raised, time.Hour)
+	require.Equal(t, util.SpendingLimits{PerTransaction: 1000, Daily: 5000}, limit.Limits(time.Now()))
+	pending, ok := limit.PendingLimits(time.Now())
+	require.True(t, ok)
+	require.Equal(t, raised, pending)
+	require.WithinDuration(t, time.Now().Add(time.Hour), limit.PendingEffectiveAt.Time, time.Minute)
+	require.Equal(t, raised, limit.Limits(time.Now().Add(2*time.Hour)))
+
+	// a decrease cancels the pending increase
+	limit = setSpendingLimit(t, account, util.LimitScopeAccount, util.SpendingLimits{PerTransaction: 500}, time.Hour)
+	require.Equal(t, util.SpendingLimits{PerTransaction: 500}, limit.Limits(time.Now()))
+	require.False(t, limit.PendingEffectiveAt.Valid)
+
+	limits, err := testQueries.ListSpendingLimits(context.Background(), account.Owner)
+	require.NoError(t, err)
+	require.Len(t, limits, 1)
+	require.Equal(t, limit.ID, limits[0].ID)
+}
+
+func TestTransferTxSpendingLimits(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountInCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountInCurrency(t, util.USD, 0)
+	setSpendingLimit(t, account1, util.LimitScopeAccount, util.SpendingLimits{PerTransaction: 250, Daily: 300}, 0)
+
+	arg := TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        300,
+	}
+	_, err := store.TransferTx(context.Background(), arg)
+	var limitErr *SpendingLimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, util.LimitScopeAccount, limitErr.Scope)
+	require.Equal(t, util.LimitPerTransaction, limitErr.Limit)
+
+	arg.Amount = 200
+	_, err = store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+
+	_, err = store.TransferTx(context.Background(), arg)
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, util.LimitDaily, limitErr.Limit)
+	require.Equal(t, int64(300), limitErr.Max)
+	require.Equal(t, int64(100), limitErr.Remaining)
+
+	arg.Amount = 100
+	result, err := store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(700), result.FromAccount.Balance)
+}
+
+func TestWithdrawTxUserSpendingLimits(t *testing.T) {
+	store := NewStore(testDB)
+
+	checking := createRandomAccountInCurrency(t, util.EUR, 1000)
+	savings, err := testQueries.CreateAccount(context.Background(), CreateAccountParams{
+		Owner:    checking.Owner,
+		Balance:  1000,
+		Currency: util.EUR,
+		Type:     util.AccountSavings,
+	})
+	require.NoError(t, err)
+	setSpendingLimit(t, checking, util.LimitScopeUser, util.SpendingLimits{Monthly: 500}, 0)
+
+	_, err = store.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID: checking.ID,
+		Amount:    300,
+	})
+	require.NoError(t, err)
+
+	// the limits of the user cover all their accounts in the currency
+	_, err = store.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID: savings.ID,
+		Amount:    300,
+	})
+	var limitErr *SpendingLimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, util.LimitScopeUser, limitErr.Scope)
+	require.Equal(t, util.LimitMonthly, limitErr.Limit)
+	require.Equal(t, int64(200), limitErr.Remaining)
+}
+
+func TestCaptureHoldTxSpendingLimits(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccountInCurrency(t, util.USD, 1000)
+	setSpendingLimit(t, account, util.LimitScopeAccount, util.SpendingLimits{Daily: 300}, 0)
+
+	_, err := store.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID: account.ID,
+		Amount:    200,
+	})
+	require.NoError(t, err)
+
+	// holding funds is not spending them, capturing them is
+	hold := createRandomHold(t, account, 200, time.Hour)
+
+	_, err = store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{HoldID: hold.ID})
+	var limitErr *SpendingLimitError
+	require.ErrorAs(t, err, &limitErr)
+	require.Equal(t, util.LimitDaily, limitErr.Limit)
+	require.Equal(t, int64(100), limitErr.Remaining)
+
+	result, err := store.CaptureHoldTx(context.Background(), CaptureHoldTxParams{
+		HoldID: hold.ID,
+		Amount: 100,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(700), result.Account.Balance)
+}
@@ -0,0 +1,81 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// statementPageSize is how many entries of a statement are fetched at a time
+const statementPageSize = 500
+
+// GetStatementParams contains the input parameters of a statement.
+// FromTime is inclusive, ToTime is exclusive.
+type GetStatementParams struct {
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+}
+
+// Statement lists the entries of an account over a period, oldest first,
+// with the balance of the account before and after them
+type Statement struct {
+	Account        Account   `json:"account"`
+	FromTime       time.Time `json:"from_time"`
+	ToTime         time.Time `json:"to_time"`
+	OpeningBalance int64     `json:"opening_balance"`
+	ClosingBalance int64     `json:"closing_balance"`
+	Entries        []Entry   `json:"entries"`
+}
+
+// GetStatement reads the statement of an account from a single snapshot of the database.
+// The balances are worked back from the current balance of the account, so the opening
+// balance plus every entry of the statement always adds up to the closing balance.
+func (store *SQLStore) GetStatement(ctx context.Context, arg GetStatementParams) (Statement, error) {
+	result := Statement{
+		FromTime: arg.FromTime,
+		ToTime:   arg.ToTime,
+		Entries:  []Entry{},
+	}
+
+	err := store.execReadTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.Account, err = q.GetAccount(ctx, arg.AccountID)
+		if err != nil {
+			return err
+		}
+
+		sinceFrom, err := q.SumEntriesSince(ctx, SumEntriesSinceParams{AccountID: arg.AccountID, Since: arg.FromTime})
+		if err != nil {
+			return err
+		}
+		sinceTo, err := q.SumEntriesSince(ctx, SumEntriesSinceParams{AccountID: arg.AccountID, Since: arg.ToTime})
+		if err != nil {
+			return err
+		}
+		result.OpeningBalance = result.Account.Balance - sinceFrom
+		result.ClosingBalance = result.Account.Balance - sinceTo
+
+		var afterID int64
+		for {
+			entries, err := q.ListStatementEntries(ctx, ListStatementEntriesParams{
+				AccountID: arg.AccountID,
+				FromTime:  arg.FromTime,
+				ToTime:    arg.ToTime,
+				AfterID:   afterID,
+				PageSize:  statementPageSize,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Entries = append(result.Entries, entries...)
+			if len(entries) < statementPageSize {
+				return nil
+			}
+			afterID = entries[len(entries)-1].ID
+		}
+	})
+
+	return result, err
+}
@@ -0,0 +1,47 @@
+package db
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetStatement(t *testing.T) {
+	store := NewStore(testDB)
+
+	account := createRandomAccountInCurrency(t, util.USD, 1000)
+	fromTime := time.Now().Add(-time.Minute)
+
+	_, err := store.DepositTx(context.Background(), DepositTxParams{AccountID: account.ID, Amount: 500})
+	require.NoError(t, err)
+	_, err = store.WithdrawTx(context.Background(), WithdrawTxParams{AccountID: account.ID, Amount: 200})
+	require.NoError(t, err)
+
+	toTime := time.Now().Add(time.Minute)
+	statement, err := store.GetStatement(context.Background(), GetStatementParams{
+		AccountID: account.ID,
+		FromTime:  fromTime,
+		ToTime:    toTime,
+	})
+	require.NoError(t, err)
+	require.Equal(t, account.ID, statement.Account.ID)
+	require.Equal(t, int64(1000), statement.OpeningBalance)
+	require.Equal(t, int64(1300), statement.ClosingBalance)
+	require.Len(t, statement.Entries, 2)
+	require.Equal(t, int64(500), statement.Entries[0].Amount)
+	require.Equal(t, int64(-200), statement.Entries[1].Amount)
+
+	// a period before the entries only sees the balance they started from
+	statement, err = store.GetStatement(context.Background(), GetStatementParams{
+		AccountID: account.ID,
+		FromTime:  fromTime.Add(-time.Hour),
+		ToTime:    fromTime,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1000), statement.OpeningBalance)
+	require.Equal(t, int64(1000), statement.ClosingBalance)
+	require.Empty(t, statement.Entries)
+}
@@ -10,10 +10,32 @@ import (
 type Store interface {
 	Querier
 	TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error)
+	FxTransferTx(ctx context.Context, arg FxTransferTxParams) (TransferTxResult, error)
+	ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error)
 	CreateUserTx(ctx context.Context, arg CreateUserTxParams) (CreateUserTxResult, error)
 	VerifyEmailTx(ctx context.Context, arg VerifyEmailTxParams) (VerifyEmailTxResult, error)
 	DepositTx(ctx context.Context, arg DepositTxParams) (DepositTxResult, error)
 	WithdrawTx(ctx context.Context, arg WithdrawTxParams) (WithdrawTxResult, error)
+	RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (RotateSessionTxResult, error)
+	AuditTx(ctx context.Context, arg AuditTxParams) (AuditTxResult, error)
+	CreateHoldTx(ctx context.Context, arg CreateHoldTxParams) (CreateHoldTxResult, error)
+	CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error)
+	ReleaseHoldTx(ctx context.Context, arg ReleaseHoldTxParams) (ReleaseHoldTxResult, error)
+	TransferBatchTx(ctx context.Context, arg TransferBatchTxParams) (TransferBatchTxResult, error)
+	RunScheduledTransferTx(ctx context.Context, arg RunScheduledTransferTxParams) (RunScheduledTransferTxResult, error)
+	ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParams) (ChangeAccountStatusTxResult, error)
+	ListAccountsPage(ctx context.Context, arg ListAccountsPageParams) (ListAccountsPageResult, error)
+	ListEntriesPage(ctx context.Context, arg ListEntriesPageParams) (ListEntriesPageResult, error)
+	ListTransfersPage(ctx context.Context, arg ListTransfersPageParams) (ListTransfersPageResult, error)
+	GetStatement(ctx context.Context, arg GetStatementParams) (Statement, error)
+	ReconcileBalances(ctx context.Context, arg ReconcileBalancesParams) (ReconcileBalancesResult, error)
+	AccrueInterest(ctx context.Context, arg AccrueInterestParams) (AccrueInterestResult, error)
+	CapitalizeInterestTx(ctx context.Context, arg CapitalizeInterestTxParams) (CapitalizeInterestTxResult, error)
+	UpdateSpendingLimitTx(ctx context.Context, arg UpdateSpendingLimitTxParams) (UpdateSpendingLimitTxResult, error)
+	CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestTxParams) (CreatePaymentRequestTxResult, error)
+	AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error)
+	DeclinePaymentRequestTx(ctx context.Context, arg ClosePaymentRequestTxParams) (PaymentRequest, error)
+	ExpirePaymentRequestTx(ctx context.Context, arg ClosePaymentRequestTxParams) (PaymentRequest, error)
 }
 
 // SQLStore provides all functions to execute SQL queries and transactions
@@ -32,7 +54,17 @@ func NewStore(db *sql.DB) Store {
 
 // ExecTx executes a function within a database transaction
 func (store *SQLStore) execTx(ctx context.Context, fn func(*Queries) error) error {
-	tx, err := store.db.BeginTx(ctx, nil)
+	return store.execTxOptions(ctx, nil, fn)
+}
+
+// execReadTx executes a function within a read-only transaction, so every query sees
+// the same snapshot of the database
+func (store *SQLStore) execReadTx(ctx context.Context, fn func(*Queries) error) error {
+	return store.execTxOptions(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true}, fn)
+}
+
+func (store *SQLStore) execTxOptions(ctx context.Context, opts *sql.TxOptions, fn func(*Queries) error) error {
+	tx, err := store.db.BeginTx(ctx, opts)
 	if err != nil {
 		return err
 	}
@@ -4,20 +4,22 @@ import (
 	"context"
 	"fmt"
 	"testing"
+	"time"
 
+	"github.com/JaidenShall/simplebank/util"
 	"github.com/stretchr/testify/require"
 )
 
 func TestTransferTx(t *testing.T) {
 	store := NewStore(testDB)
 
-	account1 := createRandomAccount(t)
-	account2 := createRandomAccount(t)
-	fmt.Println(">> before:", account1.Balance, account2.Balance)
-
 	n := 5
 	amount := int64(10)
 
+	account1 := createRandomAccountWithBalance(t, int64(n)*amount)
+	account2 := createRandomAccount(t)
+	fmt.Println(">> before:", account1.Balance, account2.Balance)
+
 	errs := make(chan error)
 	results := make(chan TransferTxResult)
 
@@ -118,12 +120,12 @@ func TestTransferTx(t *testing.T) {
 func TestTransferTxDeadlock(t *testing.T) {
 	store := NewStore(testDB)
 
-	account1 := createRandomAccount(t)
-	account2 := createRandomAccount(t)
-	fmt.Println(">> before:", account1.Balance, account2.Balance)
-
 	n := 10
 	amount := int64(10)
+
+	account1 := createRandomAccountWithBalance(t, int64(n)*amount)
+	account2 := createRandomAccountWithBalance(t, int64(n)*amount)
+	fmt.Println(">> before:", account1.Balance, account2.Balance)
 	errs := make(chan error)
 
 	for i := range n {
@@ -162,3 +164,518 @@ func TestTransferTxDeadlock(t *testing.T) {
 	require.Equal(t, account1.Balance, updatedAccount1.Balance)
 	require.Equal(t, account2.Balance, updatedAccount2.Balance)
 }
+
+func TestTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+
+	n := 10
+	amount := int64(30)
+	balance := int64(100)
+
+	account1 := createRandomAccountWithBalance(t, balance)
+	account2 := createRandomAccount(t)
+
+	errs := make(chan error)
+
+	// run n concurrent transfers that together ask for far more than the balance
+	for range n {
+		go func() {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account1.ID,
+				ToAccountID:   account2.ID,
+				Amount:        amount,
+			})
+
+			errs <- err
+		}()
+	}
+
+	succeeded := 0
+	for range n {
+		err := <-errs
+		if err != nil {
+			require.ErrorIs(t, err, ErrInsufficientFunds)
+			continue
+		}
+		succeeded++
+	}
+
+	// only as many transfers as the balance covers can go through
+	require.Equal(t, int(balance/amount), succeeded)
+
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, balance-int64(succeeded)*amount, updatedAccount1.Balance)
+	require.GreaterOrEqual(t, updatedAccount1.Balance, int64(0))
+
+	updatedAccount2, err := store.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, account2.Balance+int64(succeeded)*amount, updatedAccount2.Balance)
+}
+
+func TestTransferTxOverdraftLimit(t *testing.T) {
+	store := NewStore(testDB)
+
+	n := 5
+	amount := int64(20)
+	overdraftLimit := int64(50)
+
+	account1 := createRandomAccountWithBalance(t, 0)
+	account2 := createRandomAccount(t)
+
+	account1, err := store.UpdateAccountOverdraftLimit(context.Background(), UpdateAccountOverdraftLimitParams{
+		ID:             account1.ID,
+		OverdraftLimit: overdraftLimit,
+	})
+	require.NoError(t, err)
+
+	errs := make(chan error)
+
+	for range n {
+		go func() {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account1.ID,
+				ToAccountID:   account2.ID,
+				Amount:        amount,
+			})
+
+			errs <- err
+		}()
+	}
+
+	succeeded := 0
+	for range n {
+		err := <-errs
+		if err != nil {
+			require.ErrorIs(t, err, ErrInsufficientFunds)
+			continue
+		}
+		succeeded++
+	}
+
+	require.Equal(t, int(overdraftLimit/amount), succeeded)
+
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, -int64(succeeded)*amount, updatedAccount1.Balance)
+	require.GreaterOrEqual(t, updatedAccount1.Balance, -overdraftLimit)
+}
+
+func TestTransferTxNoOverdraw(t *testing.T) {
+	store := NewStore(testDB)
+
+	n := 20
+	amount := int64(30)
+	balance := int64(50)
+
+	account1 := createRandomAccountWithBalance(t, balance)
+	account2 := createRandomAccountWithBalance(t, balance)
+
+	errs := make(chan error)
+
+	// interleave transfers in both directions, each one larger than half the starting balance
+	for i := range n {
+		fromAccountID := account1.ID
+		toAccountID := account2.ID
+
+		if i%2 == 1 {
+			fromAccountID = account2.ID
+			toAccountID = account1.ID
+		}
+
+		go func() {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: fromAccountID,
+				ToAccountID:   toAccountID,
+				Amount:        amount,
+			})
+
+			errs <- err
+		}()
+	}
+
+	for range n {
+		err := <-errs
+		if err != nil {
+			require.ErrorIs(t, err, ErrInsufficientFunds)
+		}
+	}
+
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+
+	updatedAccount2, err := store.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+
+	// no interleaving may overdraw either account, and no money is created or lost
+	require.GreaterOrEqual(t, updatedAccount1.Balance, int64(0))
+	require.GreaterOrEqual(t, updatedAccount2.Balance, int64(0))
+	require.Equal(t, 2*balance, updatedAccount1.Balance+updatedAccount2.Balance)
+}
+
+func TestTransferTxIdempotent(t *testing.T) {
+	store := NewStore(testDB)
+
+	n := 5
+	amount := int64(10)
+
+	account1 := createRandomAccountWithBalance(t, int64(n)*amount)
+	account2 := createRandomAccount(t)
+
+	idempotency := &IdempotencyParams{
+		Username:    account1.Owner,
+		Key:         util.RandomString(32),
+		RequestHash: util.RandomString(64),
+	}
+
+	errs := make(chan error)
+	results := make(chan TransferTxResult)
+
+	// replay the same request concurrently, as a client retrying on timeouts would
+	for range n {
+		go func() {
+			result, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account1.ID,
+				ToAccountID:   account2.ID,
+				Amount:        amount,
+				Idempotency:   idempotency,
+			})
+
+			errs <- err
+			results <- result
+		}()
+	}
+
+	var transferID int64
+	for range n {
+		err := <-errs
+		require.NoError(t, err)
+
+		result := <-results
+		require.NotZero(t, result.Transfer.ID)
+		if transferID == 0 {
+			transferID = result.Transfer.ID
+		}
+		require.Equal(t, transferID, result.Transfer.ID)
+	}
+
+	// the money only moved once
+	updatedAccount1, err := store.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance-amount, updatedAccount1.Balance)
+
+	// reusing the key for a different request is rejected
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        amount * 2,
+		Idempotency: &IdempotencyParams{
+			Username:    idempotency.Username,
+			Key:         idempotency.Key,
+			RequestHash: util.RandomString(64),
+		},
+	})
+	require.ErrorIs(t, err, ErrIdempotencyKeyReused)
+}
+
+func TestTransferTxIdempotentFailure(t *testing.T) {
+	store := NewStore(testDB)
+
+	amount := int64(10)
+
+	account1 := createRandomAccountWithBalance(t, 0)
+	account2 := createRandomAccount(t)
+
+	arg := TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        amount,
+		Idempotency: &IdempotencyParams{
+			Username:    account1.Owner,
+			Key:         util.RandomString(32),
+			RequestHash: util.RandomString(64),
+		},
+	}
+
+	_, err := store.TransferTx(context.Background(), arg)
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	// a failed request does not burn the key, so the retry runs once funds arrive
+	_, err = store.DepositTx(context.Background(), DepositTxParams{
+		AccountID: account1.ID,
+		Amount:    amount,
+	})
+	require.NoError(t, err)
+
+	result, err := store.TransferTx(context.Background(), arg)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), result.FromAccount.Balance)
+}
+
+func TestTransferTxAccountNotActive(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	_, err := testQueries.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     account2.ID,
+		Status: util.AccountFrozen,
+	})
+	require.NoError(t, err)
+
+	// a frozen account can neither receive nor send money
+	for _, arg := range []TransferTxParams{
+		{FromAccountID: account1.ID, ToAccountID: account2.ID, Amount: 10},
+		{FromAccountID: account2.ID, ToAccountID: account1.ID, Amount: 10},
+	} {
+		_, err := store.TransferTx(context.Background(), arg)
+		var notActiveErr *AccountNotActiveError
+		require.ErrorAs(t, err, &notActiveErr)
+		require.Equal(t, account2.ID, notActiveErr.AccountID)
+		require.Equal(t, util.AccountFrozen, notActiveErr.Status)
+	}
+
+	updatedAccount1, err := testQueries.GetAccount(context.Background(), account1.ID)
+	require.NoError(t, err)
+	require.Equal(t, account1.Balance, updatedAccount1.Balance)
+}
+
+func TestTransferTxNewPayeeLimit(t *testing.T) {
+	store := NewStore(testDB)
+
+	n := 5
+	amount := int64(10)
+	limit := NewPayeeLimit{Amount: 30, Period: 24 * time.Hour}
+
+	account1 := createRandomAccountWithBalance(t, 100)
+	account2 := createRandomAccountInCurrency(t, account1.Currency, 0)
+
+	errs := make(chan error)
+
+	// concurrent transfers to the account count against the limit one after the other
+	for range n {
+		go func() {
+			_, err := store.TransferTx(context.Background(), TransferTxParams{
+				FromAccountID: account1.ID,
+				ToAccountID:   account2.ID,
+				Amount:        amount,
+				NewPayeeLimit: limit,
+			})
+
+			errs <- err
+		}()
+	}
+
+	rejected := 0
+	for range n {
+		err := <-errs
+		if err != nil {
+			require.ErrorIs(t, err, ErrNewPayeeLimit)
+			rejected++
+		}
+	}
+	require.Equal(t, n-int(limit.Amount/amount), rejected)
+
+	updatedAccount2, err := testQueries.GetAccount(context.Background(), account2.ID)
+	require.NoError(t, err)
+	require.Equal(t, limit.Amount, updatedAccount2.Balance)
+}
+
+func TestTransferBatchTx(t *testing.T) {
+	store := NewStore(testDB)
+
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 100)
+	toAccount1 := createRandomAccountInCurrency(t, util.USD, 0)
+	toAccount2 := createRandomAccountInCurrency(t, util.USD, 0)
+
+	result, err := store.TransferBatchTx(context.Background(), TransferBatchTxParams{
+		FromAccountID: fromAccount.ID,
+		Legs: []TransferBatchLeg{
+			{ToAccountID: toAccount1.ID, Amount: 30},
+			{ToAccountID: toAccount2.ID, Amount: 20},
+			{ToAccountID: toAccount1.ID, Amount: 10},
+		},
+		Atomic: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(40), result.FromAccount.Balance)
+	require.Len(t, result.Legs, 3)
+
+	for i, leg := range []TransferBatchLeg{{toAccount1.ID, 30}, {toAccount2.ID, 20}, {toAccount1.ID, 10}} {
+		require.Empty(t, result.Legs[i].Error)
+		require.NotZero(t, result.Legs[i].Transfer.ID)
+		require.Equal(t, fromAccount.ID, result.Legs[i].Transfer.FromAccountID)
+		require.Equal(t, leg.ToAccountID, result.Legs[i].Transfer.ToAccountID)
+		require.Equal(t, leg.Amount, result.Legs[i].Transfer.Amount)
+	}
+
+	updatedAccount1, err := testQueries.GetAccount(context.Background(), toAccount1.ID)
+	require.NoError(t, err)
+	require.Equal(t, int64(40), updatedAccount1.Balance)
+}
+
+func TestTransferBatchTxAtomicFailure(t *testing.T) {
+	store := NewStore(testDB)
+
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 100)
+	toAccount1 := createRandomAccountInCurrency(t, util.USD, 0)
+	toAccount2 := createRandomAccountInCurrency(t, util.USD, 0)
+
+	// the total is checked before any leg is made
+	_, err := store.TransferBatchTx(context.Background(), TransferBatchTxParams{
+		FromAccountID: fromAccount.ID,
+		Legs: []TransferBatchLeg{
+			{ToAccountID: toAccount1.ID, Amount: 60},
+			{ToAccountID: toAccount2.ID, Amount: 60},
+		},
+		Atomic: true,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	_, err = testQueries.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     toAccount2.ID,
+		Status: util.AccountFrozen,
+	})
+	require.NoError(t, err)
+
+	// a failing leg undoes the legs before it
+	_, err = store.TransferBatchTx(context.Background(), TransferBatchTxParams{
+		FromAccountID: fromAccount.ID,
+		Legs: []TransferBatchLeg{
+			{ToAccountID: toAccount1.ID, Amount: 10},
+			{ToAccountID: toAccount2.ID, Amount: 10},
+		},
+		Atomic: true,
+	})
+	var legErr *TransferBatchLegError
+	require.ErrorAs(t, err, &legErr)
+	require.Equal(t, 1, legErr.Index)
+	var notActiveErr *AccountNotActiveError
+	require.ErrorAs(t, err, &notActiveErr)
+
+	for _, account := range []Account{fromAccount, toAccount1, toAccount2} {
+		updatedAccount, err := testQueries.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, updatedAccount.Balance)
+	}
+}
+
+func TestTransferBatchTxBestEffort(t *testing.T) {
+	store := NewStore(testDB)
+
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 100)
+	toAccount1 := createRandomAccountInCurrency(t, util.USD, 0)
+	toAccount2 := createRandomAccountInCurrency(t, util.USD, 0)
+
+	_, err := testQueries.UpdateAccountStatus(context.Background(), UpdateAccountStatusParams{
+		ID:     toAccount2.ID,
+		Status: util.AccountFrozen,
+	})
+	require.NoError(t, err)
+
+	result, err := store.TransferBatchTx(context.Background(), TransferBatchTxParams{
+		FromAccountID: fromAccount.ID,
+		Legs: []TransferBatchLeg{
+			{ToAccountID: toAccount1.ID, Amount: 60},
+			{ToAccountID: toAccount2.ID, Amount: 10},
+			{ToAccountID: toAccount1.ID, Amount: 50},
+			{ToAccountID: toAccount1.ID, Amount: 40},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Legs, 4)
+
+	require.Empty(t, result.Legs[0].Error)
+	require.NotZero(t, result.Legs[0].Transfer.ID)
+	require.Contains(t, result.Legs[1].Error, util.AccountFrozen)
+	require.Zero(t, result.Legs[1].Transfer.ID)
+	require.Contains(t, result.Legs[2].Error, ErrInsufficientFunds.Error())
+	require.Zero(t, result.Legs[2].Transfer.ID)
+	require.Empty(t, result.Legs[3].Error)
+	require.NotZero(t, result.Legs[3].Transfer.ID)
+
+	require.Equal(t, int64(0), result.FromAccount.Balance)
+}
+
+func TestTransferBatchTxRiskDecision(t *testing.T) {
+	store := NewStore(testDB)
+
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 100)
+	toAccount := createRandomAccountInCurrency(t, util.USD, 0)
+	since := time.Now().Add(-time.Minute)
+
+	legs := make([]TransferBatchLeg, 5)
+	for i := range legs {
+		legs[i] = TransferBatchLeg{ToAccountID: toAccount.ID, Amount: 30}
+	}
+
+	// one decision covers the whole batch however many of its legs are made
+	_, err := store.TransferBatchTx(context.Background(), TransferBatchTxParams{
+		FromAccountID: fromAccount.ID,
+		Legs:          legs,
+		RiskDecision: &CreateRiskDecisionParams{
+			Username:  fromAccount.Owner,
+			Operation: util.RiskTransfer,
+			AccountID: fromAccount.ID,
+			Amount:    150,
+			Currency:  util.USD,
+			Action:    util.RiskAllow,
+			Rules:     []string{},
+			Request:   []byte("{}"),
+		},
+	})
+	require.NoError(t, err)
+
+	count, err := testQueries.CountRiskDecisionsSince(context.Background(), CountRiskDecisionsSinceParams{
+		Username: fromAccount.Owner,
+		Since:    since,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), count)
+}
+
+func TestTransferBatchTxDeadlock(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountInCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountInCurrency(t, util.USD, 1000)
+	account3 := createRandomAccountInCurrency(t, util.USD, 1000)
+	accounts := []Account{account1, account2, account3}
+
+	n := 9
+	errs := make(chan error)
+
+	// every batch pays the two other accounts, listed in opposite orders
+	for i := 0; i < n; i++ {
+		from := accounts[i%3]
+		to1 := accounts[(i+1)%3]
+		to2 := accounts[(i+2)%3]
+		if i%2 == 1 {
+			to1, to2 = to2, to1
+		}
+
+		go func() {
+			_, err := store.TransferBatchTx(context.Background(), TransferBatchTxParams{
+				FromAccountID: from.ID,
+				Legs: []TransferBatchLeg{
+					{ToAccountID: to1.ID, Amount: 10},
+					{ToAccountID: to2.ID, Amount: 10},
+				},
+				Atomic: true,
+			})
+			errs <- err
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		require.NoError(t, <-errs)
+	}
+
+	// each account paid and received the same amount
+	for _, account := range accounts {
+		updatedAccount, err := testQueries.GetAccount(context.Background(), account.ID)
+		require.NoError(t, err)
+		require.Equal(t, account.Balance, updatedAccount.Balance)
+	}
+}
@@ -7,26 +7,88 @@ package db
 
 import (
 	"context"
+	"database/sql"
+	"time"
+
+	"github.com/google/uuid"
 )
 
+const addTransferRefundedAmount = `-- name: AddTransferRefundedAmount :one
+UPDATE transfers
+SET refunded_amount = refunded_amount + $1
+WHERE id = $2
+RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_quote_id, reversed_transfer_id, refunded_amount, memo, reference, category
+`
+
+type AddTransferRefundedAmountParams struct {
+	Amount int64 `json:"amount"`
+	ID     int64 `json:"id"`
+}
+
+func (q *Queries) AddTransferRefundedAmount(ctx context.Context, arg AddTransferRefundedAmountParams) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, addTransferRefundedAmount, arg.Amount, arg.ID)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxQuoteID,
+		&i.ReversedTransferID,
+		&i.RefundedAmount,
+		&i.Memo,
+		&i.Reference,
+		&i.Category,
+	)
+	return i, err
+}
+
 const createTransfer = `-- name: CreateTransfer :one
 INSERT INTO transfers (
   from_account_id,
   to_account_id,
-  amount
+  amount,
+  to_amount,
+  fx_rate,
+  fx_quote_id,
+  reversed_transfer_id,
+  memo,
+  reference,
+  category
 ) VALUES (
-  $1, $2, $3
-) RETURNING id, from_account_id, to_account_id, amount, created_at
+  $1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+) RETURNING id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_quote_id, reversed_transfer_id, refunded_amount, memo, reference, category
 `
 
 type CreateTransferParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Amount        int64 `json:"amount"`
+	FromAccountID      int64         `json:"from_account_id"`
+	ToAccountID        int64         `json:"to_account_id"`
+	Amount             int64         `json:"amount"`
+	ToAmount           int64         `json:"to_amount"`
+	FxRate             sql.NullInt64 `json:"fx_rate"`
+	FxQuoteID          uuid.NullUUID `json:"fx_quote_id"`
+	ReversedTransferID sql.NullInt64 `json:"reversed_transfer_id"`
+	Memo               string        `json:"memo"`
+	Reference          string        `json:"reference"`
+	Category           string        `json:"category"`
 }
 
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (Transfer, error) {
-	row := q.db.QueryRowContext(ctx, createTransfer, arg.FromAccountID, arg.ToAccountID, arg.Amount)
+	row := q.db.QueryRowContext(ctx, createTransfer,
+		arg.FromAccountID,
+		arg.ToAccountID,
+		arg.Amount,
+		arg.ToAmount,
+		arg.FxRate,
+		arg.FxQuoteID,
+		arg.ReversedTransferID,
+		arg.Memo,
+		arg.Reference,
+		arg.Category,
+	)
 	var i Transfer
 	err := row.Scan(
 		&i.ID,
@@ -34,12 +96,47 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxQuoteID,
+		&i.ReversedTransferID,
+		&i.RefundedAmount,
+		&i.Memo,
+		&i.Reference,
+		&i.Category,
 	)
 	return i, err
 }
 
+const getPaymentsToAccount = `-- name: GetPaymentsToAccount :one
+SELECT
+  COALESCE(min(transfers.created_at), now())::timestamptz AS first_paid_at,
+  COALESCE(sum(transfers.amount), 0)::bigint AS total
+FROM transfers
+JOIN accounts ON accounts.id = transfers.from_account_id
+WHERE accounts.owner = $1
+  AND transfers.to_account_id = $2
+`
+
+type GetPaymentsToAccountParams struct {
+	Owner       string `json:"owner"`
+	ToAccountID int64  `json:"to_account_id"`
+}
+
+type GetPaymentsToAccountRow struct {
+	FirstPaidAt time.Time `json:"first_paid_at"`
+	Total       int64     `json:"total"`
+}
+
+func (q *Queries) GetPaymentsToAccount(ctx context.Context, arg GetPaymentsToAccountParams) (GetPaymentsToAccountRow, error) {
+	row := q.db.QueryRowContext(ctx, getPaymentsToAccount, arg.Owner, arg.ToAccountID)
+	var i GetPaymentsToAccountRow
+	err := row.Scan(&i.FirstPaidAt, &i.Total)
+	return i, err
+}
+
 const getTransfer = `-- name: GetTransfer :one
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_quote_id, reversed_transfer_id, refunded_amount, memo, reference, category FROM transfers
 WHERE id = $1 LIMIT 1
 `
 
@@ -52,33 +149,85 @@ func (q *Queries) GetTransfer(ctx context.Context, id int64) (Transfer, error) {
 		&i.ToAccountID,
 		&i.Amount,
 		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxQuoteID,
+		&i.ReversedTransferID,
+		&i.RefundedAmount,
+		&i.Memo,
+		&i.Reference,
+		&i.Category,
+	)
+	return i, err
+}
+
+const getTransferForUpdate = `-- name: GetTransferForUpdate :one
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_quote_id, reversed_transfer_id, refunded_amount, memo, reference, category FROM transfers
+WHERE id = $1 LIMIT 1
+FOR NO KEY UPDATE
+`
+
+func (q *Queries) GetTransferForUpdate(ctx context.Context, id int64) (Transfer, error) {
+	row := q.db.QueryRowContext(ctx, getTransferForUpdate, id)
+	var i Transfer
+	err := row.Scan(
+		&i.ID,
+		&i.FromAccountID,
+		&i.ToAccountID,
+		&i.Amount,
+		&i.CreatedAt,
+		&i.ToAmount,
+		&i.FxRate,
+		&i.FxQuoteID,
+		&i.ReversedTransferID,
+		&i.RefundedAmount,
+		&i.Memo,
+		&i.Reference,
+		&i.Category,
 	)
 	return i, err
 }
 
 const listTransfers = `-- name: ListTransfers :many
-SELECT id, from_account_id, to_account_id, amount, created_at FROM transfers
-WHERE 
-    from_account_id = $1 OR
-    to_account_id = $2
-ORDER BY id
-LIMIT $3
-OFFSET $4
+SELECT id, from_account_id, to_account_id, amount, created_at, to_amount, fx_rate, fx_quote_id, reversed_transfer_id, refunded_amount, memo, reference, category FROM transfers
+WHERE (from_account_id = $1 OR to_account_id = $1)
+  AND ($2::bigint IS NULL OR id < $2)
+  AND ($3::timestamptz IS NULL OR created_at >= $3)
+  AND ($4::timestamptz IS NULL OR created_at < $4)
+  AND ($5::bigint IS NULL OR abs(amount) >= $5)
+  AND ($6::bigint IS NULL OR abs(amount) <= $6)
+  AND ($7::text IS NULL OR to_tsvector('simple', memo) @@ websearch_to_tsquery('simple', $7))
+  AND ($8::varchar IS NULL OR reference = $8)
+  AND ($9::varchar IS NULL OR category = $9)
+ORDER BY id DESC
+LIMIT $10
 `
 
 type ListTransfersParams struct {
-	FromAccountID int64 `json:"from_account_id"`
-	ToAccountID   int64 `json:"to_account_id"`
-	Limit         int32 `json:"limit"`
-	Offset        int32 `json:"offset"`
+	AccountID int64          `json:"account_id"`
+	BeforeID  sql.NullInt64  `json:"before_id"`
+	FromTime  sql.NullTime   `json:"from_time"`
+	ToTime    sql.NullTime   `json:"to_time"`
+	MinAmount sql.NullInt64  `json:"min_amount"`
+	MaxAmount sql.NullInt64  `json:"max_amount"`
+	Query     sql.NullString `json:"query"`
+	Reference sql.NullString `json:"reference"`
+	Category  sql.NullString `json:"category"`
+	PageSize  int32          `json:"page_size"`
 }
 
 func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([]Transfer, error) {
 	rows, err := q.db.QueryContext(ctx, listTransfers,
-		arg.FromAccountID,
-		arg.ToAccountID,
-		arg.Limit,
-		arg.Offset,
+		arg.AccountID,
+		arg.BeforeID,
+		arg.FromTime,
+		arg.ToTime,
+		arg.MinAmount,
+		arg.MaxAmount,
+		arg.Query,
+		arg.Reference,
+		arg.Category,
+		arg.PageSize,
 	)
 	if err != nil {
 		return nil, err
@@ -93,6 +242,14 @@ func (q *Queries) ListTransfers(ctx context.Context, arg ListTransfersParams) ([
 			&i.ToAccountID,
 			&i.Amount,
 			&i.CreatedAt,
+			&i.ToAmount,
+			&i.FxRate,
+			&i.FxQuoteID,
+			&i.ReversedTransferID,
+			&i.RefundedAmount,
+			&i.Memo,
+			&i.Reference,
+			&i.Category,
 		); err != nil {
 			return nil, err
 		}
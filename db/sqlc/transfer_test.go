@@ -0,0 +1,345 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func createRandomTransfer(t *testing.T, fromAccount, toAccount Account, amount int64) Transfer {
+	arg := CreateTransferParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        amount,
+		ToAmount:      amount,
+	}
+
+	transfer, err := testQueries.CreateTransfer(context.Background(), arg)
+	require.NoError(t, err)
+	require.NotEmpty(t, transfer)
+
+	require.Equal(t, arg.FromAccountID, transfer.FromAccountID)
+	require.Equal(t, arg.ToAccountID, transfer.ToAccountID)
+	require.Equal(t, arg.Amount, transfer.Amount)
+	require.Equal(t, arg.ToAmount, transfer.ToAmount)
+	require.False(t, transfer.FxRate.Valid)
+	require.False(t, transfer.FxQuoteID.Valid)
+
+	require.NotZero(t, transfer.ID)
+	require.NotZero(t, transfer.CreatedAt)
+
+	return transfer
+}
+
+func TestListTransfers(t *testing.T) {
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	// transfers in both directions count towards account1
+	var transfers []Transfer
+	for i := 1; i <= 5; i++ {
+		if i%2 == 0 {
+			transfers = append(transfers, createRandomTransfer(t, account1, account2, int64(i*10)))
+		} else {
+			transfers = append(transfers, createRandomTransfer(t, account2, account1, int64(i*10)))
+		}
+	}
+
+	arg := ListTransfersParams{
+		AccountID: account1.ID,
+		PageSize:  10,
+	}
+
+	gotTransfers, err := testQueries.ListTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, gotTransfers, len(transfers))
+
+	// newest first
+	for i, transfer := range gotTransfers {
+		require.Equal(t, transfers[len(transfers)-1-i].ID, transfer.ID)
+	}
+
+	// keyset: continue after the second newest transfer
+	arg.BeforeID = sql.NullInt64{Int64: transfers[3].ID, Valid: true}
+	gotTransfers, err = testQueries.ListTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, gotTransfers, 3)
+	require.Equal(t, transfers[2].ID, gotTransfers[0].ID)
+
+	// amount filter
+	arg = ListTransfersParams{
+		AccountID: account1.ID,
+		MinAmount: sql.NullInt64{Int64: 20, Valid: true},
+		MaxAmount: sql.NullInt64{Int64: 40, Valid: true},
+		PageSize:  10,
+	}
+	gotTransfers, err = testQueries.ListTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.Len(t, gotTransfers, 3)
+
+	// date filter
+	arg = ListTransfersParams{
+		AccountID: account1.ID,
+		FromTime:  sql.NullTime{Time: time.Now().Add(time.Hour), Valid: true},
+		PageSize:  10,
+	}
+	gotTransfers, err = testQueries.ListTransfers(context.Background(), arg)
+	require.NoError(t, err)
+	require.Empty(t, gotTransfers)
+}
+
+func TestListTransfersPage(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccount(t)
+	account2 := createRandomAccount(t)
+
+	var transfers []Transfer
+	for i := 0; i < 5; i++ {
+		transfers = append(transfers, createRandomTransfer(t, account1, account2, 10))
+	}
+
+	arg := ListTransfersPageParams{
+		AccountID: account2.ID,
+		Filter:    HistoryFilter{MinAmount: 10},
+		PageSize:  2,
+	}
+
+	var gotTransfers []Transfer
+	for {
+		result, err := store.ListTransfersPage(context.Background(), arg)
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(result.Transfers), 2)
+
+		gotTransfers = append(gotTransfers, result.Transfers...)
+		if result.NextPageToken == "" {
+			break
+		}
+		arg.PageToken = result.NextPageToken
+	}
+	require.ElementsMatch(t, transfers, gotTransfers)
+
+	// the token is bound to the account and filter it was issued for
+	result, err := store.ListTransfersPage(context.Background(), ListTransfersPageParams{
+		AccountID: account2.ID,
+		PageSize:  2,
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, result.NextPageToken)
+
+	_, err = store.ListTransfersPage(context.Background(), ListTransfersPageParams{
+		AccountID: account1.ID,
+		PageSize:  2,
+		PageToken: result.NextPageToken,
+	})
+	require.ErrorIs(t, err, ErrInvalidPageToken)
+}
+
+func TestListTransfersPageAnnotation(t *testing.T) {
+	store := NewStore(testDB)
+
+	account1 := createRandomAccountInCurrency(t, util.USD, 1000)
+	account2 := createRandomAccountInCurrency(t, util.USD, 0)
+
+	rent, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        100,
+		Annotation: Annotation{
+			Memo:      "Rent for the flat on Main Street",
+			Reference: "INV-2024-001",
+			Category:  "housing",
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "Rent for the flat on Main Street", rent.Transfer.Memo)
+	require.Equal(t, "INV-2024-001", rent.Transfer.Reference)
+	require.Equal(t, "housing", rent.Transfer.Category)
+	require.Equal(t, "housing", rent.FromEntry.Category)
+	require.Equal(t, "INV-2024-001", rent.ToEntry.Reference)
+	require.Empty(t, rent.ToEntry.Category)
+
+	_, err = store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: account1.ID,
+		ToAccountID:   account2.ID,
+		Amount:        10,
+		Annotation:    Annotation{Memo: "Coffee", Category: "food"},
+	})
+	require.NoError(t, err)
+
+	for _, filter := range []HistoryFilter{
+		{Query: "rent flat"},
+		{Reference: "INV-2024-001"},
+		{Category: "housing"},
+	} {
+		result, err := store.ListTransfersPage(context.Background(), ListTransfersPageParams{
+			AccountID: account1.ID,
+			Filter:    filter,
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Transfers, 1)
+		require.Equal(t, rent.Transfer.ID, result.Transfers[0].ID)
+	}
+
+	entries, err := store.ListEntriesPage(context.Background(), ListEntriesPageParams{
+		AccountID: account2.ID,
+		Filter:    HistoryFilter{Query: "main street"},
+	})
+	require.NoError(t, err)
+	require.Len(t, entries.Entries, 1)
+	require.Equal(t, rent.ToEntry.ID, entries.Entries[0].ID)
+
+	// the recipient doesn't see the payer's category
+	entries, err = store.ListEntriesPage(context.Background(), ListEntriesPageParams{
+		AccountID: account2.ID,
+		Filter:    HistoryFilter{Category: "housing"},
+	})
+	require.NoError(t, err)
+	require.Empty(t, entries.Entries)
+}
+
+func TestReverseTransferTx(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 100)
+	toAccount := createRandomAccountWithBalance(t, 0)
+
+	transferResult, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        60,
+	})
+	require.NoError(t, err)
+	original := transferResult.Transfer
+
+	result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.ID,
+		Amount:     25,
+		Kind:       JournalRefund,
+	})
+	require.NoError(t, err)
+
+	refund := result.Transfer
+	require.Equal(t, toAccount.ID, refund.FromAccountID)
+	require.Equal(t, fromAccount.ID, refund.ToAccountID)
+	require.Equal(t, int64(25), refund.Amount)
+	require.Equal(t, int64(25), refund.ToAmount)
+	require.True(t, refund.ReversedTransferID.Valid)
+	require.Equal(t, original.ID, refund.ReversedTransferID.Int64)
+
+	require.Equal(t, int64(25), result.OriginalTransfer.RefundedAmount)
+	require.Equal(t, int64(35), result.FromAccount.Balance)
+	require.Equal(t, int64(65), result.ToAccount.Balance)
+	requireJournal(t, result.Journal, JournalRefund,
+		map[int64]int64{toAccount.ID: -25, fromAccount.ID: 25}, nil)
+
+	// the running total can't go past the original amount
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.ID,
+		Amount:     36,
+		Kind:       JournalRefund,
+	})
+	require.ErrorIs(t, err, ErrReversalExceedsTransfer)
+
+	// a refund can't be refunded in turn
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: refund.ID,
+		Kind:       JournalRefund,
+	})
+	require.ErrorIs(t, err, ErrTransferIsReversal)
+
+	// no amount reverses what is left
+	result, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.ID,
+		Kind:       JournalReversal,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(35), result.Transfer.Amount)
+	require.Equal(t, int64(60), result.OriginalTransfer.RefundedAmount)
+	require.Equal(t, int64(0), result.FromAccount.Balance)
+	require.Equal(t, int64(100), result.ToAccount.Balance)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: original.ID,
+		Kind:       JournalReversal,
+	})
+	require.ErrorIs(t, err, ErrTransferFullyRefunded)
+}
+
+func TestReverseTransferTxInsufficientFunds(t *testing.T) {
+	store := NewStore(testDB)
+	fromAccount := createRandomAccountWithBalance(t, 100)
+	toAccount := createRandomAccountWithBalance(t, 0)
+
+	transferResult, err := store.TransferTx(context.Background(), TransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        60,
+	})
+	require.NoError(t, err)
+
+	// the recipient already spent part of the money
+	_, err = store.WithdrawTx(context.Background(), WithdrawTxParams{
+		AccountID: toAccount.ID,
+		Amount:    50,
+	})
+	require.NoError(t, err)
+
+	_, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferResult.Transfer.ID,
+		Kind:       JournalReversal,
+	})
+	require.ErrorIs(t, err, ErrInsufficientFunds)
+
+	original, err := testQueries.GetTransfer(context.Background(), transferResult.Transfer.ID)
+	require.NoError(t, err)
+	require.Zero(t, original.RefundedAmount)
+}
+
+func TestReverseTransferTxFx(t *testing.T) {
+	store := NewStore(testDB)
+
+	fromAccount := createRandomAccountInCurrency(t, util.USD, 1000)
+	toAccount := createRandomAccountInCurrency(t, util.EUR, 0)
+	quote := createRandomFxQuote(t, fromAccount.Owner, 91_734_000, time.Minute)
+
+	transferResult, err := store.FxTransferTx(context.Background(), FxTransferTxParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        999,
+		QuoteID:       quote.ID,
+		Username:      fromAccount.Owner,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(916), transferResult.Transfer.ToAmount)
+
+	// 300 of the 916 EUR is 327.17 of the 999 USD, rounded down
+	result, err := store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferResult.Transfer.ID,
+		Amount:     300,
+		Kind:       JournalRefund,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(300), result.Transfer.Amount)
+	require.Equal(t, int64(327), result.Transfer.ToAmount)
+	requireJournal(t, result.Journal, JournalRefund,
+		map[int64]int64{toAccount.ID: -300, fromAccount.ID: 327},
+		map[string]int64{
+			systemKey(util.SystemFXPosition, util.EUR): 300,
+			systemKey(util.SystemFXPosition, util.USD): -327,
+		})
+
+	// the rest brings the source account back to exactly what it sent
+	result, err = store.ReverseTransferTx(context.Background(), ReverseTransferTxParams{
+		TransferID: transferResult.Transfer.ID,
+		Kind:       JournalRefund,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int64(616), result.Transfer.Amount)
+	require.Equal(t, int64(672), result.Transfer.ToAmount)
+	require.Equal(t, int64(1000), result.ToAccount.Balance)
+	require.Equal(t, int64(0), result.FromAccount.Balance)
+}
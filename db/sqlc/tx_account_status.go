@@ -0,0 +1,118 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// ChangeAccountStatusTxParams contains the input parameters of the change account status transaction
+type ChangeAccountStatusTxParams struct {
+	AccountID int64  `json:"account_id"`
+	Status    string `json:"status"`
+}
+
+// ChangeAccountStatusTxResult is the result of the change account status transaction
+type ChangeAccountStatusTxResult struct {
+	Account Account `json:"account"`
+}
+
+// ChangeAccountStatusTx moves an account to a new status within a database transaction.
+// See ChangeAccountStatus for the allowed transitions.
+func (store *SQLStore) ChangeAccountStatusTx(ctx context.Context, arg ChangeAccountStatusTxParams) (ChangeAccountStatusTxResult, error) {
+	var result ChangeAccountStatusTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+		result.Account, err = ChangeAccountStatus(ctx, q, arg)
+		return err
+	})
+
+	return result, err
+}
+
+// ChangeAccountStatus moves an account to a new status using q, which must already run
+// inside a transaction. Active and frozen accounts can switch between each other, and an
+// active account can be closed once its balance is zero, all the interest it accrued has
+// been capitalized and nothing is still due to move money in or out of it. Closing cancels
+// the scheduled transfers other accounts have into it. A closed account only comes back
+// through ReopenAccount: it returns an AccountNotActiveError for a closed account,
+// ErrAccountNotEmpty when closing an account that still holds money, ErrInterestPending
+// when closing one still owed interest, and ErrAccountInUse when closing one with active
+// holds, scheduled transfers out of it or pending payment requests into it. Moving an
+// account to the status it already has is a no-op.
+func ChangeAccountStatus(ctx context.Context, q Querier, arg ChangeAccountStatusTxParams) (Account, error) {
+	account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+	if err != nil {
+		return account, err
+	}
+
+	if account.Status == arg.Status {
+		return account, nil
+	}
+
+	switch arg.Status {
+	case util.AccountActive, util.AccountFrozen:
+		if account.Status == util.AccountClosed {
+			return account, &AccountNotActiveError{AccountID: account.ID, Status: account.Status}
+		}
+	case util.AccountClosed:
+		if err := checkAccountActive(account); err != nil {
+			return account, err
+		}
+		if account.Balance != 0 {
+			return account, fmt.Errorf("%w: account %d has balance %d", ErrAccountNotEmpty, account.ID, account.Balance)
+		}
+
+		// capitalizing skips closed accounts, the interest would never be credited
+		pending, err := q.GetPendingInterest(ctx, account.ID)
+		if err != nil {
+			return account, err
+		}
+		if pending != 0 {
+			return account, fmt.Errorf("%w: account %d is owed %d, it is credited at the start of next month",
+				ErrInterestPending, account.ID, pending)
+		}
+
+		commitments, err := q.GetAccountCommitments(ctx, account.ID)
+		if err != nil {
+			return account, err
+		}
+		if commitments.ActiveHolds != 0 || commitments.ScheduledTransfers != 0 || commitments.PendingPaymentRequests != 0 {
+			return account, fmt.Errorf("%w: account %d has %d active holds, %d scheduled transfers and %d pending payment requests",
+				ErrAccountInUse, account.ID, commitments.ActiveHolds, commitments.ScheduledTransfers, commitments.PendingPaymentRequests)
+		}
+
+		// the owner can't cancel schedules other users set up into the account, they would only fail from now on
+		if _, err := q.CancelScheduledTransfersToAccount(ctx, account.ID); err != nil {
+			return account, err
+		}
+	default:
+		return account, fmt.Errorf("unsupported account status: %s", arg.Status)
+	}
+
+	return q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+		ID:     arg.AccountID,
+		Status: arg.Status,
+	})
+}
+
+// ReopenAccount makes a closed account active again using q, which must already run inside
+// a transaction. It returns ErrAccountNotClosed for an account that isn't closed. Scheduled
+// transfers cancelled when the account was closed stay cancelled.
+func ReopenAccount(ctx context.Context, q Querier, accountID int64) (Account, error) {
+	account, err := q.GetAccountForUpdate(ctx, accountID)
+	if err != nil {
+		return account, err
+	}
+
+	if account.Status != util.AccountClosed {
+		return account, fmt.Errorf("%w: account %d is %s", ErrAccountNotClosed, account.ID, account.Status)
+	}
+
+	return q.UpdateAccountStatus(ctx, UpdateAccountStatusParams{
+		ID:     accountID,
+		Status: util.AccountActive,
+	})
+}
@@ -0,0 +1,57 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// AuditTxParams contains the input parameters of the audit transaction.
+// The outcome and error of the audit log are set by AuditTx.
+type AuditTxParams struct {
+	CreateAuditLogParams
+	// Run performs the audited operation within the transaction
+	Run func(q Querier) error
+}
+
+// AuditTxResult is the result of the audit transaction
+type AuditTxResult struct {
+	AuditLog AuditLog
+}
+
+// AuditTx runs a support or admin action and records it in the audit log,
+// so an action never takes effect without leaving a trace. When the action fails
+// its changes are rolled back and the attempt is recorded as failed on its own,
+// then the error of the action is returned.
+func (store *SQLStore) AuditTx(ctx context.Context, arg AuditTxParams) (AuditTxResult, error) {
+	var result AuditTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		err := arg.Run(q)
+		if err != nil {
+			return err
+		}
+
+		auditLog := arg.CreateAuditLogParams
+		auditLog.Outcome = util.AuditSucceeded
+		auditLog.Error = ""
+		result.AuditLog, err = q.CreateAuditLog(ctx, auditLog)
+		return err
+	})
+	if err == nil {
+		return result, nil
+	}
+
+	auditLog := arg.CreateAuditLogParams
+	auditLog.Outcome = util.AuditFailed
+	auditLog.Error = err.Error()
+	var logErr error
+	result.AuditLog, logErr = store.CreateAuditLog(ctx, auditLog)
+	if logErr != nil {
+		return result, errors.Join(err, fmt.Errorf("failed to record audit log: %w", logErr))
+	}
+
+	return result, err
+}
@@ -3,48 +3,100 @@ package db
 import (
 	"context"
 	"fmt"
+
+	"github.com/JaidenShall/simplebank/util"
 )
 
 type DepositTxParams struct {
 	AccountID int64 `json:"account_id"`
 	Amount    int64 `json:"amount"`
+	Annotation
+	// RiskDecision is the decision of the risk rules allowing the movement, recorded along with it.
+	// It can be nil.
+	RiskDecision *CreateRiskDecisionParams `json:"-"`
+	Idempotency  *IdempotencyParams        `json:"-"`
 }
 
 type DepositTxResult struct {
 	Account Account `json:"account"`
 	Entry   Entry   `json:"entry"`
+	Journal Journal `json:"journal"`
 }
 
 // DepositTx performs a money deposit to an account.
-// It creates an entry and updates the account balance within a database transaction
+// It creates an entry, posts a journal from the cash vault to the account and updates
+// the account balance within a database transaction.
+// It returns an AccountNotActiveError if the account is frozen or closed.
+// arg.RiskDecision is only recorded when the deposit is made.
+// If arg.Idempotency is set, a replay of the same key returns the original result instead.
 func (store *SQLStore) DepositTx(ctx context.Context, arg DepositTxParams) (DepositTxResult, error) {
 	var result DepositTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
-		var err error
-
-		// Validate amount
-		if arg.Amount <= 0 {
-			return fmt.Errorf("deposit amount must be positive")
-		}
-
-		// Create deposit entry
-		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams(arg))
-		if err != nil {
-			return err
-		}
-
-		// Add money to account
-		result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
-			ID:     arg.AccountID,
-			Amount: arg.Amount,
+		return execIdempotent(ctx, q, arg.Idempotency, &result, func() error {
+			err := deposit(ctx, q, arg, &result)
+			if err != nil {
+				return err
+			}
+			return recordRiskDecision(ctx, q, arg.RiskDecision, 0, result.Entry.ID)
 		})
-		if err != nil {
-			return err
-		}
-
-		return nil
 	})
 
 	return result, err
 }
+
+// deposit makes the deposit using q, which must already run inside a transaction
+func deposit(ctx context.Context, q Querier, arg DepositTxParams, result *DepositTxResult) error {
+	var err error
+
+	// Validate amount
+	if arg.Amount <= 0 {
+		return fmt.Errorf("deposit amount must be positive")
+	}
+
+	// Only active accounts can be credited
+	account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+	if err != nil {
+		return err
+	}
+
+	err = checkAccountActive(account)
+	if err != nil {
+		return err
+	}
+
+	// Create deposit entry
+	result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.AccountID,
+		Amount:    arg.Amount,
+		Memo:      arg.Memo,
+		Reference: arg.Reference,
+		Category:  arg.Category,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The money comes out of the cash vault
+	result.Journal, err = postJournal(ctx, q, JournalParams{
+		Kind: JournalDeposit,
+		Postings: []PostingParams{
+			{AccountID: account.ID, Currency: account.Currency, Amount: arg.Amount},
+			{SystemAccount: util.SystemCashVault, Currency: account.Currency, Amount: -arg.Amount},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Add money to account
+	result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+		ID:     arg.AccountID,
+		Amount: arg.Amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/currency"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/google/uuid"
+)
+
+// Errors returned by FxTransferTx when the quote cannot be applied
+var (
+	ErrFxQuoteNotFound  = errors.New("fx quote not found")
+	ErrFxQuoteExpired   = errors.New("fx quote has expired")
+	ErrFxQuoteUsed      = errors.New("fx quote has already been used")
+	ErrFxQuoteMismatch  = errors.New("fx quote does not match the account currencies")
+	ErrFxAmountTooSmall = errors.New("converted amount rounds down to zero")
+)
+
+// FxTransferTxParams contains the input parameters of the fx transfer transaction.
+// Amount is in the currency of the source account.
+type FxTransferTxParams struct {
+	FromAccountID int64     `json:"from_account_id"`
+	ToAccountID   int64     `json:"to_account_id"`
+	Amount        int64     `json:"amount"`
+	QuoteID       uuid.UUID `json:"quote_id"`
+	Username      string    `json:"username"`
+	Annotation
+	NewPayeeLimit NewPayeeLimit `json:"new_payee_limit"`
+	// RiskDecision is the decision of the risk rules allowing the movement, recorded along with it.
+	// It can be nil.
+	RiskDecision *CreateRiskDecisionParams `json:"-"`
+	Idempotency  *IdempotencyParams        `json:"-"`
+}
+
+// FxTransferTx performs a transfer between accounts in different currencies at the rate
+// of a quote previously issued to arg.Username. The source account is debited arg.Amount and
+// the destination account is credited the converted amount, rounded down to the nearest
+// minor unit of the destination currency. The quote can only be used once, and the transfer
+// row keeps the applied rate and the quote id. The transfer fee is charged like for TransferTx,
+// in the currency of the source account. On top of the errors of TransferTx it returns one of the ErrFxQuote errors
+// if the quote cannot be used, and ErrFxAmountTooSmall if nothing would be credited.
+func (store *SQLStore) FxTransferTx(ctx context.Context, arg FxTransferTxParams) (TransferTxResult, error) {
+	var result TransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		return execIdempotent(ctx, q, arg.Idempotency, &result, func() error {
+			err := fxTransfer(ctx, q, arg, &result)
+			if err != nil {
+				return err
+			}
+			return recordRiskDecision(ctx, q, arg.RiskDecision, result.Transfer.ID, 0)
+		})
+	})
+
+	return result, err
+}
+
+func fxTransfer(ctx context.Context, q *Queries, arg FxTransferTxParams, result *TransferTxResult) error {
+	// locking the quote makes concurrent transfers with the same quote run one after the other
+	quote, err := q.GetFxQuoteForUpdate(ctx, arg.QuoteID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return ErrFxQuoteNotFound
+		}
+		return err
+	}
+	if quote.Username != arg.Username {
+		return ErrFxQuoteNotFound
+	}
+	if quote.UsedAt.Valid {
+		return ErrFxQuoteUsed
+	}
+	if time.Now().After(quote.ExpiresAt) {
+		return ErrFxQuoteExpired
+	}
+
+	fromAccount, err := q.GetAccount(ctx, arg.FromAccountID)
+	if err != nil {
+		return err
+	}
+	toAccount, err := q.GetAccount(ctx, arg.ToAccountID)
+	if err != nil {
+		return err
+	}
+	if fromAccount.Currency != quote.FromCurrency || toAccount.Currency != quote.ToCurrency {
+		return fmt.Errorf("%w: quote is %s to %s, accounts are %s to %s", ErrFxQuoteMismatch,
+			quote.FromCurrency, quote.ToCurrency, fromAccount.Currency, toAccount.Currency)
+	}
+
+	fromCurrency, ok := currency.Lookup(quote.FromCurrency)
+	if !ok {
+		return fmt.Errorf("unknown currency %s", quote.FromCurrency)
+	}
+	toCurrency, ok := currency.Lookup(quote.ToCurrency)
+	if !ok {
+		return fmt.Errorf("unknown currency %s", quote.ToCurrency)
+	}
+
+	toAmount, err := util.ConvertAmount(arg.Amount, quote.Rate, fromCurrency.Exponent, toCurrency.Exponent)
+	if err != nil {
+		return err
+	}
+	if toAmount == 0 {
+		return ErrFxAmountTooSmall
+	}
+
+	fromAccount, toAccount, err = lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID)
+	if err != nil {
+		return err
+	}
+
+	err = checkSpendingLimits(ctx, q, fromAccount, arg.Amount)
+	if err != nil {
+		return err
+	}
+
+	err = checkNewPayeeLimit(ctx, q, fromAccount, toAccount, arg.Amount, arg.NewPayeeLimit)
+	if err != nil {
+		return err
+	}
+
+	feeQuote, feeOK, err := quoteFee(ctx, q, fromAccount, util.FeeTransfer, arg.Amount)
+	if err != nil {
+		return err
+	}
+
+	if feeQuote.Amount > 0 {
+		err = checkAvailableFunds(ctx, q, fromAccount, arg.Amount+feeQuote.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = q.UseFxQuote(ctx, quote.ID)
+	if err != nil {
+		return err
+	}
+
+	err = moveMoney(ctx, q, JournalTransfer, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		ToAmount:      toAmount,
+		FxRate:        sql.NullInt64{Int64: quote.Rate, Valid: true},
+		FxQuoteID:     uuid.NullUUID{UUID: quote.ID, Valid: true},
+		Memo:          arg.Memo,
+		Reference:     arg.Reference,
+		Category:      arg.Category,
+	}, result)
+	if err != nil {
+		return err
+	}
+
+	result.Fee = feeQuote
+	if !feeOK {
+		return nil
+	}
+
+	result.FeeEntry, result.FromAccount, err = chargeFee(ctx, q, result.FromAccount, util.FeeTransfer,
+		feeQuote, sql.NullInt64{Int64: result.Transfer.ID, Valid: true})
+	return err
+}
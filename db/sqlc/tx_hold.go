@@ -0,0 +1,290 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/fee"
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// CreateHoldTxParams contains the input parameters of the create hold transaction
+type CreateHoldTxParams struct {
+	AccountID   int64              `json:"account_id"`
+	Amount      int64              `json:"amount"`
+	ExpiresAt   time.Time          `json:"expires_at"`
+	Idempotency *IdempotencyParams `json:"-"`
+}
+
+// CreateHoldTxResult is the result of the create hold transaction
+type CreateHoldTxResult struct {
+	Hold             Hold    `json:"hold"`
+	Account          Account `json:"account"`
+	AvailableBalance int64   `json:"available_balance"`
+}
+
+// CreateHoldTx reserves funds on an account until they are captured, released or the hold expires.
+// The balance is left untouched, only the available balance goes down by the held amount.
+// It returns ErrInsufficientFunds if the account cannot cover the amount on top of its
+// active holds, and an AccountNotActiveError if the account is frozen or closed.
+// If arg.Idempotency is set, a replay of the same key returns the original result instead.
+func (store *SQLStore) CreateHoldTx(ctx context.Context, arg CreateHoldTxParams) (CreateHoldTxResult, error) {
+	var result CreateHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		return execIdempotent(ctx, q, arg.Idempotency, &result, func() error {
+			if arg.Amount <= 0 {
+				return fmt.Errorf("hold amount must be positive")
+			}
+
+			account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+			if err != nil {
+				return err
+			}
+
+			err = checkAccountActive(account)
+			if err != nil {
+				return err
+			}
+
+			held, err := q.GetHeldAmount(ctx, account.ID)
+			if err != nil {
+				return err
+			}
+
+			err = checkSufficientFunds(account, held, arg.Amount)
+			if err != nil {
+				return err
+			}
+
+			result.Hold, err = q.CreateHold(ctx, CreateHoldParams{
+				AccountID: arg.AccountID,
+				Amount:    arg.Amount,
+				ExpiresAt: arg.ExpiresAt,
+			})
+			if err != nil {
+				return err
+			}
+
+			result.Account = account
+			result.AvailableBalance = account.Balance - held - arg.Amount
+			return nil
+		})
+	})
+
+	return result, err
+}
+
+// CaptureHoldTxParams contains the input parameters of the capture hold transaction
+type CaptureHoldTxParams struct {
+	HoldID int64 `json:"hold_id"`
+	// Amount captures only part of the hold when set, the rest is released
+	Amount int64 `json:"amount"`
+	// RiskDecision is the decision of the risk rules allowing the movement, recorded along with it.
+	// It can be nil.
+	RiskDecision *CreateRiskDecisionParams `json:"-"`
+}
+
+// CaptureHoldTxResult is the result of the capture hold transaction
+type CaptureHoldTxResult struct {
+	Hold    Hold    `json:"hold"`
+	Account Account `json:"account"`
+	Entry   Entry   `json:"entry"`
+	Journal Journal `json:"journal"`
+	// Fee is the withdrawal fee charged on the captured amount
+	Fee fee.Quote `json:"fee"`
+	// FeeEntry debited the fee, it is empty when nothing was charged
+	FeeEntry Entry `json:"fee_entry"`
+}
+
+// CaptureHoldTx settles an active hold. It debits the captured amount from the account
+// with an entry and a journal to the cash vault, and releases whatever was held on top of it.
+// The captured amount is charged the withdrawal fee like WithdrawTx.
+// It returns ErrHoldNotActive if the hold was already settled, ErrHoldExpired once it has expired,
+// ErrCaptureExceedsHold if more than the held amount is captured, a SpendingLimitError
+// if the captured amount is over a spending limit of the account,
+// and an AccountNotActiveError if the account is frozen or closed.
+// arg.RiskDecision is only recorded when the hold is captured.
+func (store *SQLStore) CaptureHoldTx(ctx context.Context, arg CaptureHoldTxParams) (CaptureHoldTxResult, error) {
+	var result CaptureHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		account, hold, err := lockHold(ctx, q, arg.HoldID)
+		if err != nil {
+			return err
+		}
+
+		err = checkHoldActive(hold)
+		if err != nil {
+			return err
+		}
+
+		err = checkAccountActive(account)
+		if err != nil {
+			return err
+		}
+
+		amount := arg.Amount
+		if amount == 0 {
+			amount = hold.Amount
+		}
+		if amount < 0 {
+			return fmt.Errorf("capture amount must be positive")
+		}
+		if amount > hold.Amount {
+			return fmt.Errorf("%w: hold %d has %d, requested %d", ErrCaptureExceedsHold, hold.ID, hold.Amount, amount)
+		}
+
+		// the debit happens now, so it counts against the limits of the day it is captured
+		err = checkSpendingLimits(ctx, q, account, amount)
+		if err != nil {
+			return err
+		}
+
+		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+			AccountID: account.ID,
+			Amount:    -amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Hold, err = q.SettleHold(ctx, SettleHoldParams{
+			ID:             hold.ID,
+			Status:         util.HoldCaptured,
+			CapturedAmount: amount,
+			EntryID:        sql.NullInt64{Int64: result.Entry.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		quote, ok, err := quoteFee(ctx, q, account, util.FeeWithdrawal, amount)
+		if err != nil {
+			return err
+		}
+
+		// the hold no longer counts, so this only fails for the fee
+		// or if the overdraft limit was lowered meanwhile
+		err = checkAvailableFunds(ctx, q, account, amount+quote.Amount)
+		if err != nil {
+			return err
+		}
+
+		result.Journal, err = postJournal(ctx, q, JournalParams{
+			Kind: JournalHoldCapture,
+			Postings: []PostingParams{
+				{AccountID: account.ID, Currency: account.Currency, Amount: -amount},
+				{SystemAccount: util.SystemCashVault, Currency: account.Currency, Amount: amount},
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+			ID:     account.ID,
+			Amount: -amount,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = recordRiskDecision(ctx, q, arg.RiskDecision, 0, result.Entry.ID)
+		if err != nil {
+			return err
+		}
+
+		result.Fee = quote
+		if !ok {
+			return nil
+		}
+
+		result.FeeEntry, result.Account, err = chargeFee(ctx, q, result.Account, util.FeeWithdrawal,
+			quote, sql.NullInt64{})
+		return err
+	})
+
+	return result, err
+}
+
+// ReleaseHoldTxParams contains the input parameters of the release hold transaction
+type ReleaseHoldTxParams struct {
+	HoldID int64 `json:"hold_id"`
+}
+
+// ReleaseHoldTxResult is the result of the release hold transaction
+type ReleaseHoldTxResult struct {
+	Hold Hold `json:"hold"`
+}
+
+// ReleaseHoldTx gives the held funds back to the available balance without moving any money.
+// A hold past its expiry is recorded as expired rather than released.
+// It returns ErrHoldNotActive if the hold was already settled.
+func (store *SQLStore) ReleaseHoldTx(ctx context.Context, arg ReleaseHoldTxParams) (ReleaseHoldTxResult, error) {
+	var result ReleaseHoldTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		hold, err := q.GetHoldForUpdate(ctx, arg.HoldID)
+		if err != nil {
+			return err
+		}
+
+		if hold.Status != util.HoldActive {
+			return fmt.Errorf("%w: hold %d is %s", ErrHoldNotActive, hold.ID, hold.Status)
+		}
+
+		status := util.HoldReleased
+		if !time.Now().Before(hold.ExpiresAt) {
+			status = util.HoldExpired
+		}
+
+		result.Hold, err = q.SettleHold(ctx, SettleHoldParams{
+			ID:     hold.ID,
+			Status: status,
+		})
+		return err
+	})
+
+	return result, err
+}
+
+// lockHold takes the row lock on the account of a hold before the one on the hold itself,
+// the same order as every other transaction that locks the account.
+func lockHold(ctx context.Context, q *Queries, holdID int64) (account Account, hold Hold, err error) {
+	hold, err = q.GetHold(ctx, holdID)
+	if err != nil {
+		return
+	}
+
+	account, err = q.GetAccountForUpdate(ctx, hold.AccountID)
+	if err != nil {
+		return
+	}
+
+	hold, err = q.GetHoldForUpdate(ctx, holdID)
+	return
+}
+
+// checkHoldActive reports whether the hold can still be captured
+func checkHoldActive(hold Hold) error {
+	if hold.Status != util.HoldActive {
+		return fmt.Errorf("%w: hold %d is %s", ErrHoldNotActive, hold.ID, hold.Status)
+	}
+	if !time.Now().Before(hold.ExpiresAt) {
+		return fmt.Errorf("%w: hold %d expired at %s", ErrHoldExpired, hold.ID, hold.ExpiresAt)
+	}
+	return nil
+}
+
+// checkAvailableFunds reports whether the account can be debited by amount on top of its
+// active holds. The account must already be locked so no hold can be added meanwhile.
+func checkAvailableFunds(ctx context.Context, q Querier, account Account, amount int64) error {
+	held, err := q.GetHeldAmount(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+	return checkSufficientFunds(account, held, amount)
+}
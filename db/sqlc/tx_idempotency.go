@@ -0,0 +1,80 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// ErrIdempotencyKeyReused is returned when an idempotency key is replayed with a different request
+var ErrIdempotencyKeyReused = errors.New("idempotency key already used with a different request")
+
+// IdempotencyParams identifies a client retry of a money movement request.
+// RequestHash is a fingerprint of the request body the key was sent with.
+type IdempotencyParams struct {
+	Username    string
+	Key         string
+	RequestHash string
+}
+
+// execIdempotent runs fn inside the caller's transaction unless the key was already used.
+// The first use of a key records the marshalled result so a replay gets the original result back
+// without fn running again. If fn fails the whole transaction rolls back, key included,
+// so the request can be retried.
+func execIdempotent(ctx context.Context, q Querier, params *IdempotencyParams, result interface{}, fn func() error) error {
+	if params == nil {
+		return fn()
+	}
+
+	// a concurrent transaction holding the same key makes this insert wait until it commits or rolls back
+	_, err := q.CreateIdempotencyKey(ctx, CreateIdempotencyKeyParams{
+		Username:       params.Username,
+		IdempotencyKey: params.Key,
+		RequestHash:    params.RequestHash,
+	})
+	if err == sql.ErrNoRows {
+		return replayIdempotent(ctx, q, params, result)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = fn()
+	if err != nil {
+		return err
+	}
+
+	response, err := json.Marshal(result)
+	if err != nil {
+		return fmt.Errorf("failed to marshal idempotent response: %w", err)
+	}
+
+	_, err = q.UpdateIdempotencyKeyResponse(ctx, UpdateIdempotencyKeyResponseParams{
+		Username:       params.Username,
+		IdempotencyKey: params.Key,
+		Response:       response,
+	})
+	return err
+}
+
+func replayIdempotent(ctx context.Context, q Querier, params *IdempotencyParams, result interface{}) error {
+	record, err := q.GetIdempotencyKey(ctx, GetIdempotencyKeyParams{
+		Username:       params.Username,
+		IdempotencyKey: params.Key,
+	})
+	if err != nil {
+		return err
+	}
+
+	if record.RequestHash != params.RequestHash {
+		return ErrIdempotencyKeyReused
+	}
+
+	err = json.Unmarshal(record.Response, result)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal idempotent response: %w", err)
+	}
+	return nil
+}
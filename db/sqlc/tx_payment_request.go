@@ -0,0 +1,196 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// CreatePaymentRequestTxParams contains the input parameters of the create payment request transaction
+type CreatePaymentRequestTxParams struct {
+	CreatePaymentRequestParams
+	// AfterCreate runs before the transaction commits, the request isn't made if it fails
+	AfterCreate func(request PaymentRequest) error
+}
+
+// CreatePaymentRequestTxResult is the result of the create payment request transaction
+type CreatePaymentRequestTxResult struct {
+	PaymentRequest PaymentRequest `json:"payment_request"`
+}
+
+// CreatePaymentRequestTx asks the payer for money and runs arg.AfterCreate, usually to
+// notify both users, in the same transaction
+func (store *SQLStore) CreatePaymentRequestTx(ctx context.Context, arg CreatePaymentRequestTxParams) (CreatePaymentRequestTxResult, error) {
+	var result CreatePaymentRequestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		result.PaymentRequest, err = q.CreatePaymentRequest(ctx, arg.CreatePaymentRequestParams)
+		if err != nil {
+			return err
+		}
+
+		return afterPaymentRequest(arg.AfterCreate, result.PaymentRequest)
+	})
+
+	return result, err
+}
+
+// AcceptPaymentRequestTxParams contains the input parameters of the accept payment request transaction
+type AcceptPaymentRequestTxParams struct {
+	ID int64 `json:"id"`
+	// FromAccountID is the account of the payer the request is paid from
+	FromAccountID int64         `json:"from_account_id"`
+	NewPayeeLimit NewPayeeLimit `json:"new_payee_limit"`
+	// RiskDecision is the decision of the risk rules allowing the movement, recorded along with it.
+	// It can be nil.
+	RiskDecision *CreateRiskDecisionParams `json:"-"`
+	// AfterAccept runs before the transaction commits, nothing is paid if it fails
+	AfterAccept func(request PaymentRequest) error `json:"-"`
+}
+
+// AcceptPaymentRequestTxResult is the result of the accept payment request transaction
+type AcceptPaymentRequestTxResult struct {
+	PaymentRequest PaymentRequest   `json:"payment_request"`
+	Transfer       TransferTxResult `json:"transfer"`
+}
+
+// AcceptPaymentRequestTx pays a pending payment request with a transfer from the payer's account
+// into the requester's, carrying the memo of the request, and marks the request accepted.
+// The transfer goes through every check TransferTx makes, arg.NewPayeeLimit included,
+// and fails the same way.
+// It returns ErrPaymentRequestNotPending if the request was already answered
+// and ErrPaymentRequestExpired once it has expired.
+func (store *SQLStore) AcceptPaymentRequestTx(ctx context.Context, arg AcceptPaymentRequestTxParams) (AcceptPaymentRequestTxResult, error) {
+	var result AcceptPaymentRequestTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		request, err := lockPendingPaymentRequest(ctx, q, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		err = transfer(ctx, q, TransferTxParams{
+			FromAccountID: arg.FromAccountID,
+			ToAccountID:   request.ToAccountID,
+			Amount:        request.Amount,
+			Annotation:    Annotation{Memo: request.Memo},
+			NewPayeeLimit: arg.NewPayeeLimit,
+		}, &result.Transfer)
+		if err != nil {
+			return err
+		}
+
+		result.PaymentRequest, err = q.UpdatePaymentRequestStatus(ctx, UpdatePaymentRequestStatusParams{
+			ID:         request.ID,
+			Status:     util.PaymentRequestAccepted,
+			TransferID: sql.NullInt64{Int64: result.Transfer.Transfer.ID, Valid: true},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = recordRiskDecision(ctx, q, arg.RiskDecision, result.Transfer.Transfer.ID, 0)
+		if err != nil {
+			return err
+		}
+
+		return afterPaymentRequest(arg.AfterAccept, result.PaymentRequest)
+	})
+
+	return result, err
+}
+
+// ClosePaymentRequestTxParams contains the input parameters of the decline and expire
+// payment request transactions
+type ClosePaymentRequestTxParams struct {
+	ID int64 `json:"id"`
+	// AfterClose runs before the transaction commits, the request stays pending if it fails
+	AfterClose func(request PaymentRequest) error `json:"-"`
+}
+
+// DeclinePaymentRequestTx marks a pending payment request declined without moving any money.
+// It returns ErrPaymentRequestNotPending if the request was already answered
+// and ErrPaymentRequestExpired once it has expired.
+func (store *SQLStore) DeclinePaymentRequestTx(ctx context.Context, arg ClosePaymentRequestTxParams) (PaymentRequest, error) {
+	var result PaymentRequest
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		request, err := lockPendingPaymentRequest(ctx, q, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		result, err = q.UpdatePaymentRequestStatus(ctx, UpdatePaymentRequestStatusParams{
+			ID:     request.ID,
+			Status: util.PaymentRequestDeclined,
+		})
+		if err != nil {
+			return err
+		}
+
+		return afterPaymentRequest(arg.AfterClose, result)
+	})
+
+	return result, err
+}
+
+// ExpirePaymentRequestTx marks a pending payment request past its expiry as expired.
+// It returns ErrPaymentRequestNotPending if the request was answered in the meantime.
+func (store *SQLStore) ExpirePaymentRequestTx(ctx context.Context, arg ClosePaymentRequestTxParams) (PaymentRequest, error) {
+	var result PaymentRequest
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		request, err := q.GetPaymentRequestForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		if request.Status != util.PaymentRequestPending {
+			return fmt.Errorf("%w: payment request %d is %s", ErrPaymentRequestNotPending, request.ID, request.Status)
+		}
+		if time.Now().Before(request.ExpiresAt) {
+			return fmt.Errorf("payment request %d doesn't expire until %s", request.ID, request.ExpiresAt.Format(time.RFC3339))
+		}
+
+		result, err = q.UpdatePaymentRequestStatus(ctx, UpdatePaymentRequestStatusParams{
+			ID:     request.ID,
+			Status: util.PaymentRequestExpired,
+		})
+		if err != nil {
+			return err
+		}
+
+		return afterPaymentRequest(arg.AfterClose, result)
+	})
+
+	return result, err
+}
+
+// lockPendingPaymentRequest locks a payment request that can still be answered
+func lockPendingPaymentRequest(ctx context.Context, q *Queries, id int64) (PaymentRequest, error) {
+	request, err := q.GetPaymentRequestForUpdate(ctx, id)
+	if err != nil {
+		return request, err
+	}
+
+	if request.Status != util.PaymentRequestPending {
+		return request, fmt.Errorf("%w: payment request %d is %s", ErrPaymentRequestNotPending, request.ID, request.Status)
+	}
+	// the expiry task may not have got to it yet
+	if !time.Now().Before(request.ExpiresAt) {
+		return request, fmt.Errorf("%w: payment request %d expired at %s", ErrPaymentRequestExpired, request.ID, request.ExpiresAt.Format(time.RFC3339))
+	}
+	return request, nil
+}
+
+func afterPaymentRequest(after func(request PaymentRequest) error, request PaymentRequest) error {
+	if after == nil {
+		return nil
+	}
+	return after(request)
+}
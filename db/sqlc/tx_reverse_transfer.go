@@ -0,0 +1,127 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+)
+
+// ReverseTransferTxParams contains the input parameters of the reverse transfer transaction.
+// Amount is in the currency of the destination account of the transfer, 0 sends back
+// everything that hasn't been refunded yet.
+type ReverseTransferTxParams struct {
+	TransferID int64 `json:"transfer_id"`
+	Amount     int64 `json:"amount"`
+	// Kind is the kind of the journal, JournalRefund or JournalReversal
+	Kind        string             `json:"kind"`
+	Idempotency *IdempotencyParams `json:"-"`
+}
+
+// ReverseTransferTxResult is the result of the reverse transfer transaction.
+// The embedded transfer result is the compensating transfer, going from the destination
+// account of the original transfer back to its source account.
+type ReverseTransferTxResult struct {
+	TransferTxResult
+	// OriginalTransfer is the reversed transfer with its updated refunded amount
+	OriginalTransfer Transfer `json:"original_transfer"`
+}
+
+// ReverseTransferTx sends all or part of a transfer back within a database transaction.
+// See ReverseTransfer for the details.
+// If arg.Idempotency is set, a replay of the same key returns the original result instead.
+func (store *SQLStore) ReverseTransferTx(ctx context.Context, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		return execIdempotent(ctx, q, arg.Idempotency, &result, func() error {
+			var err error
+			result, err = ReverseTransfer(ctx, q, arg)
+			return err
+		})
+	})
+
+	return result, err
+}
+
+// ReverseTransfer sends all or part of a transfer back using q, which must already run inside
+// a transaction. It makes a compensating transfer linked to the original one and adds the amount
+// to the refunded amount of the original, which can never go past what was credited.
+// For fx transfers the source account gets back its share of the original amount, so partial
+// refunds add up to exactly the original amount once everything has been sent back.
+// On top of the errors of TransferTx it returns ErrTransferIsReversal for a compensating transfer,
+// ErrTransferFullyRefunded when nothing is left to send back and ErrReversalExceedsTransfer when
+// arg.Amount is more than what is left.
+func ReverseTransfer(ctx context.Context, q Querier, arg ReverseTransferTxParams) (ReverseTransferTxResult, error) {
+	var result ReverseTransferTxResult
+
+	original, err := q.GetTransfer(ctx, arg.TransferID)
+	if err != nil {
+		return result, err
+	}
+
+	// the accounts are locked before the transfer, the same order as every other transaction
+	_, _, err = lockAccounts(ctx, q, original.ToAccountID, original.FromAccountID)
+	if err != nil {
+		return result, err
+	}
+
+	original, err = q.GetTransferForUpdate(ctx, arg.TransferID)
+	if err != nil {
+		return result, err
+	}
+
+	if original.ReversedTransferID.Valid {
+		return result, fmt.Errorf("%w: transfer %d reverses transfer %d",
+			ErrTransferIsReversal, original.ID, original.ReversedTransferID.Int64)
+	}
+
+	remaining := original.ToAmount - original.RefundedAmount
+	if remaining == 0 {
+		return result, fmt.Errorf("%w: transfer %d", ErrTransferFullyRefunded, original.ID)
+	}
+
+	amount := arg.Amount
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount > remaining {
+		return result, fmt.Errorf("%w: transfer %d has %d left, requested %d",
+			ErrReversalExceedsTransfer, original.ID, remaining, amount)
+	}
+
+	refunded := original.RefundedAmount + amount
+	toAmount := sourceShare(original, refunded) - sourceShare(original, original.RefundedAmount)
+	if toAmount == 0 {
+		return result, ErrFxAmountTooSmall
+	}
+
+	err = moveMoney(ctx, q, arg.Kind, CreateTransferParams{
+		FromAccountID:      original.ToAccountID,
+		ToAccountID:        original.FromAccountID,
+		Amount:             amount,
+		ToAmount:           toAmount,
+		ReversedTransferID: sql.NullInt64{Int64: original.ID, Valid: true},
+	}, &result.TransferTxResult)
+	if err != nil {
+		return result, err
+	}
+
+	result.OriginalTransfer, err = q.AddTransferRefundedAmount(ctx, AddTransferRefundedAmountParams{
+		ID:     original.ID,
+		Amount: amount,
+	})
+	return result, err
+}
+
+// sourceShare returns the part of the amount debited by the transfer that corresponds
+// to refunded out of the amount it credited, rounded down
+func sourceShare(transfer Transfer, refunded int64) int64 {
+	if transfer.Amount == transfer.ToAmount {
+		return refunded
+	}
+
+	share := new(big.Int).Mul(big.NewInt(refunded), big.NewInt(transfer.Amount))
+	share.Quo(share, big.NewInt(transfer.ToAmount))
+	return share.Int64()
+}
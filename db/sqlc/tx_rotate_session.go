@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrRefreshTokenReused is returned when a refresh token that was already exchanged
+// is presented again. By then the whole session family has been blocked.
+var ErrRefreshTokenReused = errors.New("refresh token reused")
+
+// ErrSessionBlocked is returned when rotating a session that was logged out or revoked
+var ErrSessionBlocked = errors.New("blocked session")
+
+// RotateSessionTxParams contains the input parameters of the rotate session transaction
+type RotateSessionTxParams struct {
+	OldSessionID uuid.UUID
+	// NewSession is created in the family of the old session; its FamilyID is ignored
+	NewSession CreateSessionParams
+}
+
+// RotateSessionTxResult is the result of the rotate session transaction
+type RotateSessionTxResult struct {
+	OldSession Session
+	Session    Session
+}
+
+// RotateSessionTx exchanges a refresh session for a new one in the same family.
+// The old session is marked consumed, so presenting its token again blocks the family.
+// The new session expires no later than the old one, so a family that keeps refreshing
+// still ends when its login session would have.
+func (store *SQLStore) RotateSessionTx(ctx context.Context, arg RotateSessionTxParams) (RotateSessionTxResult, error) {
+	var result RotateSessionTxResult
+	reused := false
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		var err error
+
+		// the row lock makes concurrent refreshes with the same token take turns,
+		// so only the first one wins and the rest are treated as reuse
+		result.OldSession, err = q.GetSessionForUpdate(ctx, arg.OldSessionID)
+		if err != nil {
+			return err
+		}
+
+		if result.OldSession.ConsumedAt.Valid {
+			reused = true
+			_, err = q.BlockSessionFamily(ctx, result.OldSession.FamilyID)
+			return err
+		}
+
+		if result.OldSession.IsBlocked {
+			return ErrSessionBlocked
+		}
+
+		if time.Now().After(result.OldSession.ExpiresAt) {
+			return fmt.Errorf("%w: session expired", ErrSessionBlocked)
+		}
+
+		result.OldSession, err = q.ConsumeSession(ctx, arg.OldSessionID)
+		if err != nil {
+			return err
+		}
+
+		newSession := arg.NewSession
+		newSession.Username = result.OldSession.Username
+		newSession.FamilyID = result.OldSession.FamilyID
+		if newSession.ExpiresAt.After(result.OldSession.ExpiresAt) {
+			newSession.ExpiresAt = result.OldSession.ExpiresAt
+		}
+		result.Session, err = q.CreateSession(ctx, newSession)
+		return err
+	})
+	if err != nil {
+		return result, err
+	}
+
+	// the block has to be committed before reporting the reuse
+	if reused {
+		return result, fmt.Errorf("%w: session %s of family %s",
+			ErrRefreshTokenReused, result.OldSession.ID, result.OldSession.FamilyID)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,158 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// ErrScheduledTransferNotDue is returned when running a scheduled transfer that is paused,
+// has ended or isn't due yet, usually because another worker got to it first.
+var ErrScheduledTransferNotDue = errors.New("scheduled transfer is not due")
+
+// RunScheduledTransferTxParams contains the input parameters of the run scheduled transfer transaction
+type RunScheduledTransferTxParams struct {
+	ID int64 `json:"id"`
+	// MaxRetries is how many times a failed occurrence is retried under the retry failure policy
+	MaxRetries int32         `json:"max_retries"`
+	RetryDelay time.Duration `json:"retry_delay"`
+	// NewPayeeLimit caps the transfers to an account the owner only started paying recently
+	NewPayeeLimit NewPayeeLimit `json:"new_payee_limit"`
+	// Screen checks the transfer of the run against the risk rules using q before it is made.
+	// It returns ErrRiskDenied to turn the run down, otherwise the decision allowing the run,
+	// which is only recorded when the transfer is made. It can be nil.
+	Screen func(q Querier, scheduled ScheduledTransfer) (*CreateRiskDecisionParams, error) `json:"-"`
+}
+
+// RunScheduledTransferTxResult is the result of the run scheduled transfer transaction
+type RunScheduledTransferTxResult struct {
+	ScheduledTransfer ScheduledTransfer    `json:"scheduled_transfer"`
+	Run               ScheduledTransferRun `json:"run"`
+	// Transfer is only set when the run succeeded
+	Transfer TransferTxResult `json:"transfer"`
+}
+
+// RunScheduledTransferTx makes the transfer of the due occurrence of a scheduled transfer,
+// records the run with its outcome and moves the schedule on to its next occurrence.
+// A run that fails for insufficient funds, an inactive account, the new payee limit or the risk rules
+// is either skipped or, under the retry failure policy, tried again after arg.RetryDelay up to arg.MaxRetries times.
+// Occurrences missed while the schedule was paused are dropped, only the latest one runs.
+// It returns ErrScheduledTransferNotDue if the schedule is not active or not due yet.
+func (store *SQLStore) RunScheduledTransferTx(ctx context.Context, arg RunScheduledTransferTxParams) (RunScheduledTransferTxResult, error) {
+	var result RunScheduledTransferTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		scheduled, err := q.GetScheduledTransferForUpdate(ctx, arg.ID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if scheduled.Status != util.ScheduleActive || !scheduled.NextRunAt.Valid || scheduled.NextRunAt.Time.After(now) {
+			return fmt.Errorf("%w: scheduled transfer %d", ErrScheduledTransferNotDue, scheduled.ID)
+		}
+
+		recurrence := scheduledRecurrence(scheduled)
+		occurrence := scheduled.NextOccurrenceAt.Time
+		attempt := scheduled.Attempts + 1
+		for next, ok := recurrence.Next(occurrence); ok && !next.After(now) && withinEnd(scheduled, next); next, ok = recurrence.Next(next) {
+			occurrence = next
+			attempt = 1
+		}
+
+		// the end was moved before the occurrence after it had been planned
+		if !withinEnd(scheduled, occurrence) {
+			result.ScheduledTransfer, err = q.AdvanceScheduledTransfer(ctx, AdvanceScheduledTransferParams{
+				ID:     scheduled.ID,
+				Status: util.ScheduleCompleted,
+			})
+			return err
+		}
+
+		runStatus := util.RunSucceeded
+		var runError string
+		var decision *CreateRiskDecisionParams
+		if arg.Screen != nil {
+			decision, err = arg.Screen(q, scheduled)
+		}
+		if err == nil {
+			err = transfer(ctx, q, TransferTxParams{
+				FromAccountID: scheduled.FromAccountID,
+				ToAccountID:   scheduled.ToAccountID,
+				Amount:        scheduled.Amount,
+				NewPayeeLimit: arg.NewPayeeLimit,
+			}, &result.Transfer)
+		}
+		if err == nil {
+			err = recordRiskDecision(ctx, q, decision, result.Transfer.Transfer.ID, 0)
+			if err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			if !isMoveRejected(err) {
+				return err
+			}
+
+			runError = err.Error()
+			switch {
+			case scheduled.FailurePolicy != util.FailurePolicyRetry:
+				runStatus = util.RunSkipped
+			case attempt <= arg.MaxRetries:
+				runStatus = util.RunRetrying
+			default:
+				runStatus = util.RunFailed
+			}
+		}
+
+		result.Run, err = q.CreateScheduledTransferRun(ctx, CreateScheduledTransferRunParams{
+			ScheduledTransferID: scheduled.ID,
+			OccurrenceAt:        occurrence,
+			Attempt:             attempt,
+			Status:              runStatus,
+			TransferID:          sql.NullInt64{Int64: result.Transfer.Transfer.ID, Valid: runStatus == util.RunSucceeded},
+			Error:               runError,
+		})
+		if err != nil {
+			return err
+		}
+
+		advance := AdvanceScheduledTransferParams{
+			ID:     scheduled.ID,
+			Status: util.ScheduleCompleted,
+		}
+		if runStatus == util.RunRetrying {
+			advance.NextOccurrenceAt = sql.NullTime{Time: occurrence, Valid: true}
+			advance.NextRunAt = sql.NullTime{Time: now.Add(arg.RetryDelay), Valid: true}
+			advance.Attempts = attempt
+			advance.Status = util.ScheduleActive
+		} else if next, ok := recurrence.Next(occurrence); ok && withinEnd(scheduled, next) {
+			advance.NextOccurrenceAt = sql.NullTime{Time: next, Valid: true}
+			advance.NextRunAt = sql.NullTime{Time: next, Valid: true}
+			advance.Status = util.ScheduleActive
+		}
+
+		result.ScheduledTransfer, err = q.AdvanceScheduledTransfer(ctx, advance)
+		return err
+	})
+
+	return result, err
+}
+
+// scheduledRecurrence returns when the scheduled transfer repeats
+func scheduledRecurrence(scheduled ScheduledTransfer) util.Recurrence {
+	return util.Recurrence{
+		Frequency:  scheduled.Frequency,
+		Interval:   scheduled.IntervalCount,
+		DayOfMonth: scheduled.DayOfMonth,
+	}
+}
+
+// withinEnd reports whether an occurrence at t falls before the end of the schedule
+func withinEnd(scheduled ScheduledTransfer, t time.Time) bool {
+	return !scheduled.EndAt.Valid || !t.After(scheduled.EndAt.Time)
+}
@@ -1,12 +1,33 @@
 package db
 
-import "context"
+import (
+	"context"
+	"database/sql"
+
+	"github.com/JaidenShall/simplebank/fee"
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// Annotation is what the customer says about a money movement. It is kept on the transfer
+// and its entries so statements and history searches can show it. The category of a transfer
+// belongs to the payer, so it only goes on the debited entry.
+type Annotation struct {
+	Memo      string `json:"memo"`
+	Reference string `json:"reference"`
+	Category  string `json:"category"`
+}
 
 // TransferTxParams contains the input parameters of the transfer transaction
 type TransferTxParams struct {
 	FromAccountID int64 `json:"from_account_id"`
 	ToAccountID   int64 `json:"to_account_id"`
 	Amount        int64 `json:"amount"`
+	Annotation
+	NewPayeeLimit NewPayeeLimit `json:"new_payee_limit"`
+	// RiskDecision is the decision of the risk rules allowing the movement, recorded along with it.
+	// It can be nil.
+	RiskDecision *CreateRiskDecisionParams `json:"-"`
+	Idempotency  *IdempotencyParams        `json:"-"`
 }
 
 // TransferTxResult is the result of the transfer transaction
@@ -16,52 +37,195 @@ type TransferTxResult struct {
 	ToAccount   Account  `json:"to_account"`
 	FromEntry   Entry    `json:"from_entry"`
 	ToEntry     Entry    `json:"to_entry"`
+	Journal     Journal  `json:"journal"`
+	// Fee is the fee charged to the source account, reversals charge none
+	Fee fee.Quote `json:"fee"`
+	// FeeEntry debited the fee, it is empty when nothing was charged
+	FeeEntry Entry `json:"fee_entry"`
 }
 
 // TransferTx performs a money transfer from one account to the other.
-// It creates the transfer, add account entries, and update accounts' balance within a database transaction
+// It locks both accounts, checks the source has sufficient funds, then creates the transfer,
+// adds account entries, posts a journal and updates accounts' balance within a database transaction.
+// The transfer fee of the source account's currency is charged in the same transaction.
+// It returns ErrInsufficientFunds if the source account cannot cover the amount and the fee on top
+// of its active holds, an AccountNotActiveError if either account is frozen or closed,
+// a SpendingLimitError if the amount goes over a spending limit of the source account and
+// ErrNewPayeeLimit if it goes over arg.NewPayeeLimit.
+// arg.RiskDecision is only recorded when the transfer is made.
+// If arg.Idempotency is set, a replay of the same key returns the original result instead.
 func (store *SQLStore) TransferTx(ctx context.Context, arg TransferTxParams) (TransferTxResult, error) {
 	var result TransferTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
-		var err error
+		return execIdempotent(ctx, q, arg.Idempotency, &result, func() error {
+			err := transfer(ctx, q, arg, &result)
+			if err != nil {
+				return err
+			}
+			return recordRiskDecision(ctx, q, arg.RiskDecision, result.Transfer.ID, 0)
+		})
+	})
 
-		result.Transfer, err = q.CreateTransfer(ctx, CreateTransferParams(arg))
-		if err != nil {
-			return err
-		}
+	return result, err
+}
 
-		result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.FromAccountID,
-			Amount:    -arg.Amount,
-		})
-		if err != nil {
-			return err
-		}
+// transfer moves the money and charges the transfer fee using q,
+// which must already run inside a transaction
+func transfer(ctx context.Context, q Querier, arg TransferTxParams, result *TransferTxResult) error {
+	// limits and the fee are checked before moveMoney writes anything, it takes the same locks again
+	fromAccount, toAccount, err := lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID)
+	if err != nil {
+		return err
+	}
 
-		result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.ToAccountID,
-			Amount:    arg.Amount,
-		})
+	err = checkSpendingLimits(ctx, q, fromAccount, arg.Amount)
+	if err != nil {
+		return err
+	}
+
+	err = checkNewPayeeLimit(ctx, q, fromAccount, toAccount, arg.Amount, arg.NewPayeeLimit)
+	if err != nil {
+		return err
+	}
+
+	quote, ok, err := quoteFee(ctx, q, fromAccount, util.FeeTransfer, arg.Amount)
+	if err != nil {
+		return err
+	}
+
+	if quote.Amount > 0 {
+		err = checkAvailableFunds(ctx, q, fromAccount, arg.Amount+quote.Amount)
 		if err != nil {
 			return err
 		}
+	}
 
-		if arg.FromAccountID < arg.ToAccountID {
-			result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.Amount)
-		} else {
-			result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.Amount, arg.FromAccountID, -arg.Amount)
-		}
+	err = moveMoney(ctx, q, JournalTransfer, CreateTransferParams{
+		FromAccountID: arg.FromAccountID,
+		ToAccountID:   arg.ToAccountID,
+		Amount:        arg.Amount,
+		ToAmount:      arg.Amount,
+		Memo:          arg.Memo,
+		Reference:     arg.Reference,
+		Category:      arg.Category,
+	}, result)
+	if err != nil {
+		return err
+	}
+
+	result.Fee = quote
+	if !ok {
+		return nil
+	}
+
+	result.FeeEntry, result.FromAccount, err = chargeFee(ctx, q, result.FromAccount, util.FeeTransfer,
+		quote, sql.NullInt64{Int64: result.Transfer.ID, Valid: true})
+	return err
+}
+
+// moveMoney debits arg.Amount from the source account and credits arg.ToAmount
+// to the destination account, recording the transfer, both entries and a journal of the kind.
+// When the currencies differ the journal goes through the fx position in each currency.
+func moveMoney(ctx context.Context, q Querier, kind string, arg CreateTransferParams, result *TransferTxResult) error {
+	fromAccount, toAccount, err := lockAccounts(ctx, q, arg.FromAccountID, arg.ToAccountID)
+	if err != nil {
+		return err
+	}
+
+	err = checkAccountActive(fromAccount)
+	if err != nil {
+		return err
+	}
+
+	err = checkAccountActive(toAccount)
+	if err != nil {
+		return err
+	}
+
+	err = checkAvailableFunds(ctx, q, fromAccount, arg.Amount)
+	if err != nil {
+		return err
+	}
 
+	result.Transfer, err = q.CreateTransfer(ctx, arg)
+	if err != nil {
 		return err
+	}
+
+	result.FromEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.FromAccountID,
+		Amount:    -arg.Amount,
+		Memo:      arg.Memo,
+		Reference: arg.Reference,
+		Category:  arg.Category,
 	})
+	if err != nil {
+		return err
+	}
 
-	return result, err
+	result.ToEntry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.ToAccountID,
+		Amount:    arg.ToAmount,
+		Memo:      arg.Memo,
+		Reference: arg.Reference,
+	})
+	if err != nil {
+		return err
+	}
+
+	postings := []PostingParams{
+		{AccountID: fromAccount.ID, Currency: fromAccount.Currency, Amount: -arg.Amount},
+		{AccountID: toAccount.ID, Currency: toAccount.Currency, Amount: arg.ToAmount},
+	}
+	if fromAccount.Currency != toAccount.Currency {
+		postings = append(postings,
+			PostingParams{SystemAccount: util.SystemFXPosition, Currency: fromAccount.Currency, Amount: arg.Amount},
+			PostingParams{SystemAccount: util.SystemFXPosition, Currency: toAccount.Currency, Amount: -arg.ToAmount},
+		)
+	}
+
+	result.Journal, err = postJournal(ctx, q, JournalParams{
+		Kind:       kind,
+		TransferID: sql.NullInt64{Int64: result.Transfer.ID, Valid: true},
+		Postings:   postings,
+	})
+	if err != nil {
+		return err
+	}
+
+	if arg.FromAccountID < arg.ToAccountID {
+		result.FromAccount, result.ToAccount, err = addMoney(ctx, q, arg.FromAccountID, -arg.Amount, arg.ToAccountID, arg.ToAmount)
+	} else {
+		result.ToAccount, result.FromAccount, err = addMoney(ctx, q, arg.ToAccountID, arg.ToAmount, arg.FromAccountID, -arg.Amount)
+	}
+
+	return err
+}
+
+// lockAccounts takes row locks on both accounts in ascending id order,
+// so concurrent transfers in opposite directions cannot deadlock.
+func lockAccounts(ctx context.Context, q Querier, accountID1 int64, accountID2 int64) (account1 Account, account2 Account, err error) {
+	if accountID1 < accountID2 {
+		account1, err = q.GetAccountForUpdate(ctx, accountID1)
+		if err != nil {
+			return
+		}
+		account2, err = q.GetAccountForUpdate(ctx, accountID2)
+		return
+	}
+
+	account2, err = q.GetAccountForUpdate(ctx, accountID2)
+	if err != nil {
+		return
+	}
+	account1, err = q.GetAccountForUpdate(ctx, accountID1)
+	return
 }
 
 func addMoney(
 	ctx context.Context,
-	q *Queries,
+	q Querier,
 	accountID1 int64,
 	amount1 int64,
 	accountID2 int64,
@@ -0,0 +1,219 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+
+	"github.com/JaidenShall/simplebank/fee"
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// TransferBatchLeg is one transfer of a batch, in the currency of the source account
+type TransferBatchLeg struct {
+	ToAccountID int64 `json:"to_account_id"`
+	Amount      int64 `json:"amount"`
+}
+
+// TransferBatchTxParams contains the input parameters of the transfer batch transaction
+type TransferBatchTxParams struct {
+	FromAccountID int64              `json:"from_account_id"`
+	Legs          []TransferBatchLeg `json:"legs"`
+	// Atomic makes the whole batch fail as soon as one leg can't be made.
+	// Otherwise every leg that can be made is, and the others report why they failed.
+	Atomic        bool          `json:"atomic"`
+	NewPayeeLimit NewPayeeLimit `json:"new_payee_limit"`
+	// RiskDecision is the decision of the risk rules allowing the whole batch, recorded with
+	// the total of the legs that are made. It can be nil.
+	RiskDecision *CreateRiskDecisionParams `json:"-"`
+	Idempotency  *IdempotencyParams        `json:"-"`
+}
+
+// TransferBatchLegResult is the outcome of one leg of a transfer batch
+type TransferBatchLegResult struct {
+	Transfer Transfer `json:"transfer"`
+	// Fee is the transfer fee charged for the leg
+	Fee fee.Quote `json:"fee"`
+	// Error is why the leg failed in best-effort mode, empty when the transfer was made
+	Error string `json:"error"`
+}
+
+// TransferBatchTxResult is the result of the transfer batch transaction
+type TransferBatchTxResult struct {
+	FromAccount Account                  `json:"from_account"`
+	Legs        []TransferBatchLegResult `json:"legs"`
+}
+
+// TransferBatchTx makes several transfers from one source account within a database transaction.
+// Every account of the batch is locked up front in ascending id order, so concurrent batches
+// and transfers cannot deadlock. The legs are then made in order, each with its own entries
+// and journal. The caller checks that all accounts share the source account's currency.
+//
+// In atomic mode the source account must be active and cover the total of the legs before
+// any leg is made, and a leg that fails, including for want of its fee, returns a TransferBatchLegError
+// undoing the whole batch.
+// In best-effort mode a leg failing for insufficient funds, an inactive account, a spending
+// limit or the new payee limit is reported in its result and the batch goes on, any other error
+// still undoes the whole batch. Every leg is checked against the spending limits of the source
+// account and arg.NewPayeeLimit and charged the transfer fee like a single transfer, so the free
+// allowance of the month is used up leg by leg and earlier legs count toward the limits of later ones.
+// arg.RiskDecision is recorded once for the batch when at least one leg is made.
+// If arg.Idempotency is set, a replay of the same key returns the original result instead.
+func (store *SQLStore) TransferBatchTx(ctx context.Context, arg TransferBatchTxParams) (TransferBatchTxResult, error) {
+	var result TransferBatchTxResult
+
+	err := store.execTx(ctx, func(q *Queries) error {
+		return execIdempotent(ctx, q, arg.Idempotency, &result, func() error {
+			err := transferBatch(ctx, q, arg, &result)
+			if err != nil {
+				return err
+			}
+			return recordBatchRiskDecision(ctx, q, arg.RiskDecision, result.Legs)
+		})
+	})
+
+	return result, err
+}
+
+func transferBatch(ctx context.Context, q Querier, arg TransferBatchTxParams, result *TransferBatchTxResult) error {
+	accountIDs := []int64{arg.FromAccountID}
+	for _, leg := range arg.Legs {
+		accountIDs = append(accountIDs, leg.ToAccountID)
+	}
+
+	accounts, err := lockAccountSet(ctx, q, accountIDs)
+	if err != nil {
+		return err
+	}
+	result.FromAccount = accounts[arg.FromAccountID]
+
+	if arg.Atomic {
+		err = checkAccountActive(result.FromAccount)
+		if err != nil {
+			return err
+		}
+
+		var total int64
+		for _, leg := range arg.Legs {
+			total += leg.Amount
+		}
+		err = checkAvailableFunds(ctx, q, result.FromAccount, total)
+		if err != nil {
+			return err
+		}
+	}
+
+	result.Legs = make([]TransferBatchLegResult, 0, len(arg.Legs))
+	for i, leg := range arg.Legs {
+		var legResult TransferTxResult
+		err := transferBatchLeg(ctx, q, result.FromAccount, accounts[leg.ToAccountID], leg, arg.NewPayeeLimit, &legResult)
+		if err != nil {
+			if arg.Atomic || !isMoveRejected(err) {
+				return &TransferBatchLegError{Index: i, Err: err}
+			}
+			result.Legs = append(result.Legs, TransferBatchLegResult{Error: err.Error()})
+			continue
+		}
+
+		result.FromAccount = legResult.FromAccount
+		result.Legs = append(result.Legs, TransferBatchLegResult{
+			Transfer: legResult.Transfer,
+			Fee:      legResult.Fee,
+		})
+	}
+
+	return nil
+}
+
+// transferBatchLeg makes one leg of a batch from fromAccount to toAccount, which are already locked,
+// checking the spending limits and the new payee limit and charging the transfer fee like a single transfer
+func transferBatchLeg(
+	ctx context.Context,
+	q Querier,
+	fromAccount Account,
+	toAccount Account,
+	leg TransferBatchLeg,
+	limit NewPayeeLimit,
+	result *TransferTxResult,
+) error {
+	err := checkSpendingLimits(ctx, q, fromAccount, leg.Amount)
+	if err != nil {
+		return err
+	}
+
+	err = checkNewPayeeLimit(ctx, q, fromAccount, toAccount, leg.Amount, limit)
+	if err != nil {
+		return err
+	}
+
+	quote, ok, err := quoteFee(ctx, q, fromAccount, util.FeeTransfer, leg.Amount)
+	if err != nil {
+		return err
+	}
+
+	if quote.Amount > 0 {
+		err = checkAvailableFunds(ctx, q, fromAccount, leg.Amount+quote.Amount)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = moveMoney(ctx, q, JournalTransfer, CreateTransferParams{
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   leg.ToAccountID,
+		Amount:        leg.Amount,
+		ToAmount:      leg.Amount,
+	}, result)
+	if err != nil {
+		return err
+	}
+
+	result.Fee = quote
+	if !ok {
+		return nil
+	}
+
+	result.FeeEntry, result.FromAccount, err = chargeFee(ctx, q, result.FromAccount, util.FeeTransfer,
+		quote, sql.NullInt64{Int64: result.Transfer.ID, Valid: true})
+	return err
+}
+
+// lockAccountSet takes row locks on every account in ascending id order, like lockAccounts,
+// and returns them by id. Ids may repeat.
+func lockAccountSet(ctx context.Context, q Querier, accountIDs []int64) (map[int64]Account, error) {
+	ids := slices.Clone(accountIDs)
+	slices.Sort(ids)
+	ids = slices.Compact(ids)
+
+	accounts := make(map[int64]Account, len(ids))
+	for _, id := range ids {
+		account, err := q.GetAccountForUpdate(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		accounts[id] = account
+	}
+	return accounts, nil
+}
+
+// recordBatchRiskDecision records the decision allowing a batch for the legs that were made,
+// and nothing when none was, so failed legs don't count toward the velocity rule
+func recordBatchRiskDecision(ctx context.Context, q Querier, decision *CreateRiskDecisionParams, legs []TransferBatchLegResult) error {
+	if decision == nil {
+		return nil
+	}
+
+	var made int64
+	for _, leg := range legs {
+		if leg.Error == "" {
+			made += leg.Transfer.Amount
+		}
+	}
+	if made == 0 {
+		return nil
+	}
+
+	arg := *decision
+	arg.Amount = made
+	return recordRiskDecision(ctx, q, &arg, 0, 0)
+}
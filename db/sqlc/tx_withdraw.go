@@ -2,63 +2,135 @@ package db
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
+
+	"github.com/JaidenShall/simplebank/fee"
+	"github.com/JaidenShall/simplebank/util"
 )
 
 type WithdrawTxParams struct {
 	AccountID int64 `json:"account_id"`
 	Amount    int64 `json:"amount"`
+	Annotation
+	// RiskDecision is the decision of the risk rules allowing the movement, recorded along with it.
+	// It can be nil.
+	RiskDecision *CreateRiskDecisionParams `json:"-"`
+	Idempotency  *IdempotencyParams        `json:"-"`
 }
 
 type WithdrawTxResult struct {
 	Account Account `json:"account"`
 	Entry   Entry   `json:"entry"`
+	Journal Journal `json:"journal"`
+	// Fee is the withdrawal fee charged to the account
+	Fee fee.Quote `json:"fee"`
+	// FeeEntry debited the fee, it is empty when nothing was charged
+	FeeEntry Entry `json:"fee_entry"`
 }
 
 // WithdrawTx performs a money withdrawal from an account.
-// It creates an entry and updates the account balance within a database transaction
+// It creates an entry, posts a journal from the account to the cash vault and updates
+// the account balance within a database transaction, then charges the withdrawal fee.
+// It returns ErrInsufficientFunds if the account cannot cover the amount and the fee on top
+// of its active holds, an AccountNotActiveError if the account is frozen or closed and
+// a SpendingLimitError if the amount goes over a spending limit of the account.
+// arg.RiskDecision is only recorded when the withdrawal is made.
+// If arg.Idempotency is set, a replay of the same key returns the original result instead.
 func (store *SQLStore) WithdrawTx(ctx context.Context, arg WithdrawTxParams) (WithdrawTxResult, error) {
 	var result WithdrawTxResult
 
 	err := store.execTx(ctx, func(q *Queries) error {
-		var err error
+		return execIdempotent(ctx, q, arg.Idempotency, &result, func() error {
+			err := withdraw(ctx, q, arg, &result)
+			if err != nil {
+				return err
+			}
+			return recordRiskDecision(ctx, q, arg.RiskDecision, 0, result.Entry.ID)
+		})
+	})
 
-		// Validate amount
-		if arg.Amount <= 0 {
-			return fmt.Errorf("withdrawal amount must be positive")
-		}
+	return result, err
+}
 
-		// Get current account to check balance
-		account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
-		if err != nil {
-			return err
-		}
+// withdraw makes the withdrawal using q, which must already run inside a transaction
+func withdraw(ctx context.Context, q Querier, arg WithdrawTxParams, result *WithdrawTxResult) error {
+	var err error
 
-		// Check sufficient balance
-		if account.Balance < arg.Amount {
-			return fmt.Errorf("insufficient balance: current=%d, requested=%d", account.Balance, arg.Amount)
-		}
+	// Validate amount
+	if arg.Amount <= 0 {
+		return fmt.Errorf("withdrawal amount must be positive")
+	}
 
-		// Create withdrawal entry
-		result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
-			AccountID: arg.AccountID,
-			Amount:    -arg.Amount, // Negative amount for withdrawal
-		})
-		if err != nil {
-			return err
-		}
+	// Get current account to check balance
+	account, err := q.GetAccountForUpdate(ctx, arg.AccountID)
+	if err != nil {
+		return err
+	}
 
-		// Subtract money from account
-		result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
-			ID:     arg.AccountID,
-			Amount: -arg.Amount,
-		})
+	// Only active accounts can be debited
+	err = checkAccountActive(account)
+	if err != nil {
+		return err
+	}
+
+	err = checkSpendingLimits(ctx, q, account, arg.Amount)
+	if err != nil {
+		return err
+	}
+
+	quote, ok, err := quoteFee(ctx, q, account, util.FeeWithdrawal, arg.Amount)
+	if err != nil {
+		return err
+	}
+
+	// Check sufficient balance for the amount and the fee
+	err = checkAvailableFunds(ctx, q, account, arg.Amount+quote.Amount)
+	if err != nil {
+		return err
+	}
+
+	// Create withdrawal entry
+	result.Entry, err = q.CreateEntry(ctx, CreateEntryParams{
+		AccountID: arg.AccountID,
+		Amount:    -arg.Amount, // Negative amount for withdrawal
+		Memo:      arg.Memo,
+		Reference: arg.Reference,
+		Category:  arg.Category,
+	})
+	if err != nil {
+		return err
+	}
+
+	// The money goes back into the cash vault
+	result.Journal, err = postJournal(ctx, q, JournalParams{
+		Kind: JournalWithdrawal,
+		Postings: []PostingParams{
+			{AccountID: account.ID, Currency: account.Currency, Amount: -arg.Amount},
+			{SystemAccount: util.SystemCashVault, Currency: account.Currency, Amount: arg.Amount},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Subtract money from account
+	result.Account, err = q.AddAccountBalance(ctx, AddAccountBalanceParams{
+		ID:     arg.AccountID,
+		Amount: -arg.Amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	result.Fee = quote
+	if ok {
+		result.FeeEntry, result.Account, err = chargeFee(ctx, q, result.Account, util.FeeWithdrawal,
+			quote, sql.NullInt64{})
 		if err != nil {
 			return err
 		}
+	}
 
-		return nil
-	})
-
-	return result, err
+	return nil
 }
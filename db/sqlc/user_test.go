@@ -29,6 +29,7 @@ func createRandomUser(t *testing.T) User {
 	require.Equal(t, arg.HashedPassword, user.HashedPassword)
 	require.Equal(t, arg.FullName, user.FullName)
 	require.Equal(t, arg.Email, user.Email)
+	require.Equal(t, util.CustomerRole, user.Role)
 
 	require.True(t, user.PasswordChangedAt.IsZero())
 	require.NotZero(t, user.CreatedAt)
@@ -148,3 +149,22 @@ func TestUpdateUserAllFields(t *testing.T) {
 	require.NotEqual(t, oldUser.FullName, updatedUser.FullName)
 	require.Equal(t, newFullName, updatedUser.FullName)
 }
+
+func TestSearchUsers(t *testing.T) {
+	user := createRandomUser(t)
+
+	users, err := testQueries.SearchUsers(context.Background(), SearchUsersParams{
+		Pattern:  "%" + user.Email + "%",
+		PageSize: 5,
+	})
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	require.Equal(t, user.Username, users[0].Username)
+
+	users, err = testQueries.SearchUsers(context.Background(), SearchUsersParams{
+		Pattern:  util.RandomString(20),
+		PageSize: 5,
+	})
+	require.NoError(t, err)
+	require.Empty(t, users)
+}
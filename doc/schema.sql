@@ -9,7 +9,8 @@ CREATE TABLE "users" (
   "email" varchar UNIQUE NOT NULL,
   "is_email_verified" bool NOT NULL DEFAULT false,
   "password_changed_at" timestamptz NOT NULL DEFAULT '0001-01-01',
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "role" varchar NOT NULL DEFAULT 'customer'
 );
 
 CREATE TABLE "verify_emails" (
@@ -27,14 +28,20 @@ CREATE TABLE "accounts" (
   "owner" varchar NOT NULL,
   "balance" bigint NOT NULL,
   "currency" varchar NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "overdraft_limit" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "type" varchar NOT NULL DEFAULT 'checking'
 );
 
 CREATE TABLE "entries" (
   "id" bigserial PRIMARY KEY,
   "account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "memo" varchar NOT NULL DEFAULT '',
+  "reference" varchar NOT NULL DEFAULT '',
+  "category" varchar NOT NULL DEFAULT ''
 );
 
 CREATE TABLE "transfers" (
@@ -42,7 +49,15 @@ CREATE TABLE "transfers" (
   "from_account_id" bigint NOT NULL,
   "to_account_id" bigint NOT NULL,
   "amount" bigint NOT NULL,
-  "created_at" timestamptz NOT NULL DEFAULT (now())
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "to_amount" bigint NOT NULL,
+  "fx_rate" bigint,
+  "fx_quote_id" uuid,
+  "reversed_transfer_id" bigint,
+  "refunded_amount" bigint NOT NULL DEFAULT 0,
+  "memo" varchar NOT NULL DEFAULT '',
+  "reference" varchar NOT NULL DEFAULT '',
+  "category" varchar NOT NULL DEFAULT ''
 );
 
 CREATE TABLE "sessions" (
@@ -53,25 +68,489 @@ CREATE TABLE "sessions" (
   "client_ip" varchar NOT NULL,
   "is_blocked" boolean NOT NULL DEFAULT false,
   "expires_at" timestamptz NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "family_id" uuid NOT NULL,
+  "consumed_at" timestamptz
+);
+
+CREATE TABLE "idempotency_keys" (
+  "username" varchar NOT NULL,
+  "idempotency_key" varchar NOT NULL,
+  "request_hash" varchar NOT NULL,
+  "response" jsonb NOT NULL DEFAULT '{}',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("username", "idempotency_key")
+);
+
+CREATE TABLE "audit_logs" (
+  "id" bigserial PRIMARY KEY,
+  "actor" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "target" varchar NOT NULL,
+  "details" jsonb NOT NULL DEFAULT '{}',
+  "outcome" varchar NOT NULL DEFAULT 'succeeded',
+  "error" varchar NOT NULL DEFAULT '',
   "created_at" timestamptz NOT NULL DEFAULT (now())
 );
 
 CREATE INDEX ON "accounts" ("owner");
 
-CREATE UNIQUE INDEX ON "accounts" ("owner", "currency");
+CREATE UNIQUE INDEX ON "accounts" ("owner", "currency", "type");
 
 CREATE INDEX ON "entries" ("account_id");
 
+CREATE INDEX ON "entries" USING GIN (to_tsvector('simple', "memo"));
+
+CREATE INDEX ON "entries" ("reference");
+
+CREATE INDEX ON "entries" ("account_id", "created_at");
+
 CREATE INDEX ON "transfers" ("from_account_id");
 
 CREATE INDEX ON "transfers" ("to_account_id");
 
 CREATE INDEX ON "transfers" ("from_account_id", "to_account_id");
 
+CREATE UNIQUE INDEX ON "transfers" ("fx_quote_id");
+
+CREATE INDEX ON "transfers" ("reversed_transfer_id");
+
+CREATE INDEX ON "transfers" USING GIN (to_tsvector('simple', "memo"));
+
+CREATE INDEX ON "transfers" ("reference");
+
+CREATE TABLE "fx_rates" (
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "rate" bigint NOT NULL,
+  "updated_by" varchar NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("from_currency", "to_currency")
+);
+
+CREATE TABLE "fx_quotes" (
+  "id" uuid PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "from_currency" varchar NOT NULL,
+  "to_currency" varchar NOT NULL,
+  "rate" bigint NOT NULL,
+  "expires_at" timestamptz NOT NULL,
+  "used_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "system_accounts" (
+  "id" bigserial PRIMARY KEY,
+  "code" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "journals" (
+  "id" bigserial PRIMARY KEY,
+  "kind" varchar NOT NULL,
+  "transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "postings" (
+  "id" bigserial PRIMARY KEY,
+  "journal_id" bigint NOT NULL,
+  "account_id" bigint,
+  "system_account_id" bigint,
+  "currency" varchar NOT NULL,
+  "amount" bigint NOT NULL
+);
+
+CREATE TABLE "holds" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "captured_amount" bigint NOT NULL DEFAULT 0,
+  "status" varchar NOT NULL DEFAULT 'active',
+  "entry_id" bigint,
+  "expires_at" timestamptz NOT NULL,
+  "settled_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfers" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "from_account_id" bigint NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "frequency" varchar NOT NULL,
+  "interval_count" int NOT NULL DEFAULT 1,
+  "day_of_month" int NOT NULL DEFAULT 0,
+  "start_at" timestamptz NOT NULL,
+  "end_at" timestamptz,
+  "next_occurrence_at" timestamptz,
+  "next_run_at" timestamptz,
+  "attempts" int NOT NULL DEFAULT 0,
+  "failure_policy" varchar NOT NULL DEFAULT 'skip',
+  "status" varchar NOT NULL DEFAULT 'active',
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "scheduled_transfer_runs" (
+  "id" bigserial PRIMARY KEY,
+  "scheduled_transfer_id" bigint NOT NULL,
+  "occurrence_at" timestamptz NOT NULL,
+  "attempt" int NOT NULL,
+  "status" varchar NOT NULL,
+  "transfer_id" bigint,
+  "error" varchar NOT NULL DEFAULT '',
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "balance_snapshots" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "snapshot_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "entry_sum" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "balance_discrepancies" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "snapshot_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "entry_sum" bigint NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "interest_accruals" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "accrual_date" date NOT NULL,
+  "balance" bigint NOT NULL,
+  "rate" int NOT NULL,
+  "amount" bigint NOT NULL,
+  "remainder" bigint NOT NULL,
+  "entry_id" bigint,
+  "capitalized_at" timestamptz,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "fee_schedules" (
+  "operation" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "flat_amount" bigint NOT NULL DEFAULT 0,
+  "rate" int NOT NULL DEFAULT 0,
+  "min_amount" bigint NOT NULL DEFAULT 0,
+  "max_amount" bigint NOT NULL DEFAULT 0,
+  "free_per_month" int NOT NULL DEFAULT 0,
+  "updated_by" varchar NOT NULL,
+  "updated_at" timestamptz NOT NULL DEFAULT (now()),
+  PRIMARY KEY ("operation", "currency")
+);
+
+CREATE TABLE "fees" (
+  "id" bigserial PRIMARY KEY,
+  "account_id" bigint NOT NULL,
+  "operation" varchar NOT NULL,
+  "amount" bigint NOT NULL,
+  "transfer_id" bigint,
+  "entry_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "spending_limits" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "currency" varchar NOT NULL,
+  "account_id" bigint,
+  "per_transaction_limit" bigint NOT NULL DEFAULT 0,
+  "daily_limit" bigint NOT NULL DEFAULT 0,
+  "monthly_limit" bigint NOT NULL DEFAULT 0,
+  "pending_per_transaction_limit" bigint,
+  "pending_daily_limit" bigint,
+  "pending_monthly_limit" bigint,
+  "pending_effective_at" timestamptz,
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "risk_decisions" (
+  "id" bigserial PRIMARY KEY,
+  "username" varchar NOT NULL,
+  "operation" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "to_account_id" bigint,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "action" varchar NOT NULL,
+  "rules" varchar[] NOT NULL DEFAULT '{}',
+  "request" jsonb NOT NULL DEFAULT '{}',
+  "user_agent" varchar NOT NULL,
+  "client_ip" varchar NOT NULL,
+  "idempotency_key" varchar,
+  "request_hash" varchar,
+  "review_status" varchar,
+  "reviewed_by" varchar,
+  "reviewed_at" timestamptz,
+  "transfer_id" bigint,
+  "entry_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "payees" (
+  "id" bigserial PRIMARY KEY,
+  "owner" varchar NOT NULL,
+  "account_id" bigint NOT NULL,
+  "nickname" varchar NOT NULL,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE TABLE "payment_requests" (
+  "id" bigserial PRIMARY KEY,
+  "requester" varchar NOT NULL,
+  "payer" varchar NOT NULL,
+  "to_account_id" bigint NOT NULL,
+  "amount" bigint NOT NULL,
+  "currency" varchar NOT NULL,
+  "memo" varchar NOT NULL DEFAULT '',
+  "status" varchar NOT NULL DEFAULT 'pending',
+  "expires_at" timestamptz NOT NULL,
+  "transfer_id" bigint,
+  "created_at" timestamptz NOT NULL DEFAULT (now()),
+  "updated_at" timestamptz NOT NULL DEFAULT (now())
+);
+
+CREATE INDEX ON "sessions" ("family_id");
+
+CREATE INDEX ON "sessions" ("username", "user_agent");
+
+CREATE INDEX ON "audit_logs" ("actor");
+
+CREATE INDEX ON "audit_logs" ("target");
+
+CREATE INDEX ON "fx_quotes" ("username");
+
+CREATE UNIQUE INDEX ON "system_accounts" ("code", "currency");
+
+CREATE INDEX ON "journals" ("transfer_id");
+
+CREATE INDEX ON "postings" ("journal_id");
+
+CREATE INDEX ON "postings" ("account_id");
+
+CREATE INDEX ON "postings" ("system_account_id");
+
+CREATE INDEX ON "holds" ("account_id", "status");
+
+CREATE INDEX ON "holds" ("expires_at");
+
+CREATE INDEX ON "scheduled_transfers" ("owner");
+
+CREATE INDEX ON "scheduled_transfers" ("next_run_at");
+
+CREATE INDEX ON "scheduled_transfer_runs" ("scheduled_transfer_id");
+
+CREATE UNIQUE INDEX ON "balance_snapshots" ("account_id", "snapshot_date");
+
+CREATE INDEX ON "balance_snapshots" ("snapshot_date");
+
+CREATE UNIQUE INDEX ON "balance_discrepancies" ("account_id", "snapshot_date");
+
+CREATE INDEX ON "balance_discrepancies" ("snapshot_date");
+
+CREATE UNIQUE INDEX ON "interest_accruals" ("account_id", "accrual_date");
+
+CREATE INDEX ON "interest_accruals" ("accrual_date");
+
+CREATE INDEX ON "fees" ("account_id", "operation", "created_at");
+
+CREATE UNIQUE INDEX ON "spending_limits" ("username", "currency");
+
+CREATE UNIQUE INDEX ON "spending_limits" ("account_id");
+
+CREATE INDEX ON "risk_decisions" ("username", "created_at");
+
+CREATE INDEX ON "risk_decisions" ("review_status", "id");
+
+CREATE UNIQUE INDEX ON "risk_decisions" ("username", "idempotency_key");
+
+CREATE UNIQUE INDEX ON "payees" ("owner", "account_id");
+
+CREATE UNIQUE INDEX ON "payees" ("owner", "nickname");
+
+CREATE INDEX ON "payment_requests" ("payer", "id");
+
+CREATE INDEX ON "payment_requests" ("requester", "id");
+
+CREATE INDEX ON "payment_requests" ("expires_at");
+
+COMMENT ON COLUMN "users"."role" IS 'customer, support or admin';
+
+COMMENT ON COLUMN "accounts"."overdraft_limit" IS 'how far below zero the balance may go, 0 disables overdraft';
+
+COMMENT ON COLUMN "accounts"."status" IS 'active, frozen or closed; only active accounts can send or receive money';
+
+COMMENT ON COLUMN "accounts"."type" IS 'checking or savings; savings accounts earn interest';
+
 COMMENT ON COLUMN "entries"."amount" IS 'can be negative or positive';
 
+COMMENT ON COLUMN "entries"."memo" IS 'free text shown on statements, searchable';
+
+COMMENT ON COLUMN "entries"."reference" IS 'external reference such as an invoice number';
+
+COMMENT ON COLUMN "entries"."category" IS 'category assigned by the account owner, transfers only set it on the debited entry';
+
 COMMENT ON COLUMN "transfers"."amount" IS 'must be positive';
 
+COMMENT ON COLUMN "transfers"."to_amount" IS 'amount credited to the destination account, differs from amount only for fx transfers';
+
+COMMENT ON COLUMN "transfers"."fx_rate" IS 'rate applied to amount to get to_amount, scaled by 10^8';
+
+COMMENT ON COLUMN "transfers"."reversed_transfer_id" IS 'the transfer this one refunds or reverses';
+
+COMMENT ON COLUMN "transfers"."refunded_amount" IS 'part of to_amount sent back by refunds and reversals so far';
+
+COMMENT ON COLUMN "transfers"."memo" IS 'free text shown on statements, searchable';
+
+COMMENT ON COLUMN "transfers"."reference" IS 'external reference such as an invoice number';
+
+COMMENT ON COLUMN "transfers"."category" IS 'category assigned by the payer';
+
+COMMENT ON COLUMN "sessions"."family_id" IS 'id of the login session the refresh token was rotated from';
+
+COMMENT ON COLUMN "sessions"."consumed_at" IS 'set once the refresh token has been exchanged for a new one';
+
+COMMENT ON COLUMN "idempotency_keys"."request_hash" IS 'fingerprint of the request the key was first used with';
+
+COMMENT ON COLUMN "audit_logs"."actor" IS 'username of the support or admin user who performed the action';
+
+COMMENT ON COLUMN "audit_logs"."target" IS 'what the action was performed on, such as account:42 or user:alice';
+
+COMMENT ON COLUMN "audit_logs"."details" IS 'the request that triggered the action';
+
+COMMENT ON COLUMN "audit_logs"."outcome" IS 'succeeded, failed or denied';
+
+COMMENT ON COLUMN "audit_logs"."error" IS 'why the action failed or was denied, empty when it succeeded';
+
+COMMENT ON COLUMN "fx_rates"."rate" IS 'units of to_currency per unit of from_currency, scaled by 10^8';
+
+COMMENT ON COLUMN "fx_quotes"."rate" IS 'the fx_rates rate at the time of the quote';
+
+COMMENT ON COLUMN "fx_quotes"."used_at" IS 'set once a transfer has been made with the quote';
+
+COMMENT ON COLUMN "system_accounts"."code" IS 'cash_vault, fees_income, fx_position, interest_expense or suspense';
+
+COMMENT ON COLUMN "journals"."kind" IS 'what the journal records, such as deposit, withdrawal or transfer';
+
+COMMENT ON COLUMN "postings"."account_id" IS 'set for customer accounts, exactly one of account_id and system_account_id is set';
+
+COMMENT ON COLUMN "postings"."amount" IS 'added to the account balance; the postings of a journal sum to zero per currency';
+
+COMMENT ON COLUMN "holds"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "holds"."captured_amount" IS 'part of amount that was settled, the rest went back to the available balance';
+
+COMMENT ON COLUMN "holds"."status" IS 'active, captured, released or expired; only active holds reduce the available balance';
+
+COMMENT ON COLUMN "holds"."entry_id" IS 'the entry that debited the account when the hold was captured';
+
+COMMENT ON COLUMN "scheduled_transfers"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "scheduled_transfers"."frequency" IS 'once, daily, weekly or monthly';
+
+COMMENT ON COLUMN "scheduled_transfers"."interval_count" IS 'number of days, weeks or months between occurrences';
+
+COMMENT ON COLUMN "scheduled_transfers"."day_of_month" IS 'day monthly transfers run on, -1 for the last day of the month, 0 otherwise';
+
+COMMENT ON COLUMN "scheduled_transfers"."next_occurrence_at" IS 'the occurrence the next run is for, null once the schedule has ended';
+
+COMMENT ON COLUMN "scheduled_transfers"."next_run_at" IS 'when the next run is due, later than next_occurrence_at while retrying';
+
+COMMENT ON COLUMN "scheduled_transfers"."attempts" IS 'failed runs of the next occurrence';
+
+COMMENT ON COLUMN "scheduled_transfers"."failure_policy" IS 'skip or retry an occurrence that fails for insufficient funds or an inactive account';
+
+COMMENT ON COLUMN "scheduled_transfers"."status" IS 'active, paused, completed or cancelled';
+
+COMMENT ON COLUMN "scheduled_transfer_runs"."status" IS 'succeeded, retrying, skipped or failed';
+
+COMMENT ON COLUMN "scheduled_transfer_runs"."error" IS 'why the transfer could not be made';
+
+COMMENT ON COLUMN "balance_snapshots"."snapshot_date" IS 'the UTC day the snapshot closes';
+
+COMMENT ON COLUMN "balance_snapshots"."balance" IS 'accounts.balance at the end of the day';
+
+COMMENT ON COLUMN "balance_snapshots"."entry_sum" IS 'sum of the entries of the account created up to the end of the day';
+
+COMMENT ON COLUMN "balance_discrepancies"."balance" IS 'accounts.balance at the end of the day';
+
+COMMENT ON COLUMN "balance_discrepancies"."entry_sum" IS 'sum of the entries of the account, different from balance';
+
+COMMENT ON COLUMN "interest_accruals"."balance" IS 'balance of the daily snapshot the interest is computed on';
+
+COMMENT ON COLUMN "interest_accruals"."rate" IS 'annual rate in basis points';
+
+COMMENT ON COLUMN "interest_accruals"."amount" IS 'whole units of interest earned on the day';
+
+COMMENT ON COLUMN "interest_accruals"."remainder" IS 'fraction of a unit carried to the next day, in units of 1/3650000';
+
+COMMENT ON COLUMN "interest_accruals"."entry_id" IS 'the interest entry the amount was capitalized with, null while accruing or when the month earned nothing';
+
+COMMENT ON COLUMN "interest_accruals"."capitalized_at" IS 'null until the amount is capitalized';
+
+COMMENT ON COLUMN "fee_schedules"."operation" IS 'transfer or withdrawal';
+
+COMMENT ON COLUMN "fee_schedules"."rate" IS 'percentage of the amount in basis points, rounded half up';
+
+COMMENT ON COLUMN "fee_schedules"."max_amount" IS 'caps the fee, 0 leaves it uncapped';
+
+COMMENT ON COLUMN "fee_schedules"."free_per_month" IS 'operations of an account that are free every calendar month';
+
+COMMENT ON COLUMN "fees"."amount" IS 'charged to the account, 0 for operations in the free allowance';
+
+COMMENT ON COLUMN "fees"."entry_id" IS 'the entry that debited the fee, null when nothing was charged';
+
+COMMENT ON COLUMN "spending_limits"."account_id" IS 'null for the limits of all the accounts of the user in the currency';
+
+COMMENT ON COLUMN "spending_limits"."per_transaction_limit" IS 'limits of outbound money in minor units, 0 is no limit';
+
+COMMENT ON COLUMN "spending_limits"."daily_limit" IS 'over the last 24 hours';
+
+COMMENT ON COLUMN "spending_limits"."monthly_limit" IS 'over the last 30 days';
+
+COMMENT ON COLUMN "spending_limits"."pending_effective_at" IS 'when the pending limits replace the current ones, increases wait for a cooling-off period';
+
+COMMENT ON COLUMN "risk_decisions"."action" IS 'allow, review or deny, the strictest action of the matched rules. Allowed decisions are only kept with the movement they allowed';
+
+COMMENT ON COLUMN "risk_decisions"."rules" IS 'names of the rules that matched';
+
+COMMENT ON COLUMN "risk_decisions"."request" IS 'the money movement to make once a review is approved';
+
+COMMENT ON COLUMN "risk_decisions"."idempotency_key" IS 'key the client sent, the approved movement is recorded under it';
+
+COMMENT ON COLUMN "risk_decisions"."review_status" IS 'pending, approved or rejected, null unless the action is review';
+
+COMMENT ON COLUMN "risk_decisions"."transfer_id" IS 'the transfer made once allowed or once the review was approved';
+
+COMMENT ON COLUMN "risk_decisions"."entry_id" IS 'the entry of the withdrawal, deposit or hold capture made once allowed or once the review was approved';
+
+COMMENT ON COLUMN "payees"."owner" IS 'the user who saved the payee, not the owner of the account';
+
+COMMENT ON COLUMN "payees"."nickname" IS 'unique among the payees of the owner';
+
+COMMENT ON COLUMN "payment_requests"."requester" IS 'the user asking to be paid';
+
+COMMENT ON COLUMN "payment_requests"."payer" IS 'the user asked to pay';
+
+COMMENT ON COLUMN "payment_requests"."to_account_id" IS 'account of the requester the money is paid into';
+
+COMMENT ON COLUMN "payment_requests"."amount" IS 'must be positive';
+
+COMMENT ON COLUMN "payment_requests"."status" IS 'pending, accepted, declined or expired';
+
+COMMENT ON COLUMN "payment_requests"."transfer_id" IS 'the transfer that paid the request, set once accepted';
+
 ALTER TABLE "verify_emails" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
 
 ALTER TABLE "accounts" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
@@ -83,3 +562,81 @@ ALTER TABLE "transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts
 ALTER TABLE "transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
 
 ALTER TABLE "sessions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "idempotency_keys" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "audit_logs" ADD FOREIGN KEY ("actor") REFERENCES "users" ("username");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("fx_quote_id") REFERENCES "fx_quotes" ("id");
+
+ALTER TABLE "transfers" ADD FOREIGN KEY ("reversed_transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "fx_rates" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "fx_quotes" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "journals" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "postings" ADD FOREIGN KEY ("journal_id") REFERENCES "journals" ("id");
+
+ALTER TABLE "postings" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "postings" ADD FOREIGN KEY ("system_account_id") REFERENCES "system_accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "holds" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("from_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfers" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "scheduled_transfer_runs" ADD FOREIGN KEY ("scheduled_transfer_id") REFERENCES "scheduled_transfers" ("id");
+
+ALTER TABLE "scheduled_transfer_runs" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "balance_snapshots" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "balance_discrepancies" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "interest_accruals" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "fee_schedules" ADD FOREIGN KEY ("updated_by") REFERENCES "users" ("username");
+
+ALTER TABLE "fees" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "fees" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "fees" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "spending_limits" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "spending_limits" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("username") REFERENCES "users" ("username");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("reviewed_by") REFERENCES "users" ("username");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
+
+ALTER TABLE "risk_decisions" ADD FOREIGN KEY ("entry_id") REFERENCES "entries" ("id");
+
+ALTER TABLE "payees" ADD FOREIGN KEY ("owner") REFERENCES "users" ("username");
+
+ALTER TABLE "payees" ADD FOREIGN KEY ("account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("requester") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("payer") REFERENCES "users" ("username");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("to_account_id") REFERENCES "accounts" ("id");
+
+ALTER TABLE "payment_requests" ADD FOREIGN KEY ("transfer_id") REFERENCES "transfers" ("id");
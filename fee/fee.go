@@ -0,0 +1,89 @@
+// Package fee computes the fees charged on money movements from a fee schedule:
+// a flat amount plus a percentage of the amount moved, kept between a minimum and a maximum,
+// and waived for the first operations of every month.
+package fee
+
+import (
+	"errors"
+	"math/big"
+)
+
+// RateScale is the scale of percentage rates: a rate of 150 is 1.5% of the amount
+const RateScale = 10_000
+
+// Schedule is how the fee of one kind of operation in one currency is computed.
+// Amounts are in minor units of the currency.
+type Schedule struct {
+	Flat int64 `json:"flat"`
+	// Rate is the percentage of the amount, scaled by RateScale
+	Rate int32 `json:"rate"`
+	Min  int64 `json:"min"`
+	// Max caps the fee, 0 leaves it uncapped
+	Max int64 `json:"max"`
+	// FreePerMonth is how many operations of an account are free every calendar month
+	FreePerMonth int32 `json:"free_per_month"`
+}
+
+// Quote is the fee of one operation
+type Quote struct {
+	// Amount is what the operation is charged, 0 when it is free
+	Amount int64 `json:"amount"`
+	// Flat and Percentage are the parts of the fee before the minimum and maximum apply
+	Flat       int64 `json:"flat"`
+	Percentage int64 `json:"percentage"`
+	// Free is true when the operation was covered by the free allowance of the month
+	Free bool `json:"free"`
+	// FreeRemaining is how many free operations are left in the month after this one
+	FreeRemaining int32 `json:"free_remaining"`
+}
+
+// Validate reports whether the schedule can compute fees
+func (schedule Schedule) Validate() error {
+	if schedule.Flat < 0 || schedule.Min < 0 || schedule.Max < 0 {
+		return errors.New("fee amounts must not be negative")
+	}
+	if schedule.Rate < 0 || schedule.Rate > RateScale {
+		return errors.New("rate must be between 0 and 100%")
+	}
+	if schedule.Max != 0 && schedule.Max < schedule.Min {
+		return errors.New("maximum must not be less than the minimum")
+	}
+	if schedule.FreePerMonth < 0 {
+		return errors.New("free operations per month must not be negative")
+	}
+	return nil
+}
+
+// Calculate returns the fee of an operation moving amount, when the account already made
+// used operations of the same kind this month. The percentage is rounded half up to the
+// minor unit. A schedule is expected to be valid and amount not to be negative.
+func Calculate(schedule Schedule, amount int64, used int64) Quote {
+	if used < int64(schedule.FreePerMonth) {
+		return Quote{
+			Free:          true,
+			FreeRemaining: schedule.FreePerMonth - int32(used) - 1,
+		}
+	}
+
+	quote := Quote{
+		Flat:       schedule.Flat,
+		Percentage: percentage(amount, schedule.Rate),
+	}
+
+	quote.Amount = quote.Flat + quote.Percentage
+	if quote.Amount < schedule.Min {
+		quote.Amount = schedule.Min
+	}
+	if schedule.Max != 0 && quote.Amount > schedule.Max {
+		quote.Amount = schedule.Max
+	}
+	return quote
+}
+
+// percentage returns amount * rate / RateScale rounded half up, which never exceeds amount
+func percentage(amount int64, rate int32) int64 {
+	part := new(big.Int).Mul(big.NewInt(amount), big.NewInt(int64(rate)))
+	part.Add(part, big.NewInt(RateScale/2))
+	part.Quo(part, big.NewInt(RateScale))
+	return part.Int64()
+}
@@ -0,0 +1,69 @@
+package fee
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalculate(t *testing.T) {
+	schedule := Schedule{Flat: 25, Rate: 150, Min: 50, Max: 1000, FreePerMonth: 2}
+
+	testCases := []struct {
+		name     string
+		schedule Schedule
+		amount   int64
+		used     int64
+		quote    Quote
+	}{
+		{name: "FirstFree", schedule: schedule, amount: 10_000, quote: Quote{Free: true, FreeRemaining: 1}},
+		{name: "LastFree", schedule: schedule, amount: 10_000, used: 1, quote: Quote{Free: true}},
+		{name: "FlatAndPercentage", schedule: schedule, amount: 10_000, used: 2, quote: Quote{Amount: 175, Flat: 25, Percentage: 150}},
+		{name: "Minimum", schedule: schedule, amount: 100, used: 2, quote: Quote{Amount: 50, Flat: 25, Percentage: 2}},
+		{name: "Maximum", schedule: schedule, amount: 1_000_000, used: 5, quote: Quote{Amount: 1000, Flat: 25, Percentage: 15_000}},
+		{name: "RoundHalfUp", schedule: Schedule{Rate: 150}, amount: 100, quote: Quote{Amount: 2, Percentage: 2}},
+		{name: "RoundDown", schedule: Schedule{Rate: 140}, amount: 100, quote: Quote{Amount: 1, Percentage: 1}},
+		{name: "Uncapped", schedule: Schedule{Rate: 100}, amount: 1_000_000, quote: Quote{Amount: 10_000, Percentage: 10_000}},
+		{name: "NoSchedule", amount: 1_000_000},
+		{
+			name:     "NoOverflow",
+			schedule: Schedule{Rate: RateScale},
+			amount:   9_000_000_000_000_000_000,
+			quote:    Quote{Amount: 9_000_000_000_000_000_000, Percentage: 9_000_000_000_000_000_000},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.quote, Calculate(tc.schedule, tc.amount, tc.used))
+		})
+	}
+}
+
+func TestScheduleValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		schedule Schedule
+		valid    bool
+	}{
+		{name: "OK", schedule: Schedule{Flat: 25, Rate: 150, Min: 50, Max: 1000, FreePerMonth: 2}, valid: true},
+		{name: "Empty", valid: true},
+		{name: "Uncapped", schedule: Schedule{Min: 50}, valid: true},
+		{name: "NegativeFlat", schedule: Schedule{Flat: -1}},
+		{name: "NegativeRate", schedule: Schedule{Rate: -1}},
+		{name: "RateAboveAmount", schedule: Schedule{Rate: RateScale + 1}},
+		{name: "MaxBelowMin", schedule: Schedule{Min: 50, Max: 10}},
+		{name: "NegativeFree", schedule: Schedule{FreePerMonth: -1}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.schedule.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
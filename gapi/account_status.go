@@ -0,0 +1,26 @@
+package gapi
+
+import (
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// accountStatusError converts an error returned while changing the status of an account
+func accountStatusError(err error) error {
+	var notActiveErr *db.AccountNotActiveError
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "account not found")
+	case errors.As(err, &notActiveErr),
+		errors.Is(err, db.ErrAccountNotEmpty),
+		errors.Is(err, db.ErrInterestPending),
+		errors.Is(err, db.ErrAccountInUse),
+		errors.Is(err, db.ErrAccountNotClosed):
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+	return status.Errorf(codes.Internal, "failed to change account status: %s", err)
+}
@@ -0,0 +1,46 @@
+package gapi
+
+import (
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// annotatedRequest is implemented by the requests moving money, which can carry
+// a memo, an external reference and a category
+type annotatedRequest interface {
+	GetMemo() string
+	GetReference() string
+	GetCategory() string
+}
+
+// validateAnnotation checks the annotation fields that are set, all of them are optional
+func validateAnnotation(req annotatedRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetMemo() != "" {
+		if err := val.ValidateMemo(req.GetMemo()); err != nil {
+			violations = append(violations, fieldViolation("memo", err))
+		}
+	}
+
+	if req.GetReference() != "" {
+		if err := val.ValidateReference(req.GetReference()); err != nil {
+			violations = append(violations, fieldViolation("reference", err))
+		}
+	}
+
+	if req.GetCategory() != "" {
+		if err := val.ValidateCategory(req.GetCategory()); err != nil {
+			violations = append(violations, fieldViolation("category", err))
+		}
+	}
+
+	return violations
+}
+
+func convertAnnotation(req annotatedRequest) db.Annotation {
+	return db.Annotation{
+		Memo:      req.GetMemo(),
+		Reference: req.GetReference(),
+		Category:  req.GetCategory(),
+	}
+}
@@ -0,0 +1,125 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// Actions recorded in the audit log
+const (
+	auditSearchUsers        = "search_users"
+	auditGetAccount         = "get_account"
+	auditListAccountEntries = "list_account_entries"
+	auditFreezeAccount      = "freeze_account"
+	auditUnfreezeAccount    = "unfreeze_account"
+	auditReopenAccount      = "reopen_account"
+	auditBlockSessions      = "block_sessions"
+	auditSetFxRate          = "set_fx_rate"
+	auditGetTrialBalance    = "get_trial_balance"
+	auditReverseTransfer    = "reverse_transfer"
+	auditSetFeeSchedule     = "set_fee_schedule"
+	auditListRiskReviews    = "list_risk_reviews"
+	auditApproveRiskReview  = "approve_risk_review"
+	auditRejectRiskReview   = "reject_risk_review"
+	// auditCallMethod records a staff method called by someone without the role for it
+	auditCallMethod = "call_method"
+)
+
+func accountTarget(accountID int64) string {
+	return fmt.Sprintf("account:%d", accountID)
+}
+
+func userTarget(username string) string {
+	return fmt.Sprintf("user:%s", username)
+}
+
+func transferTarget(transferID int64) string {
+	return fmt.Sprintf("transfer:%d", transferID)
+}
+
+func fxRateTarget(fromCurrency string, toCurrency string) string {
+	return fmt.Sprintf("fx_rate:%s/%s", fromCurrency, toCurrency)
+}
+
+func feeScheduleTarget(operation string, currency string) string {
+	return fmt.Sprintf("fee_schedule:%s/%s", operation, currency)
+}
+
+func riskReviewTarget(decisionID int64) string {
+	return fmt.Sprintf("risk_review:%d", decisionID)
+}
+
+func methodTarget(method string) string {
+	return fmt.Sprintf("method:%s", method)
+}
+
+// audit runs an AdminService action inside a transaction that also records it in the
+// audit log together with the request. Errors returned by action are passed through
+// unchanged so the caller can map them to a status.
+func (server *Server) audit(
+	ctx context.Context,
+	actor *token.Payload,
+	action string,
+	target string,
+	req proto.Message,
+	fn func(q db.Querier) error,
+) error {
+	details, err := protojson.Marshal(req)
+	if err != nil {
+		return fmt.Errorf("cannot encode audit details: %w", err)
+	}
+
+	_, err = server.store.AuditTx(ctx, db.AuditTxParams{
+		CreateAuditLogParams: db.CreateAuditLogParams{
+			Actor:   actor.Username,
+			Action:  action,
+			Target:  target,
+			Details: details,
+		},
+		Run: fn,
+	})
+	return err
+}
+
+// auditDenied records a call to an AdminService method that methodPolicies refused to an
+// authenticated user. Failing to record it is only logged, the call is denied either way.
+func (server *Server) auditDenied(ctx context.Context, method string, req interface{}, denial error) {
+	if !strings.HasPrefix(method, "/"+pb.AdminService_ServiceDesc.ServiceName+"/") {
+		return
+	}
+
+	// without a valid token there is no user to record
+	payload, err := server.authenticate(ctx)
+	if err != nil {
+		return
+	}
+
+	details := []byte("{}")
+	if message, ok := req.(proto.Message); ok {
+		if encoded, err := protojson.Marshal(message); err == nil {
+			details = encoded
+		}
+	}
+
+	_, err = server.store.CreateAuditLog(ctx, db.CreateAuditLogParams{
+		Actor:   payload.Username,
+		Action:  auditCallMethod,
+		Target:  methodTarget(method),
+		Details: details,
+		Outcome: util.AuditDenied,
+		Error:   status.Convert(denial).Message(),
+	})
+	if err != nil {
+		log.Error().Err(err).Str("method", method).Msg("failed to record denied call in the audit log")
+	}
+}
@@ -14,7 +14,17 @@ const (
 	authorizationBearer = "bearer"
 )
 
+// authorizeUser returns the payload injected by AuthInterceptor, or authenticates
+// the request itself when the handler is called without the interceptor.
 func (server *Server) authorizeUser(ctx context.Context) (*token.Payload, error) {
+	if payload, ok := payloadFromContext(ctx); ok {
+		return payload, nil
+	}
+	return server.authenticate(ctx)
+}
+
+// authenticate verifies the bearer access token in the request metadata
+func (server *Server) authenticate(ctx context.Context) (*token.Payload, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return nil, fmt.Errorf("missing metadata")
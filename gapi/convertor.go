@@ -1,8 +1,12 @@
 package gapi
 
 import (
+	"time"
+
 	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/fee"
 	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -13,26 +17,53 @@ func convertUser(user db.User) *pb.User {
 		Email:             user.Email,
 		PasswordChangedAt: timestamppb.New(user.PasswordChangedAt),
 		CreatedAt:         timestamppb.New(user.CreatedAt),
+		Role:              user.Role,
 	}
 }
 
 func convertAccount(account db.Account) *pb.Account {
 	return &pb.Account{
-		Id:        account.ID,
-		Owner:     account.Owner,
-		Balance:   account.Balance,
-		Currency:  account.Currency,
-		CreatedAt: timestamppb.New(account.CreatedAt),
+		Id:             account.ID,
+		Owner:          account.Owner,
+		Balance:        account.Balance,
+		Currency:       account.Currency,
+		CreatedAt:      timestamppb.New(account.CreatedAt),
+		OverdraftLimit: account.OverdraftLimit,
+		Status:         account.Status,
+		Type:           account.Type,
 	}
 }
 
 func convertTransfer(transfer db.Transfer) *pb.Transfer {
-	return &pb.Transfer{
-		Id:            transfer.ID,
-		FromAccountId: transfer.FromAccountID,
-		ToAccountId:   transfer.ToAccountID,
-		Amount:        transfer.Amount,
-		CreatedAt:     timestamppb.New(transfer.CreatedAt),
+	rsp := &pb.Transfer{
+		Id:                 transfer.ID,
+		FromAccountId:      transfer.FromAccountID,
+		ToAccountId:        transfer.ToAccountID,
+		Amount:             transfer.Amount,
+		CreatedAt:          timestamppb.New(transfer.CreatedAt),
+		ToAmount:           transfer.ToAmount,
+		ReversedTransferId: transfer.ReversedTransferID.Int64,
+		RefundedAmount:     transfer.RefundedAmount,
+		Memo:               transfer.Memo,
+		Reference:          transfer.Reference,
+		Category:           transfer.Category,
+	}
+	if transfer.FxRate.Valid {
+		rsp.FxRate = util.FormatFXRate(transfer.FxRate.Int64)
+	}
+	if transfer.FxQuoteID.Valid {
+		rsp.FxQuoteId = transfer.FxQuoteID.UUID.String()
+	}
+	return rsp
+}
+
+func convertTransferBatchLegResult(leg db.TransferBatchLegResult, currency string) *pb.TransferBatchLegResult {
+	if leg.Error != "" {
+		return &pb.TransferBatchLegResult{Error: leg.Error}
+	}
+	return &pb.TransferBatchLegResult{
+		Transfer: convertTransfer(leg.Transfer),
+		Fee:      convertFeeQuote(leg.Fee, currency),
 	}
 }
 
@@ -42,5 +73,202 @@ func convertEntry(entry db.Entry) *pb.Entry {
 		AccountId: entry.AccountID,
 		Amount:    entry.Amount,
 		CreatedAt: timestamppb.New(entry.CreatedAt),
+		Memo:      entry.Memo,
+		Reference: entry.Reference,
+		Category:  entry.Category,
+	}
+}
+
+func convertHold(hold db.Hold) *pb.Hold {
+	rsp := &pb.Hold{
+		Id:             hold.ID,
+		AccountId:      hold.AccountID,
+		Amount:         hold.Amount,
+		CapturedAmount: hold.CapturedAmount,
+		Status:         hold.Status,
+		ExpiresAt:      timestamppb.New(hold.ExpiresAt),
+		CreatedAt:      timestamppb.New(hold.CreatedAt),
+	}
+	if hold.SettledAt.Valid {
+		rsp.SettledAt = timestamppb.New(hold.SettledAt.Time)
+	}
+	return rsp
+}
+
+func convertScheduledTransfer(scheduled db.ScheduledTransfer) *pb.ScheduledTransfer {
+	rsp := &pb.ScheduledTransfer{
+		Id:            scheduled.ID,
+		FromAccountId: scheduled.FromAccountID,
+		ToAccountId:   scheduled.ToAccountID,
+		Amount:        scheduled.Amount,
+		Frequency:     scheduled.Frequency,
+		Interval:      scheduled.IntervalCount,
+		DayOfMonth:    scheduled.DayOfMonth,
+		StartAt:       timestamppb.New(scheduled.StartAt),
+		FailurePolicy: scheduled.FailurePolicy,
+		Status:        scheduled.Status,
+		CreatedAt:     timestamppb.New(scheduled.CreatedAt),
+	}
+	if scheduled.EndAt.Valid {
+		rsp.EndAt = timestamppb.New(scheduled.EndAt.Time)
+	}
+	if scheduled.NextOccurrenceAt.Valid {
+		rsp.NextOccurrenceAt = timestamppb.New(scheduled.NextOccurrenceAt.Time)
+	}
+	if scheduled.NextRunAt.Valid {
+		rsp.NextRunAt = timestamppb.New(scheduled.NextRunAt.Time)
+	}
+	return rsp
+}
+
+func convertScheduledTransferRun(run db.ScheduledTransferRun) *pb.ScheduledTransferRun {
+	return &pb.ScheduledTransferRun{
+		Id:           run.ID,
+		OccurrenceAt: timestamppb.New(run.OccurrenceAt),
+		Attempt:      run.Attempt,
+		Status:       run.Status,
+		TransferId:   run.TransferID.Int64,
+		Error:        run.Error,
+		CreatedAt:    timestamppb.New(run.CreatedAt),
+	}
+}
+
+func convertPayee(payee db.Payee, currency string, ownerName string) *pb.Payee {
+	return &pb.Payee{
+		Id:        payee.ID,
+		AccountId: payee.AccountID,
+		Nickname:  payee.Nickname,
+		OwnerName: ownerName,
+		Currency:  currency,
+		CreatedAt: timestamppb.New(payee.CreatedAt),
+		UpdatedAt: timestamppb.New(payee.UpdatedAt),
+	}
+}
+
+func convertPaymentRequest(request db.PaymentRequest) *pb.PaymentRequest {
+	return &pb.PaymentRequest{
+		Id:          request.ID,
+		Requester:   request.Requester,
+		Payer:       request.Payer,
+		ToAccountId: request.ToAccountID,
+		Amount:      request.Amount,
+		Currency:    request.Currency,
+		Memo:        request.Memo,
+		Status:      request.Status,
+		ExpiresAt:   timestamppb.New(request.ExpiresAt),
+		TransferId:  request.TransferID.Int64,
+		CreatedAt:   timestamppb.New(request.CreatedAt),
+		UpdatedAt:   timestamppb.New(request.UpdatedAt),
+	}
+}
+
+func convertSession(session db.Session) *pb.Session {
+	return &pb.Session{
+		Id:        session.ID.String(),
+		UserAgent: session.UserAgent,
+		ClientIp:  session.ClientIp,
+		CreatedAt: timestamppb.New(session.CreatedAt),
+		ExpiresAt: timestamppb.New(session.ExpiresAt),
+	}
+}
+
+func convertFxRate(rate db.FxRate) *pb.FxRate {
+	return &pb.FxRate{
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         util.FormatFXRate(rate.Rate),
+		UpdatedBy:    rate.UpdatedBy,
+		UpdatedAt:    timestamppb.New(rate.UpdatedAt),
+	}
+}
+
+func convertFxQuote(quote db.FxQuote) *pb.FxQuote {
+	return &pb.FxQuote{
+		Id:           quote.ID.String(),
+		FromCurrency: quote.FromCurrency,
+		ToCurrency:   quote.ToCurrency,
+		Rate:         util.FormatFXRate(quote.Rate),
+		ExpiresAt:    timestamppb.New(quote.ExpiresAt),
+	}
+}
+
+func convertFeeSchedule(schedule db.FeeSchedule) *pb.FeeSchedule {
+	return &pb.FeeSchedule{
+		Operation:    schedule.Operation,
+		Currency:     schedule.Currency,
+		FlatAmount:   schedule.FlatAmount,
+		Rate:         schedule.Rate,
+		MinAmount:    schedule.MinAmount,
+		MaxAmount:    schedule.MaxAmount,
+		FreePerMonth: schedule.FreePerMonth,
+		UpdatedBy:    schedule.UpdatedBy,
+		UpdatedAt:    timestamppb.New(schedule.UpdatedAt),
+	}
+}
+
+func convertFeeQuote(quote fee.Quote, currency string) *pb.FeeQuote {
+	return &pb.FeeQuote{
+		Amount:           quote.Amount,
+		Currency:         currency,
+		FlatAmount:       quote.Flat,
+		PercentageAmount: quote.Percentage,
+		Free:             quote.Free,
+		FreeRemaining:    quote.FreeRemaining,
 	}
 }
+
+func convertSpendingLimits(limits util.SpendingLimits) *pb.SpendingLimits {
+	return &pb.SpendingLimits{
+		PerTransaction: limits.PerTransaction,
+		Daily:          limits.Daily,
+		Monthly:        limits.Monthly,
+	}
+}
+
+// convertSpendingLimit converts the spending limits in force at now
+func convertSpendingLimit(limit db.SpendingLimit, now time.Time) *pb.SpendingLimit {
+	rsp := &pb.SpendingLimit{
+		AccountId: limit.AccountID.Int64,
+		Currency:  limit.Currency,
+		Limits:    convertSpendingLimits(limit.Limits(now)),
+		UpdatedAt: timestamppb.New(limit.UpdatedAt),
+	}
+	if pending, ok := limit.PendingLimits(now); ok {
+		rsp.PendingLimits = convertSpendingLimits(pending)
+		rsp.PendingEffectiveAt = timestamppb.New(limit.PendingEffectiveAt.Time)
+	}
+	return rsp
+}
+
+// convertRiskReview converts a decision for the customer it screened,
+// leaving out what the rules matched on
+func convertRiskReview(decision db.RiskDecision) *pb.RiskDecision {
+	rsp := &pb.RiskDecision{
+		Id:           decision.ID,
+		Username:     decision.Username,
+		Operation:    decision.Operation,
+		AccountId:    decision.AccountID,
+		ToAccountId:  decision.ToAccountID.Int64,
+		Amount:       decision.Amount,
+		Currency:     decision.Currency,
+		Action:       decision.Action,
+		ReviewStatus: decision.ReviewStatus.String,
+		TransferId:   decision.TransferID.Int64,
+		EntryId:      decision.EntryID.Int64,
+		CreatedAt:    timestamppb.New(decision.CreatedAt),
+	}
+	if decision.ReviewedAt.Valid {
+		rsp.ReviewedAt = timestamppb.New(decision.ReviewedAt.Time)
+	}
+	return rsp
+}
+
+// convertRiskDecision converts a decision for staff
+func convertRiskDecision(decision db.RiskDecision) *pb.RiskDecision {
+	rsp := convertRiskReview(decision)
+	rsp.Rules = decision.Rules
+	rsp.UserAgent = decision.UserAgent
+	rsp.ClientIp = decision.ClientIp
+	rsp.ReviewedBy = decision.ReviewedBy.String
+	return rsp
+}
@@ -0,0 +1,105 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// historyRequest is implemented by the requests listing an account's entries or transfers
+type historyRequest interface {
+	GetAccountId() int64
+	GetFromTime() *timestamppb.Timestamp
+	GetToTime() *timestamppb.Timestamp
+	GetMinAmount() int64
+	GetMaxAmount() int64
+	GetPageSize() int32
+	GetQuery() string
+	GetReference() string
+	GetCategory() string
+}
+
+// checkAccountOwner returns an error status unless the account exists and belongs to username
+func (server *Server) checkAccountOwner(ctx context.Context, accountID int64, username string) error {
+	account, err := server.store.GetAccount(ctx, accountID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return status.Errorf(codes.NotFound, "account not found")
+		}
+		return status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Owner != username {
+		return status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+	return nil
+}
+
+func validateHistoryRequest(req historyRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if req.GetFromTime() != nil && req.GetToTime() != nil &&
+		!req.GetFromTime().AsTime().Before(req.GetToTime().AsTime()) {
+		violations = append(violations, fieldViolation("to_time", fmt.Errorf("must be after from_time")))
+	}
+
+	if req.GetMinAmount() < 0 {
+		violations = append(violations, fieldViolation("min_amount", fmt.Errorf("must not be negative")))
+	}
+
+	if req.GetMaxAmount() < 0 {
+		violations = append(violations, fieldViolation("max_amount", fmt.Errorf("must not be negative")))
+	} else if req.GetMaxAmount() > 0 && req.GetMaxAmount() < req.GetMinAmount() {
+		violations = append(violations, fieldViolation("max_amount", fmt.Errorf("must not be less than min_amount")))
+	}
+
+	if req.GetQuery() != "" {
+		if err := val.ValidateSearchQuery(req.GetQuery()); err != nil {
+			violations = append(violations, fieldViolation("query", err))
+		}
+	}
+
+	if req.GetReference() != "" {
+		if err := val.ValidateReference(req.GetReference()); err != nil {
+			violations = append(violations, fieldViolation("reference", err))
+		}
+	}
+
+	if req.GetCategory() != "" {
+		if err := val.ValidateCategory(req.GetCategory()); err != nil {
+			violations = append(violations, fieldViolation("category", err))
+		}
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
+
+func convertHistoryFilter(req historyRequest) db.HistoryFilter {
+	filter := db.HistoryFilter{
+		MinAmount: req.GetMinAmount(),
+		MaxAmount: req.GetMaxAmount(),
+		Query:     req.GetQuery(),
+		Reference: req.GetReference(),
+		Category:  req.GetCategory(),
+	}
+	if req.GetFromTime() != nil {
+		filter.FromTime = req.GetFromTime().AsTime()
+	}
+	if req.GetToTime() != nil {
+		filter.ToTime = req.GetToTime().AsTime()
+	}
+	return filter
+}
@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getHoldOfUser returns the hold with the account it was placed on,
+// making sure the account belongs to the user
+func (server *Server) getHoldOfUser(ctx context.Context, username string, holdID int64) (db.Hold, db.Account, error) {
+	hold, err := server.store.GetHold(ctx, holdID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return hold, db.Account{}, status.Errorf(codes.NotFound, "hold not found")
+		}
+		return hold, db.Account{}, status.Errorf(codes.Internal, "failed to get hold: %s", err)
+	}
+
+	account, err := server.store.GetAccount(ctx, hold.AccountID)
+	if err != nil {
+		return hold, account, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Owner != username {
+		return hold, account, status.Errorf(codes.PermissionDenied, "hold doesn't belong to the authenticated user")
+	}
+	return hold, account, nil
+}
+
+// holdError converts an error returned by the hold transactions
+func holdError(err error) error {
+	var notActiveErr *db.AccountNotActiveError
+	var limitErr *db.SpendingLimitError
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "hold not found")
+	case errors.Is(err, db.ErrCaptureExceedsHold):
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	case errors.Is(err, db.ErrHoldNotActive),
+		errors.Is(err, db.ErrHoldExpired),
+		errors.Is(err, db.ErrInsufficientFunds),
+		errors.As(err, &notActiveErr),
+		errors.As(err, &limitErr):
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+	return status.Errorf(codes.Internal, "hold transaction failed: %s", err)
+}
@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/metadata"
+)
+
+// idempotencyKey returns the key set on the request,
+// falling back to the Idempotency-Key header forwarded by the gateway.
+func idempotencyKey(ctx context.Context, field *string) *string {
+	if field != nil {
+		return field
+	}
+
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(idempotencyKeyHeader); len(keys) > 0 {
+			return &keys[0]
+		}
+	}
+
+	return nil
+}
+
+// newIdempotencyParams fingerprints the method and request fields the key is bound to.
+// It returns nil when the client did not send a key.
+func newIdempotencyParams(username string, key *string, method string, fields ...interface{}) *db.IdempotencyParams {
+	if key == nil {
+		return nil
+	}
+
+	hash := sha256.New()
+	fmt.Fprint(hash, method)
+	for _, field := range fields {
+		fmt.Fprintf(hash, "|%v", field)
+	}
+
+	return &db.IdempotencyParams{
+		Username:    username,
+		Key:         *key,
+		RequestHash: hex.EncodeToString(hash.Sum(nil)),
+	}
+}
+
+func validateIdempotencyKey(key *string) (violations []*errdetails.BadRequest_FieldViolation) {
+	if key != nil {
+		if err := val.ValidateIdempotencyKey(*key); err != nil {
+			violations = append(violations, fieldViolation("idempotency_key", err))
+		}
+	}
+
+	return violations
+}
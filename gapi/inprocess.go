@@ -0,0 +1,63 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// inProcessConn dispatches client calls straight to a service implementation,
+// running them through the server interceptor like a call over the network would.
+// It lets the HTTP gateway share the gRPC server's interceptors without a socket.
+type inProcessConn struct {
+	handlers    map[string]grpc.MethodDesc
+	srv         interface{}
+	interceptor grpc.UnaryServerInterceptor
+}
+
+// NewInProcessConn returns a client connection serving desc with srv in the current process
+func NewInProcessConn(desc *grpc.ServiceDesc, srv interface{}, interceptor grpc.UnaryServerInterceptor) grpc.ClientConnInterface {
+	handlers := make(map[string]grpc.MethodDesc, len(desc.Methods))
+	for _, method := range desc.Methods {
+		handlers[fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName)] = method
+	}
+
+	return &inProcessConn{
+		handlers:    handlers,
+		srv:         srv,
+		interceptor: interceptor,
+	}
+}
+
+func (conn *inProcessConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	handler, ok := conn.handlers[method]
+	if !ok {
+		return status.Errorf(codes.Unimplemented, "unknown method %s", method)
+	}
+
+	// the server side reads what the client sent as incoming metadata
+	md, _ := metadata.FromOutgoingContext(ctx)
+	ctx = metadata.NewIncomingContext(ctx, md.Copy())
+
+	dec := func(in interface{}) error {
+		proto.Merge(in.(proto.Message), args.(proto.Message))
+		return nil
+	}
+
+	resp, err := handler.Handler(conn.srv, ctx, dec, conn.interceptor)
+	if err != nil {
+		return err
+	}
+
+	proto.Merge(reply.(proto.Message), resp.(proto.Message))
+	return nil
+}
+
+func (conn *inProcessConn) NewStream(ctx context.Context, desc *grpc.StreamDesc, method string, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, status.Errorf(codes.Unimplemented, "streaming is not supported in process")
+}
@@ -0,0 +1,169 @@
+package gapi
+
+import (
+	"context"
+	"slices"
+
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// access says who may call a method. The zero value denies everyone, so a method
+// missing from methodPolicies is never exposed by accident.
+type access int
+
+const (
+	accessDenied access = iota
+	accessPublic
+	accessAuthenticated
+	accessRole
+)
+
+type methodPolicy struct {
+	access access
+	// roles may call the method when access is accessRole
+	roles []string
+}
+
+// methodPolicies lists every method the server exposes together with who may call it
+var methodPolicies = map[string]methodPolicy{
+	pb.SimpleBank_CreateUser_FullMethodName:       {access: accessPublic},
+	pb.SimpleBank_LoginUser_FullMethodName:        {access: accessPublic},
+	pb.SimpleBank_VerifyEmail_FullMethodName:      {access: accessPublic},
+	pb.SimpleBank_RenewAccessToken_FullMethodName: {access: accessPublic},
+	pb.SimpleBank_LogoutUser_FullMethodName:       {access: accessPublic},
+
+	pb.SimpleBank_ListSessions_FullMethodName:            {access: accessAuthenticated},
+	pb.SimpleBank_RevokeSession_FullMethodName:           {access: accessAuthenticated},
+	pb.SimpleBank_RevokeAllSessions_FullMethodName:       {access: accessAuthenticated},
+	pb.SimpleBank_UpdateUser_FullMethodName:              {access: accessAuthenticated},
+	pb.SimpleBank_CreateAccount_FullMethodName:           {access: accessAuthenticated},
+	pb.SimpleBank_GetAccount_FullMethodName:              {access: accessAuthenticated},
+	pb.SimpleBank_ListAccounts_FullMethodName:            {access: accessAuthenticated},
+	pb.SimpleBank_FreezeAccount_FullMethodName:           {access: accessAuthenticated},
+	pb.SimpleBank_CloseAccount_FullMethodName:            {access: accessAuthenticated},
+	pb.SimpleBank_Deposit_FullMethodName:                 {access: accessAuthenticated},
+	pb.SimpleBank_Withdraw_FullMethodName:                {access: accessAuthenticated},
+	pb.SimpleBank_TransferMoney_FullMethodName:           {access: accessAuthenticated},
+	pb.SimpleBank_CreateTransferBatch_FullMethodName:     {access: accessAuthenticated},
+	pb.SimpleBank_CreateFxQuote_FullMethodName:           {access: accessAuthenticated},
+	pb.SimpleBank_CreateHold_FullMethodName:              {access: accessAuthenticated},
+	pb.SimpleBank_CaptureHold_FullMethodName:             {access: accessAuthenticated},
+	pb.SimpleBank_ReleaseHold_FullMethodName:             {access: accessAuthenticated},
+	pb.SimpleBank_CreateScheduledTransfer_FullMethodName: {access: accessAuthenticated},
+	pb.SimpleBank_GetScheduledTransfer_FullMethodName:    {access: accessAuthenticated},
+	pb.SimpleBank_ListScheduledTransfers_FullMethodName:  {access: accessAuthenticated},
+	pb.SimpleBank_UpdateScheduledTransfer_FullMethodName: {access: accessAuthenticated},
+	pb.SimpleBank_DeleteScheduledTransfer_FullMethodName: {access: accessAuthenticated},
+	pb.SimpleBank_ListAccountEntries_FullMethodName:      {access: accessAuthenticated},
+	pb.SimpleBank_GetStatement_FullMethodName:            {access: accessAuthenticated},
+	pb.SimpleBank_ListAccountTransfers_FullMethodName:    {access: accessAuthenticated},
+	pb.SimpleBank_GetTransfer_FullMethodName:             {access: accessAuthenticated},
+	pb.SimpleBank_RefundTransfer_FullMethodName:          {access: accessAuthenticated},
+	pb.SimpleBank_GetLimits_FullMethodName:               {access: accessAuthenticated},
+	pb.SimpleBank_UpdateLimits_FullMethodName:            {access: accessAuthenticated},
+	pb.SimpleBank_CreatePayee_FullMethodName:             {access: accessAuthenticated},
+	pb.SimpleBank_GetPayee_FullMethodName:                {access: accessAuthenticated},
+	pb.SimpleBank_ListPayees_FullMethodName:              {access: accessAuthenticated},
+	pb.SimpleBank_UpdatePayee_FullMethodName:             {access: accessAuthenticated},
+	pb.SimpleBank_DeletePayee_FullMethodName:             {access: accessAuthenticated},
+	pb.SimpleBank_CreatePaymentRequest_FullMethodName:    {access: accessAuthenticated},
+	pb.SimpleBank_ListPaymentRequests_FullMethodName:     {access: accessAuthenticated},
+	pb.SimpleBank_AcceptPaymentRequest_FullMethodName:    {access: accessAuthenticated},
+	pb.SimpleBank_DeclinePaymentRequest_FullMethodName:   {access: accessAuthenticated},
+
+	pb.AdminService_SearchUsers_FullMethodName:                {access: accessRole, roles: staffRoles},
+	pb.AdminService_GetCustomerAccount_FullMethodName:         {access: accessRole, roles: staffRoles},
+	pb.AdminService_ListCustomerAccountEntries_FullMethodName: {access: accessRole, roles: staffRoles},
+	pb.AdminService_FreezeCustomerAccount_FullMethodName:      {access: accessRole, roles: staffRoles},
+	pb.AdminService_BlockUserSessions_FullMethodName:          {access: accessRole, roles: staffRoles},
+	pb.AdminService_GetTrialBalance_FullMethodName:            {access: accessRole, roles: staffRoles},
+	pb.AdminService_ListRiskReviews_FullMethodName:            {access: accessRole, roles: staffRoles},
+	pb.AdminService_RejectRiskReview_FullMethodName:           {access: accessRole, roles: staffRoles},
+	// lifting a freeze is left to admins, support staff can only put one in place
+	pb.AdminService_UnfreezeCustomerAccount_FullMethodName: {access: accessRole, roles: []string{util.AdminRole}},
+	pb.AdminService_ReopenCustomerAccount_FullMethodName:   {access: accessRole, roles: []string{util.AdminRole}},
+	pb.AdminService_SetFxRate_FullMethodName:               {access: accessRole, roles: []string{util.AdminRole}},
+	pb.AdminService_SetFeeSchedule_FullMethodName:          {access: accessRole, roles: []string{util.AdminRole}},
+	// support staff can't move customer money, refunds are up to the recipient otherwise
+	pb.AdminService_ReverseTransfer_FullMethodName:   {access: accessRole, roles: []string{util.AdminRole}},
+	pb.AdminService_ApproveRiskReview_FullMethodName: {access: accessRole, roles: []string{util.AdminRole}},
+}
+
+// staffRoles may call the AdminService
+var staffRoles = []string{util.SupportRole, util.AdminRole}
+
+type authPayloadKey struct{}
+
+// AuthInterceptor enforces methodPolicies on unary calls and makes the
+// authenticated payload available to the handler through authorizeUser.
+func (server *Server) AuthInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (resp interface{}, err error) {
+	authorizedCtx, err := server.authorizeMethod(ctx, info.FullMethod)
+	if err != nil {
+		if status.Code(err) == codes.PermissionDenied {
+			server.auditDenied(ctx, info.FullMethod, req, err)
+		}
+		return nil, err
+	}
+	return handler(authorizedCtx, req)
+}
+
+// AuthStreamInterceptor enforces methodPolicies on streaming calls
+func (server *Server) AuthStreamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	ctx, err := server.authorizeMethod(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authServerStream{ServerStream: ss, ctx: ctx})
+}
+
+func (server *Server) authorizeMethod(ctx context.Context, method string) (context.Context, error) {
+	policy := methodPolicies[method]
+
+	switch policy.access {
+	case accessPublic:
+		return ctx, nil
+	case accessAuthenticated, accessRole:
+		payload, err := server.authenticate(ctx)
+		if err != nil {
+			return nil, unauthenticatedError(err)
+		}
+
+		if policy.access == accessRole && !slices.Contains(policy.roles, payload.Role) {
+			return nil, status.Errorf(codes.PermissionDenied, "method requires one of the roles %v", policy.roles)
+		}
+
+		return context.WithValue(ctx, authPayloadKey{}, payload), nil
+	default:
+		return nil, status.Errorf(codes.PermissionDenied, "method %s is not exposed", method)
+	}
+}
+
+func payloadFromContext(ctx context.Context) (*token.Payload, bool) {
+	payload, ok := ctx.Value(authPayloadKey{}).(*token.Payload)
+	return payload, ok
+}
+
+// authServerStream overrides the context of a stream with the authorized one
+type authServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (stream *authServerStream) Context() context.Context {
+	return stream.ctx
+}
@@ -0,0 +1,248 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+func serviceMethods(desc grpc.ServiceDesc) []string {
+	var methods []string
+	for _, method := range desc.Methods {
+		methods = append(methods, fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName))
+	}
+	for _, stream := range desc.Streams {
+		methods = append(methods, fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName))
+	}
+	return methods
+}
+
+// allMethods returns the methods of every service the server implements
+func allMethods() []string {
+	return append(serviceMethods(pb.SimpleBank_ServiceDesc), serviceMethods(pb.AdminService_ServiceDesc)...)
+}
+
+func TestMethodPoliciesCoverService(t *testing.T) {
+	methods := allMethods()
+	require.NotEmpty(t, methods)
+
+	for _, method := range methods {
+		policy, ok := methodPolicies[method]
+		require.True(t, ok, "%s has no policy", method)
+		require.NotEqual(t, accessDenied, policy.access, "%s is denied", method)
+		if policy.access == accessRole {
+			require.NotEmpty(t, policy.roles, "%s requires a role but lists none", method)
+		}
+	}
+
+	// every policy belongs to a method that exists
+	for method := range methodPolicies {
+		require.Contains(t, methods, method)
+	}
+}
+
+// newDeniedAuditStore returns a store accepting the audit rows of denied admin calls
+func newDeniedAuditStore(t *testing.T, username string) *mockdb.MockStore {
+	ctrl := gomock.NewController(t)
+	store := mockdb.NewMockStore(ctrl)
+	store.EXPECT().
+		CreateAuditLog(gomock.Any(), gomock.Any()).
+		AnyTimes().
+		DoAndReturn(func(_ context.Context, arg db.CreateAuditLogParams) (db.AuditLog, error) {
+			require.Equal(t, username, arg.Actor)
+			require.Equal(t, util.AuditDenied, arg.Outcome)
+			require.NotEmpty(t, arg.Error)
+			return db.AuditLog{}, nil
+		})
+	return store
+}
+
+func TestAuthInterceptor(t *testing.T) {
+	user, _ := randomUser(t)
+	server := newTestServer(t, newDeniedAuditStore(t, user.Username), nil)
+
+	for _, method := range allMethods() {
+		policy := methodPolicies[method]
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+
+		t.Run(method, func(t *testing.T) {
+			called := false
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				called = true
+				payload, ok := payloadFromContext(ctx)
+				if policy.access == accessPublic {
+					require.False(t, ok)
+				} else {
+					require.True(t, ok)
+					require.Equal(t, user.Username, payload.Username)
+				}
+				return nil, nil
+			}
+
+			// without a token only public methods go through
+			_, err := server.AuthInterceptor(context.Background(), nil, info, handler)
+			if policy.access == accessPublic {
+				require.NoError(t, err)
+				require.True(t, called)
+			} else {
+				requireStatusCode(t, err, codes.Unauthenticated)
+				require.False(t, called)
+			}
+
+			called = false
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+			_, err = server.AuthInterceptor(ctx, nil, info, handler)
+			if policy.access == accessRole {
+				requireStatusCode(t, err, codes.PermissionDenied)
+				require.False(t, called)
+			} else {
+				require.NoError(t, err)
+				require.True(t, called)
+			}
+		})
+	}
+}
+
+func TestAuthInterceptorRoles(t *testing.T) {
+	user, _ := randomUser(t)
+	server := newTestServer(t, newDeniedAuditStore(t, user.Username), nil)
+
+	for _, method := range allMethods() {
+		policy := methodPolicies[method]
+		if policy.access != accessRole {
+			continue
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+
+		for _, role := range []string{util.CustomerRole, util.SupportRole, util.AdminRole} {
+			t.Run(fmt.Sprintf("%s/%s", method, role), func(t *testing.T) {
+				called := false
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					called = true
+					return nil, nil
+				}
+
+				ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, role, time.Minute)
+				_, err := server.AuthInterceptor(ctx, nil, info, handler)
+				if slices.Contains(policy.roles, role) {
+					require.NoError(t, err)
+					require.True(t, called)
+				} else {
+					requireStatusCode(t, err, codes.PermissionDenied)
+					require.False(t, called)
+				}
+			})
+		}
+	}
+
+	// customers never reach the admin service
+	for _, method := range serviceMethods(pb.AdminService_ServiceDesc) {
+		require.NotContains(t, methodPolicies[method].roles, util.CustomerRole)
+	}
+}
+
+func TestAuthInterceptorAuditsDeniedAdminCall(t *testing.T) {
+	user, _ := randomUser(t)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	req := &pb.SearchUsersRequest{Query: "alice"}
+	store.EXPECT().
+		CreateAuditLog(gomock.Any(), gomock.Any()).
+		Times(1).
+		DoAndReturn(func(_ context.Context, arg db.CreateAuditLogParams) (db.AuditLog, error) {
+			require.Equal(t, user.Username, arg.Actor)
+			require.Equal(t, auditCallMethod, arg.Action)
+			require.Equal(t, methodTarget(pb.AdminService_SearchUsers_FullMethodName), arg.Target)
+			require.Equal(t, util.AuditDenied, arg.Outcome)
+			require.Contains(t, string(arg.Details), "alice")
+			return db.AuditLog{}, nil
+		})
+
+	server := newTestServer(t, store, nil)
+	info := &grpc.UnaryServerInfo{FullMethod: pb.AdminService_SearchUsers_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		require.Fail(t, "handler must not be called")
+		return nil, nil
+	}
+
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, util.CustomerRole, time.Minute)
+	_, err := server.AuthInterceptor(ctx, req, info, handler)
+	requireStatusCode(t, err, codes.PermissionDenied)
+}
+
+func TestAuthInterceptorExpiredToken(t *testing.T) {
+	user, _ := randomUser(t)
+	server := newTestServer(t, nil, nil)
+
+	info := &grpc.UnaryServerInfo{FullMethod: pb.SimpleBank_GetAccount_FullMethodName}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		require.Fail(t, "handler must not be called")
+		return nil, nil
+	}
+
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, -time.Minute)
+	_, err := server.AuthInterceptor(ctx, nil, info, handler)
+	requireStatusCode(t, err, codes.Unauthenticated)
+}
+
+func TestAuthInterceptorUnlistedMethod(t *testing.T) {
+	user, _ := randomUser(t)
+	server := newTestServer(t, nil, nil)
+
+	info := &grpc.UnaryServerInfo{FullMethod: "/pb.SimpleBank/NotListed"}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		require.Fail(t, "handler must not be called")
+		return nil, nil
+	}
+
+	// fails closed even for authenticated users
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+	_, err := server.AuthInterceptor(ctx, nil, info, handler)
+	requireStatusCode(t, err, codes.PermissionDenied)
+}
+
+func TestInProcessConn(t *testing.T) {
+	user, _ := randomUser(t)
+	account := randomAccount(user.Username)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	store := mockdb.NewMockStore(ctrl)
+
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+	store.EXPECT().GetHeldAmount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(int64(0), nil)
+
+	server := newTestServer(t, store, nil)
+	conn := NewInProcessConn(&pb.SimpleBank_ServiceDesc, server, server.AuthInterceptor)
+	client := pb.NewSimpleBankClient(conn)
+
+	req := &pb.GetAccountRequest{Id: account.ID}
+
+	_, err := client.GetAccount(context.Background(), req)
+	requireStatusCode(t, err, codes.Unauthenticated)
+
+	// the client's outgoing metadata reaches the server as incoming metadata
+	accessToken, _, err := server.tokenMaker.CreateToken(user.Username, user.Role, time.Minute)
+	require.NoError(t, err)
+	ctx := metadata.AppendToOutgoingContext(context.Background(),
+		authorizationHeader, fmt.Sprintf("%s %s", authorizationBearer, accessToken))
+
+	res, err := client.GetAccount(ctx, req)
+	require.NoError(t, err)
+	require.Equal(t, account.ID, res.GetAccount().GetId())
+}
@@ -2,7 +2,9 @@ package gapi
 
 import (
 	"context"
+	"strings"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 )
@@ -11,8 +13,18 @@ const (
 	grpcGatewayUserAgentHeader = "grpcgateway-user-agent"
 	userAgentHeader            = "user-agent"
 	xForwardedForHeader        = "x-forwarded-for"
+	idempotencyKeyHeader       = "idempotency-key"
 )
 
+// IncomingHeaderMatcher forwards the Idempotency-Key HTTP header to gRPC metadata,
+// on top of the headers the gateway forwards by default.
+func IncomingHeaderMatcher(key string) (string, bool) {
+	if strings.ToLower(key) == idempotencyKeyHeader {
+		return idempotencyKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
 type Metadata struct {
 	UserAgent string
 	ClientIP  string
@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/lib/pq"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getOwnPayee returns the payee if it was saved by the user
+func (server *Server) getOwnPayee(ctx context.Context, username string, id int64) (db.Payee, error) {
+	payee, err := server.store.GetPayee(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return payee, status.Errorf(codes.NotFound, "payee not found")
+		}
+		return payee, status.Errorf(codes.Internal, "failed to get payee: %s", err)
+	}
+
+	if payee.Owner != username {
+		return payee, status.Errorf(codes.PermissionDenied, "payee doesn't belong to the authenticated user")
+	}
+	return payee, nil
+}
+
+// recipientName returns the masked full name of the owner of an account being paid,
+// enough for the payer to recognize them without giving the name away
+func (server *Server) recipientName(ctx context.Context, account db.Account) (string, error) {
+	owner, err := server.store.GetUser(ctx, account.Owner)
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to get account owner: %s", err)
+	}
+	return util.MaskName(owner.FullName), nil
+}
+
+// describePayee converts a payee along with the currency and owner of its account
+func (server *Server) describePayee(ctx context.Context, payee db.Payee) (*pb.Payee, error) {
+	account, err := server.store.GetAccount(ctx, payee.AccountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get payee account: %s", err)
+	}
+
+	name, err := server.recipientName(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	return convertPayee(payee, account.Currency, name), nil
+}
+
+// payeeError converts an error returned when saving a payee
+func payeeError(err error) error {
+	if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == "unique_violation" {
+		return status.Errorf(codes.AlreadyExists, "a payee with this account or nickname already exists")
+	}
+	return status.Errorf(codes.Internal, "failed to save payee: %s", err)
+}
@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/worker"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getPaymentRequestToPay returns the payment request if the user was asked to pay it
+func (server *Server) getPaymentRequestToPay(ctx context.Context, username string, id int64) (db.PaymentRequest, error) {
+	request, err := server.store.GetPaymentRequest(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return request, status.Errorf(codes.NotFound, "payment request not found")
+		}
+		return request, status.Errorf(codes.Internal, "failed to get payment request: %s", err)
+	}
+
+	if request.Payer != username {
+		return request, status.Errorf(codes.PermissionDenied, "payment request isn't addressed to the authenticated user")
+	}
+	return request, nil
+}
+
+// notifyPaymentRequest tells both users about the status a payment request just moved to.
+// It never fails the transaction it runs in, a missed email doesn't undo the change.
+func (server *Server) notifyPaymentRequest(ctx context.Context) func(request db.PaymentRequest) error {
+	return func(request db.PaymentRequest) error {
+		worker.DistributePaymentRequestEmails(ctx, server.taskDistributor, request)
+		return nil
+	}
+}
+
+// paymentRequestError converts an error returned by answering a payment request
+func paymentRequestError(err error) error {
+	var notActiveErr *db.AccountNotActiveError
+	var limitErr *db.SpendingLimitError
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "payment request not found")
+	case errors.Is(err, db.ErrPaymentRequestNotPending),
+		errors.Is(err, db.ErrPaymentRequestExpired),
+		errors.Is(err, db.ErrInsufficientFunds),
+		errors.As(err, &notActiveErr),
+		errors.As(err, &limitErr),
+		errors.Is(err, db.ErrNewPayeeLimit):
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	}
+	return status.Errorf(codes.Internal, "payment request transaction failed: %s", err)
+}
@@ -0,0 +1,30 @@
+package gapi
+
+import (
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// reverseTransferError converts an error returned by the reverse transfer transaction
+func reverseTransferError(err error) error {
+	var notActiveErr *db.AccountNotActiveError
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "transfer not found")
+	case errors.Is(err, db.ErrReversalExceedsTransfer),
+		errors.Is(err, db.ErrFxAmountTooSmall):
+		return status.Errorf(codes.InvalidArgument, "%s", err)
+	case errors.Is(err, db.ErrTransferIsReversal),
+		errors.Is(err, db.ErrTransferFullyRefunded),
+		errors.Is(err, db.ErrInsufficientFunds),
+		errors.As(err, &notActiveErr):
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	case errors.Is(err, db.ErrIdempotencyKeyReused):
+		return status.Errorf(codes.AlreadyExists, "%s", err)
+	}
+	return status.Errorf(codes.Internal, "reverse transfer transaction failed: %s", err)
+}
@@ -0,0 +1,155 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// screenRisk evaluates the risk rules against a money movement before it is made.
+// It records and returns the decision when the movement is held for review, and records it
+// and returns a status error when the movement is denied. When the movement can go ahead it
+// returns the decision for the transaction making the movement to record, so a movement that
+// fails leaves nothing counting toward the velocity rule. Both decisions are nil when no rule
+// is enabled. request is the transaction params ApproveRiskReview makes the movement with,
+// nil when it can't wait for a review, which denies it instead.
+//
+// A retry with the idempotency key of a held movement gets the same review back
+// rather than being screened again, and one of a movement already made isn't screened,
+// so the transaction replays it.
+func (server *Server) screenRisk(
+	ctx context.Context,
+	op risk.Operation,
+	request interface{},
+	idempotency *db.IdempotencyParams,
+) (held *db.RiskDecision, allowed *db.CreateRiskDecisionParams, err error) {
+	if server.riskEngine == nil {
+		return nil, nil, nil
+	}
+
+	if idempotency != nil {
+		_, err = server.store.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{
+			Username:       idempotency.Username,
+			IdempotencyKey: idempotency.Key,
+		})
+		if err == nil {
+			return nil, nil, nil
+		}
+		if err != sql.ErrNoRows {
+			return nil, nil, status.Errorf(codes.Internal, "failed to get idempotency key: %s", err)
+		}
+
+		review, err := server.store.GetRiskReviewByIdempotencyKey(ctx, db.GetRiskReviewByIdempotencyKeyParams{
+			Username:       idempotency.Username,
+			IdempotencyKey: sql.NullString{String: idempotency.Key, Valid: true},
+		})
+		if err == nil {
+			held, err = heldRiskReview(review, idempotency)
+			return held, nil, err
+		}
+		if err != sql.ErrNoRows {
+			return nil, nil, status.Errorf(codes.Internal, "failed to get risk review: %s", err)
+		}
+	}
+
+	mtdt := server.extractMetadata(ctx)
+	op.UserAgent = mtdt.UserAgent
+	op.ClientIP = mtdt.ClientIP
+	op.Time = time.Now()
+
+	decision, err := server.riskEngine.Evaluate(ctx, op, db.NewRiskHistory(server.store))
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to evaluate risk rules: %s", err)
+	}
+	if decision.Action == util.RiskReview && request == nil {
+		decision.Action = util.RiskDeny
+	}
+
+	body := []byte("{}")
+	if request != nil {
+		body, err = json.Marshal(request)
+		if err != nil {
+			return nil, nil, status.Errorf(codes.Internal, "failed to marshal request: %s", err)
+		}
+	}
+
+	arg := db.CreateRiskDecisionParams{
+		Username:    op.Username,
+		Operation:   op.Kind,
+		AccountID:   op.AccountID,
+		ToAccountID: sql.NullInt64{Int64: op.ToAccountID, Valid: op.ToAccountID != 0},
+		Amount:      op.Amount,
+		Currency:    op.Currency,
+		Action:      decision.Action,
+		Rules:       decision.Rules,
+		Request:     body,
+		UserAgent:   op.UserAgent,
+		ClientIp:    op.ClientIP,
+	}
+	if arg.Rules == nil {
+		arg.Rules = []string{}
+	}
+	if idempotency != nil {
+		arg.IdempotencyKey = sql.NullString{String: idempotency.Key, Valid: true}
+		arg.RequestHash = sql.NullString{String: idempotency.RequestHash, Valid: true}
+	}
+	if decision.Action == util.RiskReview {
+		arg.ReviewStatus = sql.NullString{String: util.RiskReviewPending, Valid: true}
+	}
+
+	if decision.Action == util.RiskAllow {
+		return nil, &arg, nil
+	}
+
+	recorded, err := server.store.CreateRiskDecision(ctx, arg)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to record risk decision: %s", err)
+	}
+
+	if decision.Action == util.RiskDeny {
+		return nil, nil, status.Errorf(codes.PermissionDenied, "%s denied by the risk rules", op.Kind)
+	}
+	return &recorded, nil, nil
+}
+
+// heldRiskReview answers a retry of a movement that was held for review
+func heldRiskReview(held db.RiskDecision, idempotency *db.IdempotencyParams) (*db.RiskDecision, error) {
+	if held.RequestHash.String != idempotency.RequestHash {
+		return nil, status.Errorf(codes.AlreadyExists, "%s", db.ErrIdempotencyKeyReused)
+	}
+
+	switch held.ReviewStatus.String {
+	case util.RiskReviewPending:
+		return &held, nil
+	case util.RiskReviewRejected:
+		return nil, status.Errorf(codes.PermissionDenied, "%s rejected after review", held.Operation)
+	}
+	// an approved movement was recorded under the key, the transaction replays it
+	return nil, nil
+}
+
+// riskReviewError converts an error returned by approving or rejecting a risk review
+func riskReviewError(err error) error {
+	var notActiveErr *db.AccountNotActiveError
+	var limitErr *db.SpendingLimitError
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		return status.Errorf(codes.NotFound, "risk review not found")
+	case errors.Is(err, db.ErrRiskReviewNotPending),
+		errors.Is(err, db.ErrInsufficientFunds),
+		errors.As(err, &notActiveErr),
+		errors.As(err, &limitErr):
+		return status.Errorf(codes.FailedPrecondition, "%s", err)
+	case errors.Is(err, db.ErrIdempotencyKeyReused):
+		return status.Errorf(codes.AlreadyExists, "%s", err)
+	}
+	return status.Errorf(codes.Internal, "risk review transaction failed: %s", err)
+}
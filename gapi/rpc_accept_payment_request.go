@@ -0,0 +1,97 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) AcceptPaymentRequest(ctx context.Context, req *pb.AcceptPaymentRequestRequest) (*pb.AcceptPaymentRequestResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateAcceptPaymentRequestRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	request, err := server.getPaymentRequestToPay(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	fromAccount, err := server.store.GetAccount(ctx, req.GetFromAccountId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "from account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get from account: %s", err)
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	if fromAccount.Currency != request.Currency {
+		return nil, status.Errorf(codes.InvalidArgument, "from account currency mismatch: %s vs %s", fromAccount.Currency, request.Currency)
+	}
+
+	// the payer can't wait on a review while the request expires, so a review denies it
+	_, decision, err := server.screenRisk(ctx, risk.Operation{
+		Kind:        util.RiskTransfer,
+		Username:    authPayload.Username,
+		AccountID:   fromAccount.ID,
+		ToAccountID: request.ToAccountID,
+		Amount:      request.Amount,
+		Currency:    fromAccount.Currency,
+	}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.AcceptPaymentRequestTx(ctx, db.AcceptPaymentRequestTxParams{
+		ID:            request.ID,
+		FromAccountID: fromAccount.ID,
+		NewPayeeLimit: db.NewPayeeLimitFromConfig(server.config),
+		RiskDecision:  decision,
+		AfterAccept:   server.notifyPaymentRequest(ctx),
+	})
+	if err != nil {
+		return nil, paymentRequestError(err)
+	}
+
+	rsp := &pb.AcceptPaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(result.PaymentRequest),
+		Transfer:       convertTransfer(result.Transfer.Transfer),
+		FromAccount:    convertAccount(result.Transfer.FromAccount),
+		FromEntry:      convertEntry(result.Transfer.FromEntry),
+		Fee:            convertFeeQuote(result.Transfer.Fee, result.Transfer.FromAccount.Currency),
+	}
+	if result.Transfer.FeeEntry.ID != 0 {
+		rsp.FeeEntry = convertEntry(result.Transfer.FeeEntry)
+	}
+	return rsp, nil
+}
+
+func validateAcceptPaymentRequestRequest(req *pb.AcceptPaymentRequestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePaymentRequestID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidateAccountID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,263 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	mockwk "github.com/JaidenShall/simplebank/worker/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestAcceptPaymentRequestAPI(t *testing.T) {
+	requester, _ := randomUser(t)
+	payer, _ := randomUser(t)
+
+	toAccount := randomAccount(requester.Username)
+	fromAccount := randomAccount(payer.Username)
+	fromAccount.Currency = toAccount.Currency
+
+	pending := randomPaymentRequest(requester.Username, payer.Username, toAccount)
+
+	transfer := db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        pending.Amount,
+	}
+	accepted := pending
+	accepted.Status = util.PaymentRequestAccepted
+	accepted.TransferID = sql.NullInt64{Int64: transfer.ID, Valid: true}
+
+	testCases := []struct {
+		name          string
+		req           *pb.AcceptPaymentRequestRequest
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: fromAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(pending, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.AcceptPaymentRequestTxParams) (db.AcceptPaymentRequestTxResult, error) {
+						require.Equal(t, pending.ID, arg.ID)
+						require.Equal(t, fromAccount.ID, arg.FromAccountID)
+						result := db.AcceptPaymentRequestTxResult{
+							PaymentRequest: accepted,
+							Transfer:       db.TransferTxResult{Transfer: transfer, FromAccount: fromAccount},
+						}
+						return result, arg.AfterAccept(accepted)
+					})
+				expectPaymentRequestEmails(taskDistributor, accepted, util.PaymentRequestAccepted)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.PaymentRequestAccepted, res.GetPaymentRequest().GetStatus())
+				require.Equal(t, transfer.ID, res.GetPaymentRequest().GetTransferId())
+				require.Equal(t, transfer.ID, res.GetTransfer().GetId())
+				require.Nil(t, res.GetFeeEntry())
+			},
+		},
+		{
+			name: "NotThePayer",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: fromAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(pending, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, requester.Username, requester.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "UnauthorizedAccount",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: toAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(pending, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "EmailNotQueued",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: fromAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(pending, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.AcceptPaymentRequestTxParams) (db.AcceptPaymentRequestTxResult, error) {
+						// the payment still commits when the emails can't be queued
+						require.NoError(t, arg.AfterAccept(accepted))
+						return db.AcceptPaymentRequestTxResult{
+							PaymentRequest: accepted,
+							Transfer:       db.TransferTxResult{Transfer: transfer, FromAccount: fromAccount},
+						}, nil
+					})
+				taskDistributor.EXPECT().
+					DistributeTaskSendPaymentRequestEmail(gomock.Any(), gomock.Any(), gomock.Any()).
+					Times(2).
+					Return(fmt.Errorf("redis unavailable"))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.PaymentRequestAccepted, res.GetPaymentRequest().GetStatus())
+			},
+		},
+		{
+			name: "NewPayeeLimitExceeded",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: fromAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(pending, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, fmt.Errorf("%w: at most 50 can be sent", db.ErrNewPayeeLimit))
+				taskDistributor.EXPECT().DistributeTaskSendPaymentRequestEmail(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "CurrencyMismatch",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: fromAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				other := fromAccount
+				other.Currency = util.EUR
+				if toAccount.Currency == util.EUR {
+					other.Currency = util.USD
+				}
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(pending, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(other, nil)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "Expired",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: fromAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(pending, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, fmt.Errorf("%w: payment request %d", db.ErrPaymentRequestExpired, pending.ID))
+				taskDistributor.EXPECT().DistributeTaskSendPaymentRequestEmail(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: fromAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(pending, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().
+					AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.AcceptPaymentRequestTxResult{}, db.ErrInsufficientFunds)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "NotFound",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: fromAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Eq(pending.ID)).Times(1).Return(db.PaymentRequest{}, sql.ErrNoRows)
+				store.EXPECT().AcceptPaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, payer.Username, payer.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.AcceptPaymentRequestRequest{Id: pending.ID, FromAccountId: fromAccount.ID},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetPaymentRequest(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.AcceptPaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+
+			server := newTestServer(t, store, taskDistributor)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.AcceptPaymentRequest(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ApproveRiskReview(ctx context.Context, req *pb.ApproveRiskReviewRequest) (*pb.ApproveRiskReviewResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateApproveRiskReviewRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var decision db.RiskDecision
+	err = server.audit(ctx, authPayload, auditApproveRiskReview, riskReviewTarget(req.GetId()), req, func(q db.Querier) error {
+		var err error
+		decision, err = db.ApproveRiskReview(ctx, q, db.ReviewRiskTxParams{
+			ID:         req.GetId(),
+			ReviewedBy: authPayload.Username,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, riskReviewError(err)
+	}
+
+	rsp := &pb.ApproveRiskReviewResponse{
+		Review: convertRiskDecision(decision),
+	}
+	return rsp, nil
+}
+
+func validateApproveRiskReviewRequest(req *pb.ApproveRiskReviewRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateRiskDecisionID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
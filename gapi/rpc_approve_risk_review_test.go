@@ -0,0 +1,198 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func randomRiskReview(t *testing.T, operation string, account db.Account, request interface{}) db.RiskDecision {
+	body, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	return db.RiskDecision{
+		ID:           util.RandomInt(1, 1000),
+		Username:     account.Owner,
+		Operation:    operation,
+		AccountID:    account.ID,
+		Amount:       util.RandomMoney(),
+		Currency:     account.Currency,
+		Action:       util.RiskReview,
+		Rules:        []string{"review_amount"},
+		Request:      body,
+		ReviewStatus: sql.NullString{String: util.RiskReviewPending, Valid: true},
+		CreatedAt:    time.Now(),
+	}
+}
+
+func TestApproveRiskReviewAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+	admin.Role = util.AdminRole
+
+	customer, _ := randomUser(t)
+	account := randomAccount(customer.Username)
+	account.Status = util.AccountActive
+
+	held := randomRiskReview(t, util.RiskDeposit, account, db.DepositTxParams{
+		AccountID: account.ID,
+		Amount:    100,
+	})
+	entry := db.Entry{ID: util.RandomInt(1, 1000), AccountID: account.ID, Amount: 100}
+
+	approved := held
+	approved.ReviewStatus = sql.NullString{String: util.RiskReviewApproved, Valid: true}
+	approved.ReviewedBy = sql.NullString{String: admin.Username, Valid: true}
+	approved.ReviewedAt = sql.NullTime{Time: time.Now(), Valid: true}
+	approved.EntryID = sql.NullInt64{Int64: entry.ID, Valid: true}
+
+	testCases := []struct {
+		name          string
+		req           *pb.ApproveRiskReviewRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ApproveRiskReviewResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ApproveRiskReviewRequest{Id: held.ID, Reason: "customer confirmed by phone"},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditApproveRiskReview, riskReviewTarget(held.ID))
+
+				store.EXPECT().GetRiskDecisionForUpdate(gomock.Any(), gomock.Eq(held.ID)).Times(1).Return(held, nil)
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(1).Return(entry, nil)
+				store.EXPECT().CreateJournal(gomock.Any(), gomock.Any()).Times(1).Return(db.Journal{ID: 1, Kind: db.JournalDeposit}, nil)
+				store.EXPECT().GetSystemAccount(gomock.Any(), gomock.Any()).Times(1).Return(db.SystemAccount{ID: 1}, nil)
+				store.EXPECT().CreatePosting(gomock.Any(), gomock.Any()).Times(2)
+				store.EXPECT().AddAccountBalance(gomock.Any(), gomock.Eq(db.AddAccountBalanceParams{
+					ID:     account.ID,
+					Amount: 100,
+				})).Times(1).Return(account, nil)
+
+				arg := db.ReviewRiskDecisionParams{
+					ID:           held.ID,
+					ReviewStatus: sql.NullString{String: util.RiskReviewApproved, Valid: true},
+					ReviewedBy:   sql.NullString{String: admin.Username, Valid: true},
+					EntryID:      sql.NullInt64{Int64: entry.ID, Valid: true},
+				}
+				store.EXPECT().ReviewRiskDecision(gomock.Any(), gomock.Eq(arg)).Times(1).Return(approved, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveRiskReviewResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, util.RiskReviewApproved, res.GetReview().GetReviewStatus())
+				require.Equal(t, admin.Username, res.GetReview().GetReviewedBy())
+				require.Equal(t, entry.ID, res.GetReview().GetEntryId())
+				require.Equal(t, []string{"review_amount"}, res.GetReview().GetRules())
+			},
+		},
+		{
+			name: "AlreadyReviewed",
+			req:  &pb.ApproveRiskReviewRequest{Id: held.ID, Reason: "customer confirmed by phone"},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditApproveRiskReview, riskReviewTarget(held.ID))
+
+				store.EXPECT().GetRiskDecisionForUpdate(gomock.Any(), gomock.Eq(held.ID)).Times(1).Return(approved, nil)
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReviewRiskDecision(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveRiskReviewResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "AccountFrozen",
+			req:  &pb.ApproveRiskReviewRequest{Id: held.ID, Reason: "customer confirmed by phone"},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditApproveRiskReview, riskReviewTarget(held.ID))
+
+				frozen := account
+				frozen.Status = util.AccountFrozen
+				store.EXPECT().GetRiskDecisionForUpdate(gomock.Any(), gomock.Eq(held.ID)).Times(1).Return(held, nil)
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(frozen, nil)
+				store.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReviewRiskDecision(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveRiskReviewResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "NotFound",
+			req:  &pb.ApproveRiskReviewRequest{Id: held.ID, Reason: "customer confirmed by phone"},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditApproveRiskReview, riskReviewTarget(held.ID))
+
+				store.EXPECT().GetRiskDecisionForUpdate(gomock.Any(), gomock.Eq(held.ID)).Times(1).Return(db.RiskDecision{}, sql.ErrNoRows)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveRiskReviewResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "MissingReason",
+			req:  &pb.ApproveRiskReviewRequest{Id: held.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveRiskReviewResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.ApproveRiskReviewRequest{Id: held.ID, Reason: "customer confirmed by phone"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ApproveRiskReviewResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ApproveRiskReview(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) BlockUserSessions(ctx context.Context, req *pb.BlockUserSessionsRequest) (*pb.BlockUserSessionsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateBlockUserSessionsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var blocked int64
+	err = server.audit(ctx, authPayload, auditBlockSessions, userTarget(req.GetUsername()), req, func(q db.Querier) error {
+		_, err := q.GetUser(ctx, req.GetUsername())
+		if err != nil {
+			return err
+		}
+
+		if req.SessionId == nil {
+			blocked, err = q.BlockUserSessions(ctx, req.GetUsername())
+			return err
+		}
+
+		_, err = q.BlockSession(ctx, db.BlockSessionParams{
+			ID:       uuid.MustParse(req.GetSessionId()),
+			Username: req.GetUsername(),
+		})
+		blocked = 1
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "user or session not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to block sessions: %s", err)
+	}
+
+	rsp := &pb.BlockUserSessionsResponse{
+		BlockedSessions: blocked,
+	}
+	return rsp, nil
+}
+
+func validateBlockUserSessionsRequest(req *pb.BlockUserSessionsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateUsername(req.GetUsername()); err != nil {
+		violations = append(violations, fieldViolation("username", err))
+	}
+
+	if req.SessionId != nil {
+		if err := val.ValidateSessionID(req.GetSessionId()); err != nil {
+			violations = append(violations, fieldViolation("session_id", err))
+		}
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
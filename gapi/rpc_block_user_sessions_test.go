@@ -0,0 +1,133 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestBlockUserSessionsAPI(t *testing.T) {
+	staff, _ := randomUser(t)
+	staff.Role = util.AdminRole
+
+	customer, _ := randomUser(t)
+	sessionID := uuid.New().String()
+	invalidSessionID := "not a uuid"
+
+	testCases := []struct {
+		name          string
+		req           *pb.BlockUserSessionsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.BlockUserSessionsResponse, err error)
+	}{
+		{
+			name: "AllSessions",
+			req: &pb.BlockUserSessionsRequest{
+				Username: customer.Username,
+				Reason:   "account takeover",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, staff.Username, auditBlockSessions, userTarget(customer.Username))
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(customer.Username)).Times(1).Return(customer, nil)
+				store.EXPECT().BlockUserSessions(gomock.Any(), gomock.Eq(customer.Username)).Times(1).Return(int64(3), nil)
+				store.EXPECT().BlockSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.BlockUserSessionsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(3), res.GetBlockedSessions())
+			},
+		},
+		{
+			name: "OneSession",
+			req: &pb.BlockUserSessionsRequest{
+				Username:  customer.Username,
+				SessionId: &sessionID,
+				Reason:    "lost device",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, staff.Username, auditBlockSessions, userTarget(customer.Username))
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(customer.Username)).Times(1).Return(customer, nil)
+				arg := db.BlockSessionParams{
+					ID:       uuid.MustParse(sessionID),
+					Username: customer.Username,
+				}
+				store.EXPECT().BlockSession(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.Session{}, nil)
+				store.EXPECT().BlockUserSessions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.BlockUserSessionsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(1), res.GetBlockedSessions())
+			},
+		},
+		{
+			name: "UserNotFound",
+			req: &pb.BlockUserSessionsRequest{
+				Username: customer.Username,
+				Reason:   "account takeover",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, staff.Username, auditBlockSessions, userTarget(customer.Username))
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(customer.Username)).Times(1).Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().BlockUserSessions(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.BlockUserSessionsResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "InvalidSessionID",
+			req: &pb.BlockUserSessionsRequest{
+				Username:  customer.Username,
+				SessionId: &invalidSessionID,
+				Reason:    "lost device",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.BlockUserSessionsResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.BlockUserSessions(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) CaptureHold(ctx context.Context, req *pb.CaptureHoldRequest) (*pb.CaptureHoldResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCaptureHoldRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	hold, account, err := server.getHoldOfUser(ctx, authPayload.Username, req.GetHoldId())
+	if err != nil {
+		return nil, err
+	}
+
+	amount := hold.Amount
+	if req.Amount != nil {
+		amount = req.GetAmount()
+	}
+
+	// the hold could expire while a review is pending, so a review denies the capture
+	_, decision, err := server.screenRisk(ctx, risk.Operation{
+		Kind:      util.RiskWithdrawal,
+		Username:  authPayload.Username,
+		AccountID: account.ID,
+		Amount:    amount,
+		Currency:  account.Currency,
+	}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.CaptureHoldTx(ctx, db.CaptureHoldTxParams{
+		HoldID:       req.GetHoldId(),
+		Amount:       req.GetAmount(),
+		RiskDecision: decision,
+	})
+	if err != nil {
+		return nil, holdError(err)
+	}
+
+	rsp := &pb.CaptureHoldResponse{
+		Hold:    convertHold(result.Hold),
+		Account: convertAccount(result.Account),
+		Entry:   convertEntry(result.Entry),
+		Fee:     convertFeeQuote(result.Fee, result.Account.Currency),
+	}
+	if result.FeeEntry.ID != 0 {
+		rsp.FeeEntry = convertEntry(result.FeeEntry)
+	}
+	return rsp, nil
+}
+
+func validateCaptureHoldRequest(req *pb.CaptureHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateHoldID(req.GetHoldId()); err != nil {
+		violations = append(violations, fieldViolation("hold_id", err))
+	}
+
+	if req.Amount != nil {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,51 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// CloseAccount closes an active account whose balance is zero and that has no active
+// holds, scheduled transfers out of it or pending payment requests into it. Accounts are
+// never deleted, so their entries and transfers stay available, and admins can reopen them.
+func (server *Server) CloseAccount(ctx context.Context, req *pb.CloseAccountRequest) (*pb.CloseAccountResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCloseAccountRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.checkAccountOwner(ctx, req.GetAccountId(), authPayload.Username); err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.ChangeAccountStatusTx(ctx, db.ChangeAccountStatusTxParams{
+		AccountID: req.GetAccountId(),
+		Status:    util.AccountClosed,
+	})
+	if err != nil {
+		return nil, accountStatusError(err)
+	}
+
+	rsp := &pb.CloseAccountResponse{
+		Account: convertAccount(result.Account),
+	}
+	return rsp, nil
+}
+
+func validateCloseAccountRequest(req *pb.CloseAccountRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	return violations
+}
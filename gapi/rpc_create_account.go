@@ -5,6 +5,7 @@ import (
 
 	db "github.com/JaidenShall/simplebank/db/sqlc"
 	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
 	"github.com/JaidenShall/simplebank/val"
 	"github.com/lib/pq"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
@@ -27,6 +28,10 @@ func (server *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRe
 		Owner:    authPayload.Username,
 		Currency: req.GetCurrency(),
 		Balance:  0,
+		Type:     util.AccountChecking,
+	}
+	if req.Type != nil {
+		arg.Type = req.GetType()
 	}
 
 	account, err := server.store.CreateAccount(ctx, arg)
@@ -34,7 +39,7 @@ func (server *Server) CreateAccount(ctx context.Context, req *pb.CreateAccountRe
 		if pqErr, ok := err.(*pq.Error); ok {
 			switch pqErr.Code.Name() {
 			case "foreign_key_violation", "unique_violation":
-				return nil, status.Errorf(codes.AlreadyExists, "account already exists for this currency: %s", err)
+				return nil, status.Errorf(codes.AlreadyExists, "account already exists for this currency and type: %s", err)
 			}
 		}
 		return nil, status.Errorf(codes.Internal, "failed to create account: %s", err)
@@ -51,5 +56,11 @@ func validateCreateAccountRequest(req *pb.CreateAccountRequest) (violations []*e
 		violations = append(violations, fieldViolation("currency", err))
 	}
 
+	if req.Type != nil {
+		if err := val.ValidateAccountType(req.GetType()); err != nil {
+			violations = append(violations, fieldViolation("type", err))
+		}
+	}
+
 	return violations
 }
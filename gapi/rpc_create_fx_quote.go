@@ -0,0 +1,77 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"github.com/google/uuid"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateFxQuote(ctx context.Context, req *pb.CreateFxQuoteRequest) (*pb.CreateFxQuoteResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCurrencyPair(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	rate, err := server.store.GetFxRate(ctx, db.GetFxRateParams{
+		FromCurrency: req.GetFromCurrency(),
+		ToCurrency:   req.GetToCurrency(),
+	})
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "no fx rate from %s to %s", req.GetFromCurrency(), req.GetToCurrency())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get fx rate: %s", err)
+	}
+
+	// the quote copies the rate so later rate changes don't affect it
+	quote, err := server.store.CreateFxQuote(ctx, db.CreateFxQuoteParams{
+		ID:           uuid.New(),
+		Username:     authPayload.Username,
+		FromCurrency: rate.FromCurrency,
+		ToCurrency:   rate.ToCurrency,
+		Rate:         rate.Rate,
+		ExpiresAt:    time.Now().Add(server.config.FxQuoteDuration),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create fx quote: %s", err)
+	}
+
+	rsp := &pb.CreateFxQuoteResponse{
+		Quote: convertFxQuote(quote),
+	}
+	return rsp, nil
+}
+
+// currencyPairRequest is implemented by the requests naming a pair of currencies
+type currencyPairRequest interface {
+	GetFromCurrency() string
+	GetToCurrency() string
+}
+
+func validateCurrencyPair(req currencyPairRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateCurrency(req.GetFromCurrency()); err != nil {
+		violations = append(violations, fieldViolation("from_currency", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetToCurrency()); err != nil {
+		violations = append(violations, fieldViolation("to_currency", err))
+	} else if req.GetToCurrency() == req.GetFromCurrency() {
+		violations = append(violations, fieldViolation("to_currency", fmt.Errorf("must differ from from_currency")))
+	}
+
+	return violations
+}
@@ -0,0 +1,140 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateFxQuoteAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	rate := db.FxRate{
+		FromCurrency: util.USD,
+		ToCurrency:   util.EUR,
+		Rate:         91_734_000,
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateFxQuoteRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateFxQuoteResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateFxQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetFxRate(gomock.Any(), gomock.Eq(db.GetFxRateParams{
+					FromCurrency: util.USD,
+					ToCurrency:   util.EUR,
+				})).Times(1).Return(rate, nil)
+				store.EXPECT().CreateFxQuote(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateFxQuoteParams) (db.FxQuote, error) {
+						require.Equal(t, user.Username, arg.Username)
+						require.Equal(t, rate.Rate, arg.Rate)
+						require.WithinDuration(t, time.Now().Add(time.Minute), arg.ExpiresAt, time.Second)
+						return db.FxQuote{
+							ID:           arg.ID,
+							Username:     arg.Username,
+							FromCurrency: arg.FromCurrency,
+							ToCurrency:   arg.ToCurrency,
+							Rate:         arg.Rate,
+							ExpiresAt:    arg.ExpiresAt,
+						}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFxQuoteResponse, err error) {
+				require.NoError(t, err)
+				quote := res.GetQuote()
+				require.NotEmpty(t, quote.GetId())
+				require.Equal(t, util.USD, quote.GetFromCurrency())
+				require.Equal(t, util.EUR, quote.GetToCurrency())
+				require.Equal(t, "0.91734", quote.GetRate())
+			},
+		},
+		{
+			name: "RateNotFound",
+			req: &pb.CreateFxQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.CAD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetFxRate(gomock.Any(), gomock.Any()).Times(1).Return(db.FxRate{}, sql.ErrNoRows)
+				store.EXPECT().CreateFxQuote(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFxQuoteResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "SameCurrency",
+			req: &pb.CreateFxQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFxQuoteResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.CreateFxQuoteRequest{
+				FromCurrency: util.USD,
+				ToCurrency:   util.EUR,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetFxRate(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateFxQuoteResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateFxQuote(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
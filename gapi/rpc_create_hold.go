@@ -0,0 +1,76 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateHold(ctx context.Context, req *pb.CreateHoldRequest) (*pb.CreateHoldResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+
+	violations := validateCreateHoldRequest(req)
+	violations = append(violations, validateIdempotencyKey(key)...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.store.GetAccount(ctx, req.GetAccountId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to find account")
+	}
+
+	if account.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+	}
+
+	idempotency := newIdempotencyParams(authPayload.Username, key, "CreateHold",
+		req.GetAccountId(), req.GetAmount())
+
+	result, err := server.store.CreateHoldTx(ctx, db.CreateHoldTxParams{
+		AccountID:   req.GetAccountId(),
+		Amount:      req.GetAmount(),
+		ExpiresAt:   time.Now().Add(server.config.HoldDuration),
+		Idempotency: idempotency,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrIdempotencyKeyReused) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, holdError(err)
+	}
+
+	rsp := &pb.CreateHoldResponse{
+		Hold:             convertHold(result.Hold),
+		AvailableBalance: result.AvailableBalance,
+	}
+	return rsp, nil
+}
+
+func validateCreateHoldRequest(req *pb.CreateHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,71 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreatePayee(ctx context.Context, req *pb.CreatePayeeRequest) (*pb.CreatePayeeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateCreatePayeeRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	account, err := server.store.GetAccount(ctx, req.GetAccountId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if account.Status == util.AccountClosed {
+		return nil, status.Errorf(codes.FailedPrecondition, "account %d is closed", account.ID)
+	}
+
+	name, err := server.recipientName(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+
+	payee, err := server.store.CreatePayee(ctx, db.CreatePayeeParams{
+		Owner:     authPayload.Username,
+		AccountID: account.ID,
+		Nickname:  strings.TrimSpace(req.GetNickname()),
+	})
+	if err != nil {
+		return nil, payeeError(err)
+	}
+
+	rsp := &pb.CreatePayeeResponse{
+		Payee: convertPayee(payee, account.Currency, name),
+	}
+	return rsp, nil
+}
+
+func validateCreatePayeeRequest(req *pb.CreatePayeeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateNickname(req.GetNickname()); err != nil {
+		violations = append(violations, fieldViolation("nickname", err))
+	}
+
+	return violations
+}
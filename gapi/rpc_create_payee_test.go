@@ -0,0 +1,208 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreatePayeeAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	recipient, _ := randomUser(t)
+	recipient.FullName = "Jaiden Shall"
+
+	account := randomAccount(recipient.Username)
+	account.Status = util.AccountActive
+
+	payee := db.Payee{
+		ID:        util.RandomInt(1, 1000),
+		Owner:     user.Username,
+		AccountID: account.ID,
+		Nickname:  "landlord",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreatePayeeRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreatePayeeResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.CreatePayeeRequest{AccountId: account.ID, Nickname: " landlord "},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(recipient.Username)).Times(1).Return(recipient, nil)
+
+				arg := db.CreatePayeeParams{
+					Owner:     user.Username,
+					AccountID: account.ID,
+					Nickname:  "landlord",
+				}
+				store.EXPECT().CreatePayee(gomock.Any(), gomock.Eq(arg)).Times(1).Return(payee, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayeeResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, payee.ID, res.GetPayee().GetId())
+				require.Equal(t, "landlord", res.GetPayee().GetNickname())
+				require.Equal(t, "J*** S***", res.GetPayee().GetOwnerName())
+				require.Equal(t, account.Currency, res.GetPayee().GetCurrency())
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req:  &pb.CreatePayeeRequest{AccountId: account.ID, Nickname: "landlord"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().CreatePayee(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayeeResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "AccountClosed",
+			req:  &pb.CreatePayeeRequest{AccountId: account.ID, Nickname: "landlord"},
+			buildStubs: func(store *mockdb.MockStore) {
+				closed := account
+				closed.Status = util.AccountClosed
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(closed, nil)
+				store.EXPECT().CreatePayee(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayeeResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "AlreadySaved",
+			req:  &pb.CreatePayeeRequest{AccountId: account.ID, Nickname: "landlord"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(recipient.Username)).Times(1).Return(recipient, nil)
+				store.EXPECT().CreatePayee(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.Payee{}, &pq.Error{Code: "23505"})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayeeResponse, err error) {
+				requireStatusCode(t, err, codes.AlreadyExists)
+			},
+		},
+		{
+			name: "InvalidNickname",
+			req:  &pb.CreatePayeeRequest{AccountId: account.ID, Nickname: "   "},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePayee(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayeeResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.CreatePayeeRequest{AccountId: account.ID, Nickname: "landlord"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreatePayee(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePayeeResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreatePayee(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestGetAndListPayeesRecipientName(t *testing.T) {
+	user, _ := randomUser(t)
+	recipient, _ := randomUser(t)
+	recipient.FullName = "Jaiden Shall"
+
+	account := randomAccount(recipient.Username)
+	payee := db.Payee{
+		ID:        util.RandomInt(1, 1000),
+		Owner:     user.Username,
+		AccountID: account.ID,
+		Nickname:  "landlord",
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	store.EXPECT().GetPayee(gomock.Any(), gomock.Eq(payee.ID)).Times(1).Return(payee, nil)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+	store.EXPECT().GetUser(gomock.Any(), gomock.Eq(recipient.Username)).Times(1).Return(recipient, nil)
+	store.EXPECT().ListPayees(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return([]db.ListPayeesRow{{
+		ID:        payee.ID,
+		Owner:     payee.Owner,
+		AccountID: payee.AccountID,
+		Nickname:  payee.Nickname,
+		CreatedAt: payee.CreatedAt,
+		UpdatedAt: payee.UpdatedAt,
+		Currency:  account.Currency,
+		FullName:  recipient.FullName,
+	}}, nil)
+
+	server := newTestServer(t, store, nil)
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+
+	// the user sees who they pay before any money moves
+	getRes, err := server.GetPayee(ctx, &pb.GetPayeeRequest{Id: payee.ID})
+	require.NoError(t, err)
+	require.Equal(t, "J*** S***", getRes.GetPayee().GetOwnerName())
+
+	listRes, err := server.ListPayees(ctx, &pb.ListPayeesRequest{})
+	require.NoError(t, err)
+	require.Len(t, listRes.GetPayees(), 1)
+	require.Equal(t, getRes.GetPayee().GetOwnerName(), listRes.GetPayees()[0].GetOwnerName())
+}
@@ -0,0 +1,154 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreatePaymentRequest(ctx context.Context, req *pb.CreatePaymentRequestRequest) (*pb.CreatePaymentRequestResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	now := time.Now()
+	violations := validateCreatePaymentRequestRequest(req, now, server.config.PaymentRequestDuration)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	toAccount, err := server.store.GetAccount(ctx, req.GetToAccountId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "to account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get to account: %s", err)
+	}
+
+	if toAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "to account doesn't belong to the authenticated user")
+	}
+
+	if toAccount.Currency != req.GetCurrency() {
+		return nil, status.Errorf(codes.InvalidArgument, "to account currency mismatch: %s vs %s", toAccount.Currency, req.GetCurrency())
+	}
+
+	if toAccount.Status != util.AccountActive {
+		return nil, status.Errorf(codes.FailedPrecondition, "account %d is %s", toAccount.ID, toAccount.Status)
+	}
+
+	payer, err := server.paymentRequestPayer(ctx, authPayload.Username, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if payer == authPayload.Username {
+		return nil, status.Errorf(codes.InvalidArgument, "can't request money from yourself")
+	}
+
+	expiresAt := now.Add(server.config.PaymentRequestDuration)
+	if req.ExpiresAt != nil {
+		expiresAt = req.GetExpiresAt().AsTime()
+	}
+
+	result, err := server.store.CreatePaymentRequestTx(ctx, db.CreatePaymentRequestTxParams{
+		CreatePaymentRequestParams: db.CreatePaymentRequestParams{
+			Requester:   authPayload.Username,
+			Payer:       payer,
+			ToAccountID: toAccount.ID,
+			Amount:      req.GetAmount(),
+			Currency:    toAccount.Currency,
+			Memo:        req.GetMemo(),
+			ExpiresAt:   expiresAt,
+		},
+		AfterCreate: server.notifyPaymentRequest(ctx),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create payment request: %s", err)
+	}
+
+	rsp := &pb.CreatePaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(result.PaymentRequest),
+	}
+	return rsp, nil
+}
+
+// paymentRequestPayer returns the username of the user asked to pay, either named
+// or the owner of a saved payee's account
+func (server *Server) paymentRequestPayer(ctx context.Context, username string, req *pb.CreatePaymentRequestRequest) (string, error) {
+	if req.PayeeId != nil {
+		payee, err := server.getOwnPayee(ctx, username, req.GetPayeeId())
+		if err != nil {
+			return "", err
+		}
+
+		account, err := server.store.GetAccount(ctx, payee.AccountID)
+		if err != nil {
+			return "", status.Errorf(codes.Internal, "failed to get payee account: %s", err)
+		}
+		return account.Owner, nil
+	}
+
+	payer, err := server.store.GetUser(ctx, req.GetPayerUsername())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", status.Errorf(codes.NotFound, "payer not found")
+		}
+		return "", status.Errorf(codes.Internal, "failed to get payer: %s", err)
+	}
+	return payer.Username, nil
+}
+
+func validateCreatePaymentRequestRequest(req *pb.CreatePaymentRequestRequest, now time.Time, maxDuration time.Duration) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	}
+
+	if req.PayeeId != nil {
+		if err := val.ValidatePayeeID(req.GetPayeeId()); err != nil {
+			violations = append(violations, fieldViolation("payee_id", err))
+		}
+		if req.GetPayerUsername() != "" {
+			violations = append(violations, fieldViolation("payer_username", fmt.Errorf("must be left out when asking a payee")))
+		}
+	} else if err := val.ValidateUsername(req.GetPayerUsername()); err != nil {
+		violations = append(violations, fieldViolation("payer_username", err))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if req.GetMemo() != "" {
+		if err := val.ValidateMemo(req.GetMemo()); err != nil {
+			violations = append(violations, fieldViolation("memo", err))
+		}
+	}
+
+	if req.ExpiresAt != nil {
+		if err := req.GetExpiresAt().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("expires_at", err))
+		} else if !req.GetExpiresAt().AsTime().After(now) {
+			violations = append(violations, fieldViolation("expires_at", fmt.Errorf("must be in the future")))
+		} else if req.GetExpiresAt().AsTime().After(now.Add(maxDuration)) {
+			violations = append(violations, fieldViolation("expires_at", fmt.Errorf("must be within %s", maxDuration)))
+		}
+	}
+
+	return violations
+}
@@ -0,0 +1,268 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/worker"
+	mockwk "github.com/JaidenShall/simplebank/worker/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func randomPaymentRequest(requester string, payer string, toAccount db.Account) db.PaymentRequest {
+	return db.PaymentRequest{
+		ID:          util.RandomInt(1, 1000),
+		Requester:   requester,
+		Payer:       payer,
+		ToAccountID: toAccount.ID,
+		Amount:      util.RandomInt(1, 100),
+		Currency:    toAccount.Currency,
+		Memo:        "dinner",
+		Status:      util.PaymentRequestPending,
+		ExpiresAt:   time.Now().Add(time.Hour),
+		CreatedAt:   time.Now(),
+		UpdatedAt:   time.Now(),
+	}
+}
+
+// expectPaymentRequestEmails expects an email about the status to both users of the request
+func expectPaymentRequestEmails(taskDistributor *mockwk.MockTaskDistributor, request db.PaymentRequest, status string) {
+	for _, username := range []string{request.Requester, request.Payer} {
+		taskDistributor.EXPECT().
+			DistributeTaskSendPaymentRequestEmail(gomock.Any(), gomock.Eq(&worker.PayloadSendPaymentRequestEmail{
+				PaymentRequestID: request.ID,
+				Status:           status,
+				Username:         username,
+			}), gomock.Any()).
+			Times(1)
+	}
+}
+
+func TestCreatePaymentRequestAPI(t *testing.T) {
+	requester, _ := randomUser(t)
+	payer, _ := randomUser(t)
+
+	account := randomAccount(requester.Username)
+	account.Status = util.AccountActive
+	payerAccount := randomAccount(payer.Username)
+	payee := db.Payee{ID: util.RandomInt(1, 1000), Owner: requester.Username, AccountID: payerAccount.ID}
+
+	created := randomPaymentRequest(requester.Username, payer.Username, account)
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreatePaymentRequestRequest
+		buildStubs    func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreatePaymentRequestResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreatePaymentRequestRequest{
+				ToAccountId:   account.ID,
+				PayerUsername: payer.Username,
+				Amount:        created.Amount,
+				Currency:      account.Currency,
+				Memo:          "dinner",
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payer.Username)).Times(1).Return(payer, nil)
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreatePaymentRequestTxParams) (db.CreatePaymentRequestTxResult, error) {
+						require.Equal(t, requester.Username, arg.Requester)
+						require.Equal(t, payer.Username, arg.Payer)
+						require.Equal(t, account.ID, arg.ToAccountID)
+						require.Equal(t, "dinner", arg.Memo)
+						require.WithinDuration(t, time.Now().Add(24*time.Hour), arg.ExpiresAt, time.Minute)
+						return db.CreatePaymentRequestTxResult{PaymentRequest: created}, arg.AfterCreate(created)
+					})
+				expectPaymentRequestEmails(taskDistributor, created, util.PaymentRequestPending)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, requester.Username, requester.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentRequestResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, created.ID, res.GetPaymentRequest().GetId())
+				require.Equal(t, payer.Username, res.GetPaymentRequest().GetPayer())
+				require.Equal(t, util.PaymentRequestPending, res.GetPaymentRequest().GetStatus())
+			},
+		},
+		{
+			name: "Payee",
+			req: &pb.CreatePaymentRequestRequest{
+				ToAccountId: account.ID,
+				PayeeId:     &payee.ID,
+				Amount:      created.Amount,
+				Currency:    account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetPayee(gomock.Any(), gomock.Eq(payee.ID)).Times(1).Return(payee, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(payerAccount.ID)).Times(1).Return(payerAccount, nil)
+				store.EXPECT().
+					CreatePaymentRequestTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreatePaymentRequestTxParams) (db.CreatePaymentRequestTxResult, error) {
+						require.Equal(t, payer.Username, arg.Payer)
+						return db.CreatePaymentRequestTxResult{PaymentRequest: created}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, requester.Username, requester.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentRequestResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "PayerNotFound",
+			req: &pb.CreatePaymentRequestRequest{
+				ToAccountId:   account.ID,
+				PayerUsername: payer.Username,
+				Amount:        created.Amount,
+				Currency:      account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(payer.Username)).Times(1).Return(db.User{}, sql.ErrNoRows)
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, requester.Username, requester.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "RequestFromSelf",
+			req: &pb.CreatePaymentRequestRequest{
+				ToAccountId:   account.ID,
+				PayerUsername: requester.Username,
+				Amount:        created.Amount,
+				Currency:      account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(requester.Username)).Times(1).Return(requester, nil)
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, requester.Username, requester.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "UnauthorizedAccount",
+			req: &pb.CreatePaymentRequestRequest{
+				ToAccountId:   payerAccount.ID,
+				PayerUsername: payer.Username,
+				Amount:        created.Amount,
+				Currency:      payerAccount.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(payerAccount.ID)).Times(1).Return(payerAccount, nil)
+				store.EXPECT().CreatePaymentRequestTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, requester.Username, requester.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "ExpiresTooLate",
+			req: &pb.CreatePaymentRequestRequest{
+				ToAccountId:   account.ID,
+				PayerUsername: payer.Username,
+				Amount:        created.Amount,
+				Currency:      account.Currency,
+				ExpiresAt:     timestamppb.New(time.Now().Add(48 * time.Hour)),
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, requester.Username, requester.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "PayerAndPayee",
+			req: &pb.CreatePaymentRequestRequest{
+				ToAccountId:   account.ID,
+				PayerUsername: payer.Username,
+				PayeeId:       &payee.ID,
+				Amount:        created.Amount,
+				Currency:      account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, requester.Username, requester.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.CreatePaymentRequestRequest{
+				ToAccountId:   account.ID,
+				PayerUsername: payer.Username,
+				Amount:        created.Amount,
+				Currency:      account.Currency,
+			},
+			buildStubs: func(store *mockdb.MockStore, taskDistributor *mockwk.MockTaskDistributor) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CreatePaymentRequestResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			taskCtrl := gomock.NewController(t)
+			defer taskCtrl.Finish()
+			taskDistributor := mockwk.NewMockTaskDistributor(taskCtrl)
+
+			tc.buildStubs(store, taskDistributor)
+
+			server := newTestServer(t, store, taskDistributor)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreatePaymentRequest(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,175 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateScheduledTransfer(ctx context.Context, req *pb.CreateScheduledTransferRequest) (*pb.CreateScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	now := time.Now()
+	violations := validateCreateScheduledTransferRequest(req, now)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.store.GetAccount(ctx, req.GetFromAccountId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "from account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get from account: %s", err)
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	toAccount, err := server.store.GetAccount(ctx, req.GetToAccountId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "to account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get to account: %s", err)
+	}
+
+	// scheduled transfers don't go through fx quotes
+	if toAccount.Currency != fromAccount.Currency {
+		return nil, status.Errorf(codes.InvalidArgument, "to account currency mismatch: %s vs %s", toAccount.Currency, fromAccount.Currency)
+	}
+
+	recurrence := util.Recurrence{
+		Frequency:  req.GetFrequency(),
+		Interval:   1,
+		DayOfMonth: req.GetDayOfMonth(),
+	}
+	if req.Interval != nil {
+		recurrence.Interval = req.GetInterval()
+	}
+
+	startAt := now
+	if req.StartAt != nil {
+		startAt = req.GetStartAt().AsTime()
+	}
+	first := recurrence.First(startAt)
+
+	var endAt sql.NullTime
+	if req.EndAt != nil {
+		endAt = sql.NullTime{Time: req.GetEndAt().AsTime(), Valid: true}
+		if endAt.Time.Before(first) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+				fieldViolation("end_at", fmt.Errorf("must not be before the first occurrence at %s", first.Format(time.RFC3339))),
+			})
+		}
+	}
+
+	// nobody waits on a review of a schedule, so a review denies it. Nothing moves yet,
+	// so an allowed schedule records no decision, each run is screened and recorded again.
+	_, _, err = server.screenRisk(ctx, risk.Operation{
+		Kind:        util.RiskTransfer,
+		Username:    authPayload.Username,
+		AccountID:   fromAccount.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      req.GetAmount(),
+		Currency:    fromAccount.Currency,
+	}, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	failurePolicy := util.FailurePolicySkip
+	if req.FailurePolicy != nil {
+		failurePolicy = req.GetFailurePolicy()
+	}
+
+	scheduled, err := server.store.CreateScheduledTransfer(ctx, db.CreateScheduledTransferParams{
+		Owner:            authPayload.Username,
+		FromAccountID:    fromAccount.ID,
+		ToAccountID:      toAccount.ID,
+		Amount:           req.GetAmount(),
+		Frequency:        recurrence.Frequency,
+		IntervalCount:    recurrence.Interval,
+		DayOfMonth:       recurrence.DayOfMonth,
+		StartAt:          startAt,
+		EndAt:            endAt,
+		NextOccurrenceAt: sql.NullTime{Time: first, Valid: true},
+		NextRunAt:        sql.NullTime{Time: first, Valid: true},
+		FailurePolicy:    failurePolicy,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create scheduled transfer: %s", err)
+	}
+
+	rsp := &pb.CreateScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return rsp, nil
+}
+
+func validateCreateScheduledTransferRequest(req *pb.CreateScheduledTransferRequest, now time.Time) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateAccountID(req.GetToAccountId()); err != nil {
+		violations = append(violations, fieldViolation("to_account_id", err))
+	} else if req.GetToAccountId() == req.GetFromAccountId() {
+		violations = append(violations, fieldViolation("to_account_id", fmt.Errorf("must differ from from_account_id")))
+	}
+
+	if err := val.ValidateAmount(req.GetAmount()); err != nil {
+		violations = append(violations, fieldViolation("amount", err))
+	}
+
+	if err := val.ValidateFrequency(req.GetFrequency()); err != nil {
+		violations = append(violations, fieldViolation("frequency", err))
+	}
+
+	if req.Interval != nil {
+		if err := val.ValidateInterval(req.GetInterval()); err != nil {
+			violations = append(violations, fieldViolation("interval", err))
+		}
+	}
+
+	if err := val.ValidateDayOfMonth(req.GetFrequency(), req.GetDayOfMonth()); err != nil {
+		violations = append(violations, fieldViolation("day_of_month", err))
+	}
+
+	if req.StartAt != nil {
+		if err := req.GetStartAt().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("start_at", err))
+		} else if req.GetStartAt().AsTime().Before(now.Add(-time.Minute)) {
+			violations = append(violations, fieldViolation("start_at", fmt.Errorf("must not be in the past")))
+		}
+	}
+
+	if req.EndAt != nil {
+		if err := req.GetEndAt().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("end_at", err))
+		}
+	}
+
+	if req.FailurePolicy != nil {
+		if err := val.ValidateFailurePolicy(req.GetFailurePolicy()); err != nil {
+			violations = append(violations, fieldViolation("failure_policy", err))
+		}
+	}
+
+	return violations
+}
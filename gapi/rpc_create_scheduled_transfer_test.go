@@ -0,0 +1,296 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func randomScheduledTransfer(fromAccount, toAccount db.Account) db.ScheduledTransfer {
+	startAt := time.Now().Add(time.Hour)
+	return db.ScheduledTransfer{
+		ID:               util.RandomInt(1, 1000),
+		Owner:            fromAccount.Owner,
+		FromAccountID:    fromAccount.ID,
+		ToAccountID:      toAccount.ID,
+		Amount:           util.RandomInt(1, 1000),
+		Frequency:        util.FrequencyMonthly,
+		IntervalCount:    1,
+		DayOfMonth:       util.LastDayOfMonth,
+		StartAt:          startAt,
+		NextOccurrenceAt: sql.NullTime{Time: startAt, Valid: true},
+		NextRunAt:        sql.NullTime{Time: startAt, Valid: true},
+		FailurePolicy:    util.FailurePolicySkip,
+		Status:           util.ScheduleActive,
+		CreatedAt:        time.Now(),
+		UpdatedAt:        time.Now(),
+	}
+}
+
+func TestCreateScheduledTransferAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	otherUser, _ := randomUser(t)
+	fromAccount := randomAccount(user.Username)
+	toAccount := randomAccount(otherUser.Username)
+	toAccount.ID = fromAccount.ID + 1
+	toAccount.Currency = fromAccount.Currency
+	scheduled := randomScheduledTransfer(fromAccount, toAccount)
+
+	startAt := time.Now().Add(24 * time.Hour)
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateScheduledTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateScheduledTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateScheduledTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        scheduled.Amount,
+				Frequency:     util.FrequencyMonthly,
+				DayOfMonth:    util.LastDayOfMonth,
+				StartAt:       timestamppb.New(startAt),
+				FailurePolicy: proto.String(util.FailurePolicyRetry),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					CreateScheduledTransfer(gomock.Any(), gomock.Any()).
+					Times(1).
+					DoAndReturn(func(_ context.Context, arg db.CreateScheduledTransferParams) (db.ScheduledTransfer, error) {
+						first := util.Recurrence{Frequency: util.FrequencyMonthly, Interval: 1, DayOfMonth: util.LastDayOfMonth}.First(startAt)
+						require.Equal(t, user.Username, arg.Owner)
+						require.Equal(t, int32(1), arg.IntervalCount)
+						require.WithinDuration(t, startAt, arg.StartAt, time.Second)
+						require.False(t, arg.EndAt.Valid)
+						require.WithinDuration(t, first, arg.NextOccurrenceAt.Time, time.Second)
+						require.Equal(t, arg.NextOccurrenceAt, arg.NextRunAt)
+						require.Equal(t, util.FailurePolicyRetry, arg.FailurePolicy)
+						return scheduled, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateScheduledTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, scheduled.ID, res.GetScheduledTransfer().GetId())
+				require.Equal(t, util.ScheduleActive, res.GetScheduledTransfer().GetStatus())
+			},
+		},
+		{
+			name: "CurrencyMismatch",
+			req: &pb.CreateScheduledTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        scheduled.Amount,
+				Frequency:     util.FrequencyDaily,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				otherCurrencyAccount := toAccount
+				otherCurrencyAccount.Currency = fromAccount.Currency + "X"
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(otherCurrencyAccount, nil)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "EndBeforeFirstOccurrence",
+			req: &pb.CreateScheduledTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        scheduled.Amount,
+				Frequency:     util.FrequencyMonthly,
+				DayOfMonth:    util.LastDayOfMonth,
+				StartAt:       timestamppb.New(startAt),
+				EndAt:         timestamppb.New(startAt.Add(-time.Hour)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "UnauthorizedUser",
+			req: &pb.CreateScheduledTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        scheduled.Amount,
+				Frequency:     util.FrequencyDaily,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, otherUser.Username, otherUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "StartInThePast",
+			req: &pb.CreateScheduledTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        scheduled.Amount,
+				Frequency:     util.FrequencyOnce,
+				StartAt:       timestamppb.New(time.Now().Add(-time.Hour)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidDayOfMonth",
+			req: &pb.CreateScheduledTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        scheduled.Amount,
+				Frequency:     util.FrequencyMonthly,
+				DayOfMonth:    32,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "SameAccount",
+			req: &pb.CreateScheduledTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   fromAccount.ID,
+				Amount:        scheduled.Amount,
+				Frequency:     util.FrequencyDaily,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.CreateScheduledTransferRequest{
+				FromAccountId: fromAccount.ID,
+				ToAccountId:   toAccount.ID,
+				Amount:        scheduled.Amount,
+				Frequency:     util.FrequencyDaily,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateScheduledTransferResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateScheduledTransfer(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestCreateScheduledTransferRiskAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	otherUser, _ := randomUser(t)
+	fromAccount := randomAccount(user.Username)
+	toAccount := randomAccount(otherUser.Username)
+	toAccount.ID = fromAccount.ID + 1
+	toAccount.Currency = fromAccount.Currency
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+
+	// a schedule can't be held for review
+	store.EXPECT().CreateRiskDecision(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.CreateRiskDecisionParams) (db.RiskDecision, error) {
+			require.Equal(t, util.RiskTransfer, arg.Operation)
+			require.Equal(t, toAccount.ID, arg.ToAccountID.Int64)
+			require.Equal(t, util.RiskDeny, arg.Action)
+			return db.RiskDecision{ID: 1, Action: arg.Action}, nil
+		})
+	store.EXPECT().CreateScheduledTransfer(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+	server.riskEngine = risk.NewEngineFromConfig(util.Config{RiskReviewAmount: 1000})
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user.Username, user.Role, time.Minute)
+	_, err := server.CreateScheduledTransfer(ctx, &pb.CreateScheduledTransferRequest{
+		FromAccountId: fromAccount.ID,
+		ToAccountId:   toAccount.ID,
+		Amount:        1000,
+		Frequency:     util.FrequencyMonthly,
+		DayOfMonth:    1,
+	})
+	requireStatusCode(t, err, codes.PermissionDenied)
+}
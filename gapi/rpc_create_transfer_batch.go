@@ -0,0 +1,161 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) CreateTransferBatch(ctx context.Context, req *pb.CreateTransferBatchRequest) (*pb.CreateTransferBatchResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+
+	violations := validateCreateTransferBatchRequest(req, server.config.TransferBatchMaxLegs)
+	violations = append(violations, validateIdempotencyKey(key)...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	fromAccount, err := server.store.GetAccount(ctx, req.GetFromAccountId())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, status.Errorf(codes.NotFound, "from account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get from account: %s", err)
+	}
+
+	if fromAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "from account doesn't belong to the authenticated user")
+	}
+
+	if fromAccount.Currency != req.GetCurrency() {
+		return nil, status.Errorf(codes.InvalidArgument, "from account currency mismatch: %s vs %s", fromAccount.Currency, req.GetCurrency())
+	}
+
+	// Every destination is checked before anything moves, each account only once
+	legs := make([]db.TransferBatchLeg, len(req.GetLegs()))
+	checked := make(map[int64]bool, len(req.GetLegs()))
+	for i, leg := range req.GetLegs() {
+		legs[i] = db.TransferBatchLeg{
+			ToAccountID: leg.GetToAccountId(),
+			Amount:      leg.GetAmount(),
+		}
+		if checked[leg.GetToAccountId()] {
+			continue
+		}
+
+		toAccount, err := server.store.GetAccount(ctx, leg.GetToAccountId())
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "leg %d: to account not found", i)
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get to account: %s", err)
+		}
+
+		if toAccount.Currency != req.GetCurrency() {
+			return nil, status.Errorf(codes.InvalidArgument, "leg %d: to account currency mismatch: %s vs %s", i, toAccount.Currency, req.GetCurrency())
+		}
+		checked[leg.GetToAccountId()] = true
+	}
+
+	idempotency := newIdempotencyParams(authPayload.Username, key, "CreateTransferBatch",
+		req.GetFromAccountId(), req.GetCurrency(), req.GetAtomic(), legs)
+
+	// the batch is screened once as a transfer of its total, with every leg as a part, so it
+	// counts once toward the velocity rule. It can't wait on a review, so a review denies it.
+	op := risk.Operation{
+		Kind:      util.RiskTransfer,
+		Username:  authPayload.Username,
+		AccountID: fromAccount.ID,
+		Currency:  fromAccount.Currency,
+		Parts:     make([]risk.Operation, len(legs)),
+	}
+	for i, leg := range legs {
+		op.Amount += leg.Amount
+		op.Parts[i] = risk.Operation{ToAccountID: leg.ToAccountID, Amount: leg.Amount}
+	}
+
+	_, decision, err := server.screenRisk(ctx, op, nil, idempotency)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.TransferBatchTx(ctx, db.TransferBatchTxParams{
+		FromAccountID: req.GetFromAccountId(),
+		Legs:          legs,
+		Atomic:        req.GetAtomic(),
+		NewPayeeLimit: db.NewPayeeLimitFromConfig(server.config),
+		RiskDecision:  decision,
+		Idempotency:   idempotency,
+	})
+	if err != nil {
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		var notActiveErr *db.AccountNotActiveError
+		if errors.As(err, &notActiveErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		var limitErr *db.SpendingLimitError
+		if errors.As(err, &limitErr) || errors.Is(err, db.ErrNewPayeeLimit) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, db.ErrIdempotencyKeyReused) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
+		return nil, status.Errorf(codes.Internal, "transfer batch transaction failed: %s", err)
+	}
+
+	rsp := &pb.CreateTransferBatchResponse{
+		FromAccount: convertAccount(result.FromAccount),
+		Legs:        make([]*pb.TransferBatchLegResult, len(result.Legs)),
+	}
+	for i, leg := range result.Legs {
+		rsp.Legs[i] = convertTransferBatchLegResult(leg, result.FromAccount.Currency)
+	}
+
+	return rsp, nil
+}
+
+func validateCreateTransferBatchRequest(req *pb.CreateTransferBatchRequest, maxLegs int) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetFromAccountId()); err != nil {
+		violations = append(violations, fieldViolation("from_account_id", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	if len(req.GetLegs()) < 1 || len(req.GetLegs()) > maxLegs {
+		violations = append(violations, fieldViolation("legs", fmt.Errorf("must contain from 1 to %d legs", maxLegs)))
+		return violations
+	}
+
+	for i, leg := range req.GetLegs() {
+		if err := val.ValidateAccountID(leg.GetToAccountId()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].to_account_id", i), err))
+		} else if leg.GetToAccountId() == req.GetFromAccountId() {
+			violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].to_account_id", i), errors.New("must be different from the from account")))
+		}
+
+		if err := val.ValidateAmount(leg.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation(fmt.Sprintf("legs[%d].amount", i), err))
+		}
+	}
+
+	return violations
+}
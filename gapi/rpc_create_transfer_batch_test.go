@@ -0,0 +1,370 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestCreateTransferBatchAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	fromAccount := randomAccount(user1.Username)
+	fromAccount.Currency = util.USD
+	toAccount1 := randomAccount(user2.Username)
+	toAccount1.ID = fromAccount.ID + 1
+	toAccount1.Currency = util.USD
+	toAccount2 := randomAccount(user2.Username)
+	toAccount2.ID = fromAccount.ID + 2
+	toAccount2.Currency = util.USD
+	eurAccount := randomAccount(user2.Username)
+	eurAccount.ID = fromAccount.ID + 3
+	eurAccount.Currency = util.EUR
+
+	legs := []*pb.TransferBatchLeg{
+		{ToAccountId: toAccount1.ID, Amount: 10},
+		{ToAccountId: toAccount2.ID, Amount: 20},
+		{ToAccountId: toAccount1.ID, Amount: 30},
+	}
+	dbLegs := []db.TransferBatchLeg{
+		{ToAccountID: toAccount1.ID, Amount: 10},
+		{ToAccountID: toAccount2.ID, Amount: 20},
+		{ToAccountID: toAccount1.ID, Amount: 30},
+	}
+	transfer1 := randomTransferBetween(fromAccount, toAccount1)
+	transfer3 := randomTransferBetween(fromAccount, toAccount1)
+
+	testCases := []struct {
+		name          string
+		req           *pb.CreateTransferBatchRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.CreateTransferBatchResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.CreateTransferBatchRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Legs:          legs,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount1.ID)).Times(1).Return(toAccount1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount2.ID)).Times(1).Return(toAccount2, nil)
+
+				arg := db.TransferBatchTxParams{
+					FromAccountID: fromAccount.ID,
+					Legs:          dbLegs,
+				}
+				store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.TransferBatchTxResult{
+					FromAccount: fromAccount,
+					Legs: []db.TransferBatchLegResult{
+						{Transfer: transfer1},
+						{Error: fmt.Sprintf("account %d is frozen", toAccount2.ID)},
+						{Transfer: transfer3},
+					},
+				}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferBatchResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, fromAccount.ID, res.GetFromAccount().GetId())
+				require.Len(t, res.GetLegs(), 3)
+				require.Equal(t, transfer1.ID, res.GetLegs()[0].GetTransfer().GetId())
+				require.Nil(t, res.GetLegs()[1].GetTransfer())
+				require.NotEmpty(t, res.GetLegs()[1].GetError())
+				require.Equal(t, transfer3.ID, res.GetLegs()[2].GetTransfer().GetId())
+				require.Empty(t, res.GetLegs()[2].GetError())
+			},
+		},
+		{
+			name: "AtomicInsufficientFunds",
+			req: &pb.CreateTransferBatchRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Legs:          legs,
+				Atomic:        true,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(3).DoAndReturn(
+					func(_ context.Context, id int64) (db.Account, error) {
+						for _, account := range []db.Account{fromAccount, toAccount1, toAccount2} {
+							if account.ID == id {
+								return account, nil
+							}
+						}
+						return db.Account{}, fmt.Errorf("unexpected account %d", id)
+					})
+				store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferBatchTxResult{}, fmt.Errorf("%w: account %d", db.ErrInsufficientFunds, fromAccount.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferBatchResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "UnauthorizedUser",
+			req: &pb.CreateTransferBatchRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Legs:          legs,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, user2.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferBatchResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "LegCurrencyMismatch",
+			req: &pb.CreateTransferBatchRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Legs: []*pb.TransferBatchLeg{
+					{ToAccountId: toAccount1.ID, Amount: 10},
+					{ToAccountId: eurAccount.ID, Amount: 10},
+				},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount1.ID)).Times(1).Return(toAccount1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(eurAccount.ID)).Times(1).Return(eurAccount, nil)
+				store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferBatchResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "TooManyLegs",
+			req: &pb.CreateTransferBatchRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Legs:          append(legs, &pb.TransferBatchLeg{ToAccountId: toAccount2.ID, Amount: 40}),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferBatchResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "LegToSourceAccount",
+			req: &pb.CreateTransferBatchRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Legs:          []*pb.TransferBatchLeg{{ToAccountId: fromAccount.ID, Amount: 10}},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferBatchResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.CreateTransferBatchRequest{
+				FromAccountId: fromAccount.ID,
+				Currency:      util.USD,
+				Legs:          legs,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.CreateTransferBatchResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.CreateTransferBatch(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestCreateTransferBatchRiskAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	fromAccount := randomAccount(user1.Username)
+	fromAccount.Currency = util.USD
+	toAccount := randomAccount(user2.Username)
+	toAccount.ID = fromAccount.ID + 1
+	toAccount.Currency = util.USD
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+
+	// the batch is screened once and can't be held, so the leg that would be reviewed denies it
+	store.EXPECT().CreateRiskDecision(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.CreateRiskDecisionParams) (db.RiskDecision, error) {
+			require.Equal(t, util.RiskDeny, arg.Action)
+			require.Equal(t, []string{"review_amount"}, arg.Rules)
+			require.Equal(t, int64(1010), arg.Amount)
+			require.False(t, arg.ToAccountID.Valid)
+			return db.RiskDecision{ID: 1, Action: arg.Action}, nil
+		})
+	store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Any()).Times(0)
+
+	server := newTestServer(t, store, nil)
+	server.riskEngine = risk.NewEngineFromConfig(util.Config{RiskReviewAmount: 1000})
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user1.Username, user1.Role, time.Minute)
+	_, err := server.CreateTransferBatch(ctx, &pb.CreateTransferBatchRequest{
+		FromAccountId: fromAccount.ID,
+		Currency:      util.USD,
+		Legs: []*pb.TransferBatchLeg{
+			{ToAccountId: toAccount.ID, Amount: 10},
+			{ToAccountId: toAccount.ID, Amount: 1000},
+		},
+	})
+	requireStatusCode(t, err, codes.PermissionDenied)
+}
+
+func TestCreateTransferBatchRiskVelocityAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	fromAccount := randomAccount(user1.Username)
+	fromAccount.Currency = util.USD
+	toAccount := randomAccount(user2.Username)
+	toAccount.ID = fromAccount.ID + 1
+	toAccount.Currency = util.USD
+
+	config := util.Config{RiskVelocityMax: 1, RiskVelocityWindow: time.Hour}
+	legs := make([]*pb.TransferBatchLeg, config.RiskVelocityMax+2)
+	for i := range legs {
+		legs[i] = &pb.TransferBatchLeg{ToAccountId: toAccount.ID, Amount: 10}
+	}
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+	store.EXPECT().CountRiskDecisionsSince(gomock.Any(), gomock.Any()).AnyTimes().Return(config.RiskVelocityMax-1, nil)
+
+	// a batch with more legs than the velocity allows counts as one operation,
+	// and the batch transaction records its decision
+	store.EXPECT().CreateRiskDecision(gomock.Any(), gomock.Any()).Times(0)
+	store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.TransferBatchTxParams) (db.TransferBatchTxResult, error) {
+			require.Len(t, arg.Legs, len(legs))
+			require.NotNil(t, arg.RiskDecision)
+			require.Equal(t, util.RiskAllow, arg.RiskDecision.Action)
+			require.Equal(t, int64(10*len(legs)), arg.RiskDecision.Amount)
+			return db.TransferBatchTxResult{FromAccount: fromAccount}, nil
+		})
+
+	server := newTestServer(t, store, nil)
+	server.riskEngine = risk.NewEngineFromConfig(config)
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user1.Username, user1.Role, time.Minute)
+	_, err := server.CreateTransferBatch(ctx, &pb.CreateTransferBatchRequest{
+		FromAccountId: fromAccount.ID,
+		Currency:      util.USD,
+		Legs:          legs,
+	})
+	require.NoError(t, err)
+}
+
+func TestCreateTransferBatchNewPayeeAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	fromAccount := randomAccount(user1.Username)
+	fromAccount.Currency = util.USD
+	toAccount := randomAccount(user2.Username)
+	toAccount.ID = fromAccount.ID + 1
+	toAccount.Currency = util.USD
+
+	newPayeeLimit := db.NewPayeeLimit{Amount: 100, Period: 24 * time.Hour}
+
+	storeCtrl := gomock.NewController(t)
+	defer storeCtrl.Finish()
+	store := mockdb.NewMockStore(storeCtrl)
+
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(1).Return(fromAccount, nil)
+	store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+	store.EXPECT().TransferBatchTx(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(_ context.Context, arg db.TransferBatchTxParams) (db.TransferBatchTxResult, error) {
+			require.Equal(t, newPayeeLimit, arg.NewPayeeLimit)
+			return db.TransferBatchTxResult{}, &db.TransferBatchLegError{
+				Index: 1,
+				Err:   fmt.Errorf("%w: at most 100 can be sent to account %d, 60 already was", db.ErrNewPayeeLimit, toAccount.ID),
+			}
+		})
+
+	server := newTestServer(t, store, nil)
+	server.config.NewPayeeTransferLimit = newPayeeLimit.Amount
+	server.config.NewPayeeTransferPeriod = newPayeeLimit.Period
+	ctx := newContextWithBearerToken(t, server.tokenMaker, user1.Username, user1.Role, time.Minute)
+
+	// each leg is within the limit, together they are not
+	_, err := server.CreateTransferBatch(ctx, &pb.CreateTransferBatchRequest{
+		FromAccountId: fromAccount.ID,
+		Currency:      util.USD,
+		Atomic:        true,
+		Legs: []*pb.TransferBatchLeg{
+			{ToAccountId: toAccount.ID, Amount: 60},
+			{ToAccountId: toAccount.ID, Amount: 60},
+		},
+	})
+	requireStatusCode(t, err, codes.FailedPrecondition)
+}
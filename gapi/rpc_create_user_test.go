@@ -343,6 +343,7 @@ func randomUser(t *testing.T) (user db.User, password string) {
 		HashedPassword: hashedPassword,
 		FullName:       util.RandomOwner(),
 		Email:          util.RandomEmail(),
+		Role:           util.CustomerRole,
 		CreatedAt:      time.Now(),
 	}
 	return
@@ -350,8 +351,13 @@ func randomUser(t *testing.T) (user db.User, password string) {
 
 func newTestServer(t *testing.T, store db.Store, taskDistributor worker.TaskDistributor) *Server {
 	config := util.Config{
-		TokenSymmetricKey:   util.RandomString(32),
-		AccessTokenDuration: time.Minute,
+		TokenSymmetricKey:       util.RandomString(32),
+		AccessTokenDuration:     time.Minute,
+		FxQuoteDuration:         time.Minute,
+		HoldDuration:            time.Hour,
+		TransferBatchMaxLegs:    3,
+		SpendingLimitCoolingOff: 24 * time.Hour,
+		PaymentRequestDuration:  24 * time.Hour,
 	}
 
 	server, err := NewServer(config, store, taskDistributor)
@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) DeclinePaymentRequest(ctx context.Context, req *pb.DeclinePaymentRequestRequest) (*pb.DeclinePaymentRequestResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeclinePaymentRequestRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	request, err := server.getPaymentRequestToPay(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	declined, err := server.store.DeclinePaymentRequestTx(ctx, db.ClosePaymentRequestTxParams{
+		ID:         request.ID,
+		AfterClose: server.notifyPaymentRequest(ctx),
+	})
+	if err != nil {
+		return nil, paymentRequestError(err)
+	}
+
+	rsp := &pb.DeclinePaymentRequestResponse{
+		PaymentRequest: convertPaymentRequest(declined),
+	}
+	return rsp, nil
+}
+
+func validateDeclinePaymentRequestRequest(req *pb.DeclinePaymentRequestRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePaymentRequestID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,45 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// DeletePayee removes the saved payee. Transfers don't refer to payees, so nothing
+// else goes with it.
+func (server *Server) DeletePayee(ctx context.Context, req *pb.DeletePayeeRequest) (*pb.DeletePayeeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateDeletePayeeRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.getOwnPayee(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	err = server.store.DeletePayee(ctx, req.GetId())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete payee: %s", err)
+	}
+
+	return &pb.DeletePayeeResponse{}, nil
+}
+
+func validateDeletePayeeRequest(req *pb.DeletePayeeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePayeeID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
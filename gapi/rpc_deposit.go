@@ -3,9 +3,12 @@ package gapi
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	db "github.com/JaidenShall/simplebank/db/sqlc"
 	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
 	"github.com/JaidenShall/simplebank/val"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -19,8 +22,12 @@ func (server *Server) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.
 		return nil, unauthenticatedError(err)
 	}
 
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+
 	// Validate input
 	violations := validateDepositRequest(req)
+	violations = append(violations, validateAnnotation(req)...)
+	violations = append(violations, validateIdempotencyKey(key)...)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
@@ -39,13 +46,40 @@ func (server *Server) Deposit(ctx context.Context, req *pb.DepositRequest) (*pb.
 	}
 
 	// Perform deposit transaction
+	idempotency := newIdempotencyParams(authPayload.Username, key, "Deposit",
+		req.GetAccountId(), req.GetAmount(), req.GetMemo(), req.GetReference(), req.GetCategory())
+
 	arg := db.DepositTxParams{
-		AccountID: req.GetAccountId(),
+		AccountID:   req.GetAccountId(),
+		Amount:      req.GetAmount(),
+		Annotation:  convertAnnotation(req),
+		Idempotency: idempotency,
+	}
+
+	held, decision, err := server.screenRisk(ctx, risk.Operation{
+		Kind:      util.RiskDeposit,
+		Username:  authPayload.Username,
+		AccountID: account.ID,
 		Amount:    req.GetAmount(),
+		Currency:  account.Currency,
+	}, arg, idempotency)
+	if err != nil {
+		return nil, err
+	}
+	if held != nil {
+		return &pb.DepositResponse{Review: convertRiskReview(*held)}, nil
 	}
+	arg.RiskDecision = decision
 
 	result, err := server.store.DepositTx(ctx, arg)
 	if err != nil {
+		var notActiveErr *db.AccountNotActiveError
+		if errors.As(err, &notActiveErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, db.ErrIdempotencyKeyReused) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
 		return nil, status.Errorf(codes.Internal, "deposit transaction failed: %s", err)
 	}
 
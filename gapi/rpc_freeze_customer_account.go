@@ -0,0 +1,107 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/proto"
+)
+
+// accountStatusRequest is implemented by the requests changing the status of an account
+type accountStatusRequest interface {
+	proto.Message
+	GetAccountId() int64
+	GetReason() string
+}
+
+func (server *Server) FreezeCustomerAccount(ctx context.Context, req *pb.FreezeCustomerAccountRequest) (*pb.FreezeCustomerAccountResponse, error) {
+	account, err := server.changeCustomerAccount(ctx, req, auditFreezeAccount, accountStatusChange(util.AccountFrozen))
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.FreezeCustomerAccountResponse{
+		Account: convertAccount(account),
+	}
+	return rsp, nil
+}
+
+func (server *Server) UnfreezeCustomerAccount(ctx context.Context, req *pb.UnfreezeCustomerAccountRequest) (*pb.UnfreezeCustomerAccountResponse, error) {
+	account, err := server.changeCustomerAccount(ctx, req, auditUnfreezeAccount, accountStatusChange(util.AccountActive))
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.UnfreezeCustomerAccountResponse{
+		Account: convertAccount(account),
+	}
+	return rsp, nil
+}
+
+// ReopenCustomerAccount makes a closed account active again, for example when a customer
+// closed the wrong account.
+func (server *Server) ReopenCustomerAccount(ctx context.Context, req *pb.ReopenCustomerAccountRequest) (*pb.ReopenCustomerAccountResponse, error) {
+	account, err := server.changeCustomerAccount(ctx, req, auditReopenAccount, func(ctx context.Context, q db.Querier, accountID int64) (db.Account, error) {
+		return db.ReopenAccount(ctx, q, accountID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.ReopenCustomerAccountResponse{
+		Account: convertAccount(account),
+	}
+	return rsp, nil
+}
+
+// accountChange changes the status of an account using q, inside the audited transaction
+type accountChange func(ctx context.Context, q db.Querier, accountID int64) (db.Account, error)
+
+func accountStatusChange(accountStatus string) accountChange {
+	return func(ctx context.Context, q db.Querier, accountID int64) (db.Account, error) {
+		return db.ChangeAccountStatus(ctx, q, db.ChangeAccountStatusTxParams{
+			AccountID: accountID,
+			Status:    accountStatus,
+		})
+	}
+}
+
+func (server *Server) changeCustomerAccount(ctx context.Context, req accountStatusRequest, action string, change accountChange) (db.Account, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return db.Account{}, unauthenticatedError(err)
+	}
+
+	violations := validateAccountStatusRequest(req)
+	if violations != nil {
+		return db.Account{}, invalidArgumentError(violations)
+	}
+
+	var account db.Account
+	err = server.audit(ctx, authPayload, action, accountTarget(req.GetAccountId()), req, func(q db.Querier) error {
+		var err error
+		account, err = change(ctx, q, req.GetAccountId())
+		return err
+	})
+	if err != nil {
+		return db.Account{}, accountStatusError(err)
+	}
+
+	return account, nil
+}
+
+func validateAccountStatusRequest(req accountStatusRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,257 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestFreezeCustomerAccountAPI(t *testing.T) {
+	staff, _ := randomUser(t)
+	staff.Role = util.SupportRole
+
+	customer, _ := randomUser(t)
+	account := randomAccount(customer.Username)
+	account.Status = util.AccountActive
+
+	frozen := account
+	frozen.Status = util.AccountFrozen
+
+	testCases := []struct {
+		name          string
+		req           *pb.FreezeCustomerAccountRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.FreezeCustomerAccountResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.FreezeCustomerAccountRequest{
+				AccountId: account.ID,
+				Reason:    "suspicious activity",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, staff.Username, auditFreezeAccount, accountTarget(account.ID))
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.UpdateAccountStatusParams{
+					ID:     account.ID,
+					Status: util.AccountFrozen,
+				}
+				store.EXPECT().UpdateAccountStatus(gomock.Any(), gomock.Eq(arg)).Times(1).Return(frozen, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeCustomerAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetAccount().GetId())
+				require.Equal(t, util.AccountFrozen, res.GetAccount().GetStatus())
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req: &pb.FreezeCustomerAccountRequest{
+				AccountId: account.ID,
+				Reason:    "suspicious activity",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, staff.Username, auditFreezeAccount, accountTarget(account.ID))
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().UpdateAccountStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeCustomerAccountResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "AccountClosed",
+			req: &pb.FreezeCustomerAccountRequest{
+				AccountId: account.ID,
+				Reason:    "suspicious activity",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				closed := account
+				closed.Status = util.AccountClosed
+
+				expectAuditTx(t, store, staff.Username, auditFreezeAccount, accountTarget(account.ID))
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(closed, nil)
+				store.EXPECT().UpdateAccountStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeCustomerAccountResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "MissingReason",
+			req: &pb.FreezeCustomerAccountRequest{
+				AccountId: account.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeCustomerAccountResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.FreezeCustomerAccountRequest{
+				AccountId: account.ID,
+				Reason:    "suspicious activity",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.FreezeCustomerAccountResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.FreezeCustomerAccount(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestReopenCustomerAccountAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+	admin.Role = util.AdminRole
+
+	customer, _ := randomUser(t)
+	account := randomAccount(customer.Username)
+	account.Status = util.AccountClosed
+
+	reopened := account
+	reopened.Status = util.AccountActive
+
+	testCases := []struct {
+		name          string
+		req           *pb.ReopenCustomerAccountRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.ReopenCustomerAccountResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ReopenCustomerAccountRequest{
+				AccountId: account.ID,
+				Reason:    "closed by mistake",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditReopenAccount, accountTarget(account.ID))
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.UpdateAccountStatusParams{
+					ID:     account.ID,
+					Status: util.AccountActive,
+				}
+				store.EXPECT().UpdateAccountStatus(gomock.Any(), gomock.Eq(arg)).Times(1).Return(reopened, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReopenCustomerAccountResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetAccount().GetId())
+				require.Equal(t, util.AccountActive, res.GetAccount().GetStatus())
+			},
+		},
+		{
+			name: "AccountNotClosed",
+			req: &pb.ReopenCustomerAccountRequest{
+				AccountId: account.ID,
+				Reason:    "closed by mistake",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditReopenAccount, accountTarget(account.ID))
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(reopened, nil)
+				store.EXPECT().UpdateAccountStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReopenCustomerAccountResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "MissingReason",
+			req: &pb.ReopenCustomerAccountRequest{
+				AccountId: account.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReopenCustomerAccountResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := newContextWithBearerToken(t, server.tokenMaker, admin.Username, admin.Role, time.Minute)
+			res, err := server.ReopenCustomerAccount(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+// expectAuditTx expects one audited action and runs it against the mock store
+func expectAuditTx(t *testing.T, store *mockdb.MockStore, actor string, action string, target string) {
+	store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(1).
+		DoAndReturn(func(ctx context.Context, arg db.AuditTxParams) (db.AuditTxResult, error) {
+			require.Equal(t, actor, arg.Actor)
+			require.Equal(t, action, arg.Action)
+			require.Equal(t, target, arg.Target)
+			require.NotEmpty(t, arg.Details)
+
+			if err := arg.Run(store); err != nil {
+				return db.AuditTxResult{}, err
+			}
+			return db.AuditTxResult{AuditLog: db.AuditLog{
+				Actor:   arg.Actor,
+				Action:  arg.Action,
+				Target:  arg.Target,
+				Details: arg.Details,
+			}}, nil
+		})
+}
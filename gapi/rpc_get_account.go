@@ -34,8 +34,14 @@ func (server *Server) GetAccount(ctx context.Context, req *pb.GetAccountRequest)
 		return nil, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
 	}
 
+	held, err := server.store.GetHeldAmount(ctx, account.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get held amount: %s", err)
+	}
+
 	rsp := &pb.GetAccountResponse{
-		Account: convertAccount(account),
+		Account:          convertAccount(account),
+		AvailableBalance: account.Balance - held,
 	}
 	return rsp, nil
 }
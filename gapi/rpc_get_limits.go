@@ -0,0 +1,29 @@
+package gapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/JaidenShall/simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetLimits(ctx context.Context, req *pb.GetLimitsRequest) (*pb.GetLimitsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	limits, err := server.store.ListSpendingLimits(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list spending limits: %s", err)
+	}
+
+	now := time.Now()
+	rsp := &pb.GetLimitsResponse{}
+	for _, limit := range limits {
+		rsp.Limits = append(rsp.Limits, convertSpendingLimit(limit, now))
+	}
+	return rsp, nil
+}
@@ -0,0 +1,41 @@
+package gapi
+
+import (
+	"context"
+
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) GetPayee(ctx context.Context, req *pb.GetPayeeRequest) (*pb.GetPayeeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetPayeeRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	payee, err := server.getOwnPayee(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetPayeeResponse{}
+	rsp.Payee, err = server.describePayee(ctx, payee)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func validateGetPayeeRequest(req *pb.GetPayeeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePayeeID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	return violations
+}
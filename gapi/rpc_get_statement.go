@@ -0,0 +1,89 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func (server *Server) GetStatement(ctx context.Context, req *pb.GetStatementRequest) (*pb.GetStatementResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateGetStatementRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	statement, err := server.getStatement(ctx, authPayload, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &pb.GetStatementResponse{
+		Account:        convertAccount(statement.Account),
+		FromTime:       timestamppb.New(statement.FromTime),
+		ToTime:         timestamppb.New(statement.ToTime),
+		OpeningBalance: statement.OpeningBalance,
+		ClosingBalance: statement.ClosingBalance,
+		Entries:        make([]*pb.Entry, len(statement.Entries)),
+	}
+	for i, entry := range statement.Entries {
+		rsp.Entries[i] = convertEntry(entry)
+	}
+
+	return rsp, nil
+}
+
+// getStatement returns the statement of an account that belongs to the authenticated user,
+// or an error status
+func (server *Server) getStatement(ctx context.Context, authPayload *token.Payload, req *pb.GetStatementRequest) (db.Statement, error) {
+	if err := server.checkAccountOwner(ctx, req.GetAccountId(), authPayload.Username); err != nil {
+		return db.Statement{}, err
+	}
+
+	statement, err := server.store.GetStatement(ctx, db.GetStatementParams{
+		AccountID: req.GetAccountId(),
+		FromTime:  req.GetFromTime().AsTime(),
+		ToTime:    req.GetToTime().AsTime(),
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return db.Statement{}, status.Errorf(codes.NotFound, "account not found")
+		}
+		return db.Statement{}, status.Errorf(codes.Internal, "failed to get statement: %s", err)
+	}
+	return statement, nil
+}
+
+func validateGetStatementRequest(req *pb.GetStatementRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateAccountID(req.GetAccountId()); err != nil {
+		violations = append(violations, fieldViolation("account_id", err))
+	}
+
+	if req.GetFromTime() == nil {
+		violations = append(violations, fieldViolation("from_time", fmt.Errorf("must be set")))
+	}
+
+	if req.GetToTime() == nil {
+		violations = append(violations, fieldViolation("to_time", fmt.Errorf("must be set")))
+	} else if req.GetFromTime() != nil {
+		if err := val.ValidateStatementPeriod(req.GetFromTime().AsTime(), req.GetToTime().AsTime()); err != nil {
+			violations = append(violations, fieldViolation("to_time", err))
+		}
+	}
+
+	return violations
+}
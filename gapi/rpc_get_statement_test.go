@@ -0,0 +1,188 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// randomStatement returns a statement of account over the month starting at fromTime
+func randomStatement(account db.Account, fromTime time.Time) db.Statement {
+	statement := db.Statement{
+		Account:        account,
+		FromTime:       fromTime,
+		ToTime:         fromTime.AddDate(0, 1, 0),
+		OpeningBalance: account.Balance,
+		ClosingBalance: account.Balance,
+	}
+	for i, amount := range []int64{500, -200} {
+		statement.Entries = append(statement.Entries, db.Entry{
+			ID:        int64(i + 1),
+			AccountID: account.ID,
+			Amount:    amount,
+			CreatedAt: fromTime.Add(time.Duration(i+1) * time.Hour),
+			Memo:      "Groceries",
+		})
+		statement.ClosingBalance += amount
+	}
+	return statement
+}
+
+func TestGetStatementAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	account := randomAccount(user1.Username)
+
+	fromTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	statement := randomStatement(account, fromTime)
+
+	testCases := []struct {
+		name          string
+		req           *pb.GetStatementRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetStatementResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.GetStatementRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(statement.FromTime),
+				ToTime:    timestamppb.New(statement.ToTime),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.GetStatementParams{
+					AccountID: account.ID,
+					FromTime:  statement.FromTime,
+					ToTime:    statement.ToTime,
+				}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Eq(arg)).Times(1).Return(statement, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetAccount().GetId())
+				require.Equal(t, statement.OpeningBalance, res.GetOpeningBalance())
+				require.Equal(t, statement.ClosingBalance, res.GetClosingBalance())
+				require.Len(t, res.GetEntries(), len(statement.Entries))
+				require.Equal(t, "Groceries", res.GetEntries()[0].GetMemo())
+			},
+		},
+		{
+			name: "PeriodTooLong",
+			req: &pb.GetStatementRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(fromTime),
+				ToTime:    timestamppb.New(fromTime.AddDate(2, 0, 0)),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "MissingPeriod",
+			req:  &pb.GetStatementRequest{AccountId: account.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req: &pb.GetStatementRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(statement.FromTime),
+				ToTime:    timestamppb.New(statement.ToTime),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "UnauthorizedUser",
+			req: &pb.GetStatementRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(statement.FromTime),
+				ToTime:    timestamppb.New(statement.ToTime),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, user2.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.GetStatementRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(statement.FromTime),
+				ToTime:    timestamppb.New(statement.ToTime),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.GetStatementResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetStatement(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) GetTrialBalance(ctx context.Context, req *pb.GetTrialBalanceRequest) (*pb.GetTrialBalanceResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	var lines []db.GetTrialBalanceRow
+	var balances []db.ListSystemAccountBalancesRow
+	err = server.audit(ctx, authPayload, auditGetTrialBalance, "ledger", req, func(q db.Querier) error {
+		var err error
+		lines, err = q.GetTrialBalance(ctx)
+		if err != nil {
+			return err
+		}
+		balances, err = q.ListSystemAccountBalances(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get trial balance: %s", err)
+	}
+
+	rsp := &pb.GetTrialBalanceResponse{
+		Balanced: true,
+	}
+	for _, line := range lines {
+		rsp.Lines = append(rsp.Lines, &pb.TrialBalanceLine{
+			Currency: line.Currency,
+			Postings: line.Postings,
+			Debits:   line.Debits,
+			Credits:  line.Credits,
+			Net:      line.Net,
+		})
+		if line.Net != 0 {
+			rsp.Balanced = false
+		}
+	}
+	for _, balance := range balances {
+		rsp.SystemAccounts = append(rsp.SystemAccounts, &pb.SystemAccountBalance{
+			Code:     balance.Code,
+			Currency: balance.Currency,
+			Balance:  balance.Balance,
+		})
+	}
+	return rsp, nil
+}
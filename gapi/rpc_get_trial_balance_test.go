@@ -0,0 +1,101 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestGetTrialBalanceAPI(t *testing.T) {
+	staff, _ := randomUser(t)
+	staff.Role = util.SupportRole
+
+	balances := []db.ListSystemAccountBalancesRow{
+		{ID: 1, Code: util.SystemCashVault, Currency: util.USD, Balance: -150},
+	}
+
+	testCases := []struct {
+		name          string
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.GetTrialBalanceResponse, err error)
+	}{
+		{
+			name: "Balanced",
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, staff.Username, auditGetTrialBalance, "ledger")
+				store.EXPECT().GetTrialBalance(gomock.Any()).Times(1).Return([]db.GetTrialBalanceRow{
+					{Currency: util.USD, Postings: 4, Debits: 200, Credits: 200},
+				}, nil)
+				store.EXPECT().ListSystemAccountBalances(gomock.Any()).Times(1).Return(balances, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTrialBalanceResponse, err error) {
+				require.NoError(t, err)
+				require.True(t, res.GetBalanced())
+				require.Len(t, res.GetLines(), 1)
+				require.Equal(t, int64(200), res.GetLines()[0].GetDebits())
+				require.Len(t, res.GetSystemAccounts(), 1)
+				require.Equal(t, int64(-150), res.GetSystemAccounts()[0].GetBalance())
+			},
+		},
+		{
+			name: "Unbalanced",
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, staff.Username, auditGetTrialBalance, "ledger")
+				store.EXPECT().GetTrialBalance(gomock.Any()).Times(1).Return([]db.GetTrialBalanceRow{
+					{Currency: util.EUR, Postings: 2, Debits: 100, Credits: 200, Net: 100},
+					{Currency: util.USD, Postings: 4, Debits: 200, Credits: 200},
+				}, nil)
+				store.EXPECT().ListSystemAccountBalances(gomock.Any()).Times(1).Return(balances, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, staff.Username, staff.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTrialBalanceResponse, err error) {
+				require.NoError(t, err)
+				require.False(t, res.GetBalanced())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.GetTrialBalanceResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.GetTrialBalance(ctx, &pb.GetTrialBalanceRequest{})
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -2,12 +2,12 @@ package gapi
 
 import (
 	"context"
-	// "fmt"
+	"errors"
 
 	db "github.com/JaidenShall/simplebank/db/sqlc"
 	"github.com/JaidenShall/simplebank/pb"
-
-	// "google.golang.org/genproto/googleapis/rpc/errdetails"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -18,43 +18,53 @@ func (server *Server) ListAccounts(ctx context.Context, req *pb.ListAccountsRequ
 		return nil, unauthenticatedError(err)
 	}
 
-	// violations := validateListAccountsRequest(req)
-	// if violations != nil {
-	// 	return nil, invalidArgumentError(violations)
-	// }
+	violations := validateListAccountsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
 
-	arg := db.ListAccountsParams{
-		Owner:  authPayload.Username,
-		Limit:  3,
-		Offset: 0,
+	arg := db.ListAccountsPageParams{
+		Owner:     authPayload.Username,
+		Currency:  req.GetCurrency(),
+		OrderBy:   req.GetOrderBy(),
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
 	}
 
-	accounts, err := server.store.ListAccounts(ctx, arg)
+	result, err := server.store.ListAccountsPage(ctx, arg)
 	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+				fieldViolation("page_token", err),
+			})
+		}
 		return nil, status.Errorf(codes.Internal, "failed to list accounts: %s", err)
 	}
 
 	rsp := &pb.ListAccountsResponse{
-		Accounts: convertAccounts(accounts),
+		Accounts:      convertAccounts(result.Accounts),
+		NextPageToken: result.NextPageToken,
 	}
 	return rsp, nil
 }
 
-// func validateListAccountsRequest(req *pb.ListAccountsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
-// 	if req.GetPageId() < 1 {
-// 		violations = append(violations, fieldViolation("page_id", fmt.Errorf("must be greater than 0")))
-// 	}
+func validateListAccountsRequest(req *pb.ListAccountsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
 
-// 	if req.GetPageSize() < 5 {
-// 		violations = append(violations, fieldViolation("page_size", fmt.Errorf("must be at least 5")))
-// 	}
+	if req.GetCurrency() != "" {
+		if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("currency", err))
+		}
+	}
 
-// 	if req.GetPageSize() > 10 {
-// 		violations = append(violations, fieldViolation("page_size", fmt.Errorf("must not exceed 10")))
-// 	}
+	if err := val.ValidateAccountOrderBy(req.GetOrderBy()); err != nil {
+		violations = append(violations, fieldViolation("order_by", err))
+	}
 
-// 	return violations
-// }
+	return violations
+}
 
 func convertAccounts(accounts []db.Account) []*pb.Account {
 	var result []*pb.Account
@@ -0,0 +1,253 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestListAccountEntriesAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	account := randomAccount(user1.Username)
+
+	n := 3
+	entries := make([]db.Entry, n)
+	for i := range entries {
+		entries[i] = db.Entry{
+			ID:        util.RandomInt(1, 1000),
+			AccountID: account.ID,
+			Amount:    util.RandomMoney(),
+			CreatedAt: time.Now(),
+		}
+	}
+
+	fromTime := time.Now().Add(-24 * time.Hour).UTC()
+	toTime := time.Now().UTC()
+	nextPageToken := util.RandomString(32)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListAccountEntriesRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListAccountEntriesResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListAccountEntriesRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(fromTime),
+				ToTime:    timestamppb.New(toTime),
+				MinAmount: 10,
+				MaxAmount: 1000,
+				PageSize:  int32(n),
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.ListEntriesPageParams{
+					AccountID: account.ID,
+					Filter: db.HistoryFilter{
+						FromTime:  fromTime,
+						ToTime:    toTime,
+						MinAmount: 10,
+						MaxAmount: 1000,
+					},
+					PageSize: int32(n),
+				}
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.ListEntriesPageResult{Entries: entries, NextPageToken: nextPageToken}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountEntriesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEntries(), n)
+				for i, entry := range res.GetEntries() {
+					require.Equal(t, entries[i].ID, entry.GetId())
+				}
+				require.Equal(t, nextPageToken, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "Search",
+			req: &pb.ListAccountEntriesRequest{
+				AccountId: account.ID,
+				Query:     "rent -garage",
+				Reference: "INV-42",
+				Category:  "housing",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.ListEntriesPageParams{
+					AccountID: account.ID,
+					Filter: db.HistoryFilter{
+						Query:     "rent -garage",
+						Reference: "INV-42",
+						Category:  "housing",
+					},
+				}
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.ListEntriesPageResult{Entries: entries[:1]}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountEntriesResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetEntries(), 1)
+			},
+		},
+		{
+			name: "InvalidSearch",
+			req: &pb.ListAccountEntriesRequest{
+				AccountId: account.ID,
+				Query:     "r",
+				Category:  "Housing",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountEntriesResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidFilter",
+			req: &pb.ListAccountEntriesRequest{
+				AccountId: account.ID,
+				FromTime:  timestamppb.New(toTime),
+				ToTime:    timestamppb.New(fromTime),
+				MinAmount: 100,
+				MaxAmount: 10,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountEntriesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidPageToken",
+			req: &pb.ListAccountEntriesRequest{
+				AccountId: account.ID,
+				PageToken: "invalid",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.ListEntriesPageResult{}, db.ErrInvalidPageToken)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountEntriesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "AccountNotFound",
+			req: &pb.ListAccountEntriesRequest{
+				AccountId: account.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(db.Account{}, sql.ErrNoRows)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountEntriesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.NotFound, st.Code())
+			},
+		},
+		{
+			name: "UnauthorizedUser",
+			req: &pb.ListAccountEntriesRequest{
+				AccountId: account.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, user2.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountEntriesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.ListAccountEntriesRequest{
+				AccountId: account.ID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ListEntriesPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountEntriesResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListAccountEntries(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListAccountsAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	n := 3
+	accounts := make([]db.Account, n)
+	for i := range accounts {
+		accounts[i] = randomAccount(user.Username)
+	}
+
+	nextPageToken := util.RandomString(32)
+
+	testCases := []struct {
+		name          string
+		req           *pb.ListAccountsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ListAccountsResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.ListAccountsRequest{
+				PageSize: int32(n),
+				Currency: util.USD,
+				OrderBy:  db.AccountOrderByBalance,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ListAccountsPageParams{
+					Owner:    user.Username,
+					Currency: util.USD,
+					OrderBy:  db.AccountOrderByBalance,
+					PageSize: int32(n),
+				}
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.ListAccountsPageResult{Accounts: accounts, NextPageToken: nextPageToken}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res.GetAccounts(), n)
+				for i, account := range res.GetAccounts() {
+					require.Equal(t, accounts[i].ID, account.GetId())
+				}
+				require.Equal(t, nextPageToken, res.GetNextPageToken())
+			},
+		},
+		{
+			name: "InvalidPageToken",
+			req: &pb.ListAccountsRequest{
+				PageToken: "invalid",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.ListAccountsPageResult{}, db.ErrInvalidPageToken)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InvalidArguments",
+			req: &pb.ListAccountsRequest{
+				PageSize: util.MaxPageSize + 1,
+				Currency: "xyz",
+				OrderBy:  "owner",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "InternalError",
+			req:  &pb.ListAccountsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.ListAccountsPageResult{}, sql.ErrConnDone)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Internal, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.ListAccountsRequest{},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ListAccountsPage(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ListAccountsResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ListAccounts(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package gapi
+
+import (
+	"context"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListAccountTransfers(ctx context.Context, req *pb.ListAccountTransfersRequest) (*pb.ListAccountTransfersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateHistoryRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	if err := server.checkAccountOwner(ctx, req.GetAccountId(), authPayload.Username); err != nil {
+		return nil, err
+	}
+
+	arg := db.ListTransfersPageParams{
+		AccountID: req.GetAccountId(),
+		Filter:    convertHistoryFilter(req),
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	}
+
+	result, err := server.store.ListTransfersPage(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+				fieldViolation("page_token", err),
+			})
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list transfers: %s", err)
+	}
+
+	rsp := &pb.ListAccountTransfersResponse{
+		NextPageToken: result.NextPageToken,
+	}
+	for _, transfer := range result.Transfers {
+		rsp.Transfers = append(rsp.Transfers, convertTransfer(transfer))
+	}
+	return rsp, nil
+}
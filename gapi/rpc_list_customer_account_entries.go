@@ -0,0 +1,62 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListCustomerAccountEntries(ctx context.Context, req *pb.ListAccountEntriesRequest) (*pb.ListAccountEntriesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateHistoryRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// the entries are read after the audit transaction, which only checks that the account exists
+	err = server.audit(ctx, authPayload, auditListAccountEntries, accountTarget(req.GetAccountId()), req, func(q db.Querier) error {
+		_, err := q.GetAccount(ctx, req.GetAccountId())
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "account not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	arg := db.ListEntriesPageParams{
+		AccountID: req.GetAccountId(),
+		Filter:    convertHistoryFilter(req),
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	}
+
+	result, err := server.store.ListEntriesPage(ctx, arg)
+	if err != nil {
+		if errors.Is(err, db.ErrInvalidPageToken) {
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+				fieldViolation("page_token", err),
+			})
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list entries: %s", err)
+	}
+
+	rsp := &pb.ListAccountEntriesResponse{
+		NextPageToken: result.NextPageToken,
+	}
+	for _, entry := range result.Entries {
+		rsp.Entries = append(rsp.Entries, convertEntry(entry))
+	}
+	return rsp, nil
+}
@@ -0,0 +1,38 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListPayees(ctx context.Context, req *pb.ListPayeesRequest) (*pb.ListPayeesResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	payees, err := server.store.ListPayees(ctx, authPayload.Username)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list payees: %s", err)
+	}
+
+	rsp := &pb.ListPayeesResponse{
+		Payees: make([]*pb.Payee, 0, len(payees)),
+	}
+	for _, payee := range payees {
+		rsp.Payees = append(rsp.Payees, convertPayee(db.Payee{
+			ID:        payee.ID,
+			Owner:     payee.Owner,
+			AccountID: payee.AccountID,
+			Nickname:  payee.Nickname,
+			CreatedAt: payee.CreatedAt,
+			UpdatedAt: payee.UpdatedAt,
+		}, payee.Currency, util.MaskName(payee.FullName)))
+	}
+	return rsp, nil
+}
@@ -0,0 +1,84 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListPaymentRequests(ctx context.Context, req *pb.ListPaymentRequestsRequest) (*pb.ListPaymentRequestsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListPaymentRequestsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	requestStatus := sql.NullString{String: req.GetStatus(), Valid: req.GetStatus() != ""}
+
+	pageSize := req.GetPageSize()
+	if pageSize == 0 {
+		pageSize = util.DefaultPageSize
+	}
+
+	var requests []db.PaymentRequest
+	if req.GetDirection() == util.PaymentRequestOutgoing {
+		requests, err = server.store.ListOutgoingPaymentRequests(ctx, db.ListOutgoingPaymentRequestsParams{
+			Requester: authPayload.Username,
+			Status:    requestStatus,
+			AfterID:   req.GetAfterId(),
+			PageSize:  pageSize,
+		})
+	} else {
+		requests, err = server.store.ListIncomingPaymentRequests(ctx, db.ListIncomingPaymentRequestsParams{
+			Payer:    authPayload.Username,
+			Status:   requestStatus,
+			AfterID:  req.GetAfterId(),
+			PageSize: pageSize,
+		})
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list payment requests: %s", err)
+	}
+
+	rsp := &pb.ListPaymentRequestsResponse{}
+	for _, request := range requests {
+		rsp.PaymentRequests = append(rsp.PaymentRequests, convertPaymentRequest(request))
+	}
+	return rsp, nil
+}
+
+func validateListPaymentRequestsRequest(req *pb.ListPaymentRequestsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetDirection() != "" {
+		if err := val.ValidatePaymentRequestDirection(req.GetDirection()); err != nil {
+			violations = append(violations, fieldViolation("direction", err))
+		}
+	}
+
+	if req.GetStatus() != "" {
+		if err := val.ValidatePaymentRequestStatus(req.GetStatus()); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if req.GetAfterId() < 0 {
+		violations = append(violations, fieldViolation("after_id", fmt.Errorf("must not be negative")))
+	}
+
+	return violations
+}
@@ -0,0 +1,75 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) ListRiskReviews(ctx context.Context, req *pb.ListRiskReviewsRequest) (*pb.ListRiskReviewsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateListRiskReviewsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	reviewStatus := req.GetStatus()
+	if reviewStatus == "" {
+		reviewStatus = util.RiskReviewPending
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize == 0 {
+		pageSize = util.DefaultPageSize
+	}
+
+	var reviews []db.RiskDecision
+	err = server.audit(ctx, authPayload, auditListRiskReviews, "risk_reviews", req, func(q db.Querier) error {
+		var err error
+		reviews, err = q.ListRiskReviews(ctx, db.ListRiskReviewsParams{
+			ReviewStatus: sql.NullString{String: reviewStatus, Valid: true},
+			AfterID:      req.GetAfterId(),
+			PageSize:     pageSize,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list risk reviews: %s", err)
+	}
+
+	rsp := &pb.ListRiskReviewsResponse{}
+	for _, review := range reviews {
+		rsp.Reviews = append(rsp.Reviews, convertRiskDecision(review))
+	}
+	return rsp, nil
+}
+
+func validateListRiskReviewsRequest(req *pb.ListRiskReviewsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetStatus() != "" {
+		if err := val.ValidateRiskReviewStatus(req.GetStatus()); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	if req.GetAfterId() < 0 {
+		violations = append(violations, fieldViolation("after_id", fmt.Errorf("must not be negative")))
+	}
+
+	return violations
+}
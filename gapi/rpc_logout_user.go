@@ -0,0 +1,48 @@
+package gapi
+
+import (
+	"context"
+	"fmt"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) LogoutUser(ctx context.Context, req *pb.LogoutUserRequest) (*pb.LogoutUserResponse, error) {
+	violations := validateLogoutUserRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	session, err := server.getRefreshSession(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+
+	// a consumed token was already rotated, so the family's live session is ended like on reuse.
+	// Logging out twice is not an error.
+	if session.ConsumedAt.Valid {
+		_, err = server.store.BlockSessionFamily(ctx, session.FamilyID)
+	} else {
+		_, err = server.store.BlockSession(ctx, db.BlockSessionParams{
+			ID:       session.ID,
+			Username: session.Username,
+		})
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to block session: %s", err)
+	}
+
+	return &pb.LogoutUserResponse{}, nil
+}
+
+func validateLogoutUserRequest(req *pb.LogoutUserRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.GetRefreshToken() == "" {
+		violations = append(violations, fieldViolation("refresh_token", fmt.Errorf("must not be empty")))
+	}
+
+	return violations
+}
@@ -0,0 +1,106 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestLogoutUserAPI(t *testing.T) {
+	user, _ := randomUser(t)
+
+	testCases := []struct {
+		name          string
+		buildRequest  func(refreshToken string) *pb.LogoutUserRequest
+		buildStubs    func(store *mockdb.MockStore, session db.Session)
+		checkResponse func(t *testing.T, res *pb.LogoutUserResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildRequest: func(refreshToken string) *pb.LogoutUserRequest {
+				return &pb.LogoutUserRequest{RefreshToken: refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+
+				arg := db.BlockSessionParams{
+					ID:       session.ID,
+					Username: session.Username,
+				}
+				session.IsBlocked = true
+				store.EXPECT().BlockSession(gomock.Any(), gomock.Eq(arg)).Times(1).Return(session, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.LogoutUserResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "AlreadyLoggedOut",
+			buildRequest: func(refreshToken string) *pb.LogoutUserRequest {
+				return &pb.LogoutUserRequest{RefreshToken: refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				session.IsBlocked = true
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().BlockSession(gomock.Any(), gomock.Any()).Times(1).Return(session, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.LogoutUserResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "ConsumedToken",
+			buildRequest: func(refreshToken string) *pb.LogoutUserRequest {
+				return &pb.LogoutUserRequest{RefreshToken: refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				session.ConsumedAt = sql.NullTime{Time: time.Now(), Valid: true}
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().BlockSessionFamily(gomock.Any(), gomock.Eq(session.FamilyID)).Times(1).Return(int64(2), nil)
+				store.EXPECT().BlockSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LogoutUserResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "InvalidToken",
+			buildRequest: func(refreshToken string) *pb.LogoutUserRequest {
+				return &pb.LogoutUserRequest{RefreshToken: "invalid"}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().BlockSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.LogoutUserResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			server := newTestServer(t, store, nil)
+			refreshToken, session := randomSession(t, server, user.Username)
+
+			tc.buildStubs(store, session)
+
+			res, err := server.LogoutUser(context.Background(), tc.buildRequest(refreshToken))
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,80 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RefundTransfer lets the recipient of a transfer send all or part of it back.
+// Admins undo transfers regardless of who received them with ReverseTransfer.
+func (server *Server) RefundTransfer(ctx context.Context, req *pb.RefundTransferRequest) (*pb.RefundTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+
+	violations := validateRefundTransferRequest(req)
+	violations = append(violations, validateIdempotencyKey(key)...)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	transfer, err := server.store.GetTransfer(ctx, req.GetTransferId())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "transfer not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to get transfer: %s", err)
+	}
+
+	toAccount, err := server.store.GetAccount(ctx, transfer.ToAccountID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+	}
+
+	if toAccount.Owner != authPayload.Username {
+		return nil, status.Errorf(codes.PermissionDenied, "only the recipient of a transfer can refund it")
+	}
+
+	result, err := server.store.ReverseTransferTx(ctx, db.ReverseTransferTxParams{
+		TransferID: req.GetTransferId(),
+		Amount:     req.GetAmount(),
+		Kind:       db.JournalRefund,
+		Idempotency: newIdempotencyParams(authPayload.Username, key, "RefundTransfer",
+			req.GetTransferId(), req.GetAmount()),
+	})
+	if err != nil {
+		return nil, reverseTransferError(err)
+	}
+
+	rsp := &pb.RefundTransferResponse{
+		Refund:   convertTransfer(result.Transfer),
+		Transfer: convertTransfer(result.OriginalTransfer),
+		Account:  convertAccount(result.FromAccount),
+	}
+	return rsp, nil
+}
+
+func validateRefundTransferRequest(req *pb.RefundTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateTransferID(req.GetTransferId()); err != nil {
+		violations = append(violations, fieldViolation("transfer_id", err))
+	}
+
+	if req.Amount != nil {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	return violations
+}
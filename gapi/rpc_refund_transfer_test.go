@@ -0,0 +1,213 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+// randomTransferBetween returns a transfer from fromAccount to toAccount in the same currency
+func randomTransferBetween(fromAccount, toAccount db.Account) db.Transfer {
+	amount := util.RandomInt(2, 1000)
+	return db.Transfer{
+		ID:            util.RandomInt(1, 1000),
+		FromAccountID: fromAccount.ID,
+		ToAccountID:   toAccount.ID,
+		Amount:        amount,
+		ToAmount:      amount,
+		CreatedAt:     time.Now(),
+	}
+}
+
+// randomReversal returns the transfer sending amount of transfer back
+func randomReversal(transfer db.Transfer, amount int64) db.Transfer {
+	return db.Transfer{
+		ID:                 transfer.ID + 1,
+		FromAccountID:      transfer.ToAccountID,
+		ToAccountID:        transfer.FromAccountID,
+		Amount:             amount,
+		ToAmount:           amount,
+		ReversedTransferID: sql.NullInt64{Int64: transfer.ID, Valid: true},
+		CreatedAt:          time.Now(),
+	}
+}
+
+func TestRefundTransferAPI(t *testing.T) {
+	sender, _ := randomUser(t)
+	recipient, _ := randomUser(t)
+	fromAccount := randomAccount(sender.Username)
+	toAccount := randomAccount(recipient.Username)
+	toAccount.ID = fromAccount.ID + 1
+	toAccount.Currency = fromAccount.Currency
+
+	transfer := randomTransferBetween(fromAccount, toAccount)
+	amount := transfer.ToAmount / 2
+	refund := randomReversal(transfer, amount)
+	refunded := transfer
+	refunded.RefundedAmount = amount
+
+	testCases := []struct {
+		name          string
+		req           *pb.RefundTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.RefundTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.RefundTransferRequest{TransferId: transfer.ID, Amount: proto.Int64(amount)},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.ReverseTransferTxParams{
+					TransferID: transfer.ID,
+					Amount:     amount,
+					Kind:       db.JournalRefund,
+				}
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					ReverseTransferTx(gomock.Any(), gomock.Eq(arg)).
+					Times(1).
+					Return(db.ReverseTransferTxResult{
+						TransferTxResult: db.TransferTxResult{
+							Transfer:    refund,
+							FromAccount: toAccount,
+							ToAccount:   fromAccount,
+						},
+						OriginalTransfer: refunded,
+					}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, recipient.Username, recipient.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, refund.ID, res.GetRefund().GetId())
+				require.Equal(t, transfer.ID, res.GetRefund().GetReversedTransferId())
+				require.Equal(t, amount, res.GetTransfer().GetRefundedAmount())
+				require.Equal(t, toAccount.ID, res.GetAccount().GetId())
+			},
+		},
+		{
+			name: "ExceedsTransfer",
+			req:  &pb.RefundTransferRequest{TransferId: transfer.ID, Amount: proto.Int64(transfer.ToAmount + 1)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					ReverseTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, fmt.Errorf("%w: test", db.ErrReversalExceedsTransfer))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, recipient.Username, recipient.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "FullyRefunded",
+			req:  &pb.RefundTransferRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().
+					ReverseTransferTx(gomock.Any(), gomock.Any()).
+					Times(1).
+					Return(db.ReverseTransferTxResult{}, fmt.Errorf("%w: test", db.ErrTransferFullyRefunded))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, recipient.Username, recipient.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundTransferResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "NotRecipient",
+			req:  &pb.RefundTransferRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(toAccount, nil)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				// the sender has to ask the recipient or an admin
+				return newContextWithBearerToken(t, tokenMaker, sender.Username, sender.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundTransferResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "TransferNotFound",
+			req:  &pb.RefundTransferRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(db.Transfer{}, sql.ErrNoRows)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, recipient.Username, recipient.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundTransferResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "InvalidAmount",
+			req:  &pb.RefundTransferRequest{TransferId: transfer.ID, Amount: proto.Int64(0)},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, recipient.Username, recipient.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.RefundTransferRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().ReverseTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.RefundTransferResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.RefundTransfer(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
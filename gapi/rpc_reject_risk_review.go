@@ -0,0 +1,52 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) RejectRiskReview(ctx context.Context, req *pb.RejectRiskReviewRequest) (*pb.RejectRiskReviewResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateRejectRiskReviewRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var decision db.RiskDecision
+	err = server.audit(ctx, authPayload, auditRejectRiskReview, riskReviewTarget(req.GetId()), req, func(q db.Querier) error {
+		var err error
+		decision, err = db.RejectRiskReview(ctx, q, db.ReviewRiskTxParams{
+			ID:         req.GetId(),
+			ReviewedBy: authPayload.Username,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, riskReviewError(err)
+	}
+
+	rsp := &pb.RejectRiskReviewResponse{
+		Review: convertRiskDecision(decision),
+	}
+	return rsp, nil
+}
+
+func validateRejectRiskReviewRequest(req *pb.RejectRiskReviewRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateRiskDecisionID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
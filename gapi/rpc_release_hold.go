@@ -0,0 +1,47 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ReleaseHold(ctx context.Context, req *pb.ReleaseHoldRequest) (*pb.ReleaseHoldResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReleaseHoldRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, _, err = server.getHoldOfUser(ctx, authPayload.Username, req.GetHoldId())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := server.store.ReleaseHoldTx(ctx, db.ReleaseHoldTxParams{
+		HoldID: req.GetHoldId(),
+	})
+	if err != nil {
+		return nil, holdError(err)
+	}
+
+	rsp := &pb.ReleaseHoldResponse{
+		Hold: convertHold(result.Hold),
+	}
+	return rsp, nil
+}
+
+func validateReleaseHoldRequest(req *pb.ReleaseHoldRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateHoldID(req.GetHoldId()); err != nil {
+		violations = append(violations, fieldViolation("hold_id", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,182 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRenewAccessTokenAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	user.Role = util.SupportRole
+
+	testCases := []struct {
+		name          string
+		buildRequest  func(refreshToken string) *pb.RenewAccessTokenRequest
+		buildStubs    func(store *mockdb.MockStore, session db.Session)
+		checkResponse func(t *testing.T, tokenMaker token.Maker, res *pb.RenewAccessTokenResponse, err error)
+	}{
+		{
+			name: "OK",
+			buildRequest: func(refreshToken string) *pb.RenewAccessTokenRequest {
+				return &pb.RenewAccessTokenRequest{RefreshToken: refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.RotateSessionTxParams) (db.RotateSessionTxResult, error) {
+						require.Equal(t, session.ID, arg.OldSessionID)
+						require.NotEqual(t, session.ID, arg.NewSession.ID)
+						require.NotEqual(t, session.RefreshToken, arg.NewSession.RefreshToken)
+						return db.RotateSessionTxResult{OldSession: session}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, tokenMaker token.Maker, res *pb.RenewAccessTokenResponse, err error) {
+				require.NoError(t, err)
+				require.NotEmpty(t, res.GetAccessToken())
+				require.NotNil(t, res.GetAccessTokenExpiresAt())
+				require.NotEmpty(t, res.GetRefreshToken())
+				require.NotNil(t, res.GetRefreshTokenExpiresAt())
+
+				// the new access token carries the user's current role
+				payload, err := tokenMaker.VerifyToken(res.GetAccessToken())
+				require.NoError(t, err)
+				require.Equal(t, util.SupportRole, payload.Role)
+			},
+		},
+		{
+			name: "RefreshTokenReused",
+			buildRequest: func(refreshToken string) *pb.RenewAccessTokenRequest {
+				return &pb.RenewAccessTokenRequest{RefreshToken: refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.RotateSessionTxResult{OldSession: session}, db.ErrRefreshTokenReused)
+			},
+			checkResponse: func(t *testing.T, tokenMaker token.Maker, res *pb.RenewAccessTokenResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+		{
+			name: "BlockedSession",
+			buildRequest: func(refreshToken string) *pb.RenewAccessTokenRequest {
+				return &pb.RenewAccessTokenRequest{RefreshToken: refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user.Username)).Times(1).Return(user, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.RotateSessionTxResult{}, db.ErrSessionBlocked)
+			},
+			checkResponse: func(t *testing.T, tokenMaker token.Maker, res *pb.RenewAccessTokenResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+		{
+			name: "MismatchedSessionToken",
+			buildRequest: func(refreshToken string) *pb.RenewAccessTokenRequest {
+				return &pb.RenewAccessTokenRequest{RefreshToken: refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				session.RefreshToken = util.RandomString(32)
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(session, nil)
+				store.EXPECT().RotateSessionTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, tokenMaker token.Maker, res *pb.RenewAccessTokenResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+		{
+			name: "SessionNotFound",
+			buildRequest: func(refreshToken string) *pb.RenewAccessTokenRequest {
+				return &pb.RenewAccessTokenRequest{RefreshToken: refreshToken}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Eq(session.ID)).Times(1).Return(db.Session{}, sql.ErrNoRows)
+			},
+			checkResponse: func(t *testing.T, tokenMaker token.Maker, res *pb.RenewAccessTokenResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "InvalidToken",
+			buildRequest: func(refreshToken string) *pb.RenewAccessTokenRequest {
+				return &pb.RenewAccessTokenRequest{RefreshToken: "invalid"}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, tokenMaker token.Maker, res *pb.RenewAccessTokenResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+		{
+			name: "MissingToken",
+			buildRequest: func(refreshToken string) *pb.RenewAccessTokenRequest {
+				return &pb.RenewAccessTokenRequest{}
+			},
+			buildStubs: func(store *mockdb.MockStore, session db.Session) {
+				store.EXPECT().GetSession(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, tokenMaker token.Maker, res *pb.RenewAccessTokenResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			server := newTestServer(t, store, nil)
+			refreshToken, session := randomSession(t, server, user.Username)
+
+			tc.buildStubs(store, session)
+
+			res, err := server.RenewAccessToken(context.Background(), tc.buildRequest(refreshToken))
+			tc.checkResponse(t, server.tokenMaker, res, err)
+		})
+	}
+}
+
+func randomSession(t *testing.T, server *Server, username string) (string, db.Session) {
+	refreshToken, refreshPayload, err := server.tokenMaker.CreateToken(username, util.CustomerRole, time.Hour)
+	require.NoError(t, err)
+
+	session := db.Session{
+		ID:           refreshPayload.ID,
+		Username:     username,
+		RefreshToken: refreshToken,
+		UserAgent:    util.RandomString(10),
+		ClientIp:     "127.0.0.1",
+		ExpiresAt:    refreshPayload.ExpiredAt,
+		CreatedAt:    refreshPayload.IssuedAt,
+		FamilyID:     refreshPayload.ID,
+	}
+	return refreshToken, session
+}
+
+func requireStatusCode(t *testing.T, err error, code codes.Code) {
+	require.Error(t, err)
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	require.Equal(t, code, st.Code())
+}
@@ -0,0 +1,60 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) ReverseTransfer(ctx context.Context, req *pb.ReverseTransferRequest) (*pb.ReverseTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateReverseTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var result db.ReverseTransferTxResult
+	err = server.audit(ctx, authPayload, auditReverseTransfer, transferTarget(req.GetTransferId()), req, func(q db.Querier) error {
+		var err error
+		result, err = db.ReverseTransfer(ctx, q, db.ReverseTransferTxParams{
+			TransferID: req.GetTransferId(),
+			Amount:     req.GetAmount(),
+			Kind:       db.JournalReversal,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, reverseTransferError(err)
+	}
+
+	rsp := &pb.ReverseTransferResponse{
+		Reversal: convertTransfer(result.Transfer),
+		Transfer: convertTransfer(result.OriginalTransfer),
+	}
+	return rsp, nil
+}
+
+func validateReverseTransferRequest(req *pb.ReverseTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateTransferID(req.GetTransferId()); err != nil {
+		violations = append(violations, fieldViolation("transfer_id", err))
+	}
+
+	if req.Amount != nil {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	if err := val.ValidateReason(req.GetReason()); err != nil {
+		violations = append(violations, fieldViolation("reason", err))
+	}
+
+	return violations
+}
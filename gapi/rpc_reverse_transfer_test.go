@@ -0,0 +1,172 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestReverseTransferAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+	admin.Role = util.AdminRole
+
+	sender, _ := randomUser(t)
+	recipient, _ := randomUser(t)
+	fromAccount := randomAccount(sender.Username)
+	toAccount := randomAccount(recipient.Username)
+	toAccount.ID = fromAccount.ID + 1
+	toAccount.Currency = fromAccount.Currency
+	fromAccount.Status = util.AccountActive
+	toAccount.Status = util.AccountActive
+
+	transfer := randomTransferBetween(fromAccount, toAccount)
+	toAccount.Balance = transfer.ToAmount
+	reversal := randomReversal(transfer, transfer.ToAmount)
+	reversed := transfer
+	reversed.RefundedAmount = transfer.ToAmount
+
+	testCases := []struct {
+		name          string
+		req           *pb.ReverseTransferRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.ReverseTransferResponse, err error)
+	}{
+		{
+			name: "OK",
+			req:  &pb.ReverseTransferRequest{TransferId: transfer.ID, Reason: "sent to the wrong account"},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditReverseTransfer, transferTarget(transfer.ID))
+
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Eq(fromAccount.ID)).Times(2).Return(fromAccount, nil)
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Eq(toAccount.ID)).Times(2).Return(toAccount, nil)
+				store.EXPECT().GetTransferForUpdate(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(transfer, nil)
+				store.EXPECT().GetHeldAmount(gomock.Any(), gomock.Eq(toAccount.ID)).Times(1).Return(int64(0), nil)
+
+				arg := db.CreateTransferParams{
+					FromAccountID:      toAccount.ID,
+					ToAccountID:        fromAccount.ID,
+					Amount:             transfer.ToAmount,
+					ToAmount:           transfer.Amount,
+					ReversedTransferID: sql.NullInt64{Int64: transfer.ID, Valid: true},
+				}
+				store.EXPECT().CreateTransfer(gomock.Any(), gomock.Eq(arg)).Times(1).Return(reversal, nil)
+				store.EXPECT().CreateEntry(gomock.Any(), gomock.Any()).Times(2)
+				store.EXPECT().
+					CreateJournal(gomock.Any(), gomock.Eq(db.CreateJournalParams{
+						Kind:       db.JournalReversal,
+						TransferID: sql.NullInt64{Int64: reversal.ID, Valid: true},
+					})).
+					Times(1).
+					Return(db.Journal{ID: 1, Kind: db.JournalReversal}, nil)
+				store.EXPECT().CreatePosting(gomock.Any(), gomock.Any()).Times(2)
+				store.EXPECT().AddAccountBalance(gomock.Any(), gomock.Any()).Times(2)
+
+				store.EXPECT().
+					AddTransferRefundedAmount(gomock.Any(), gomock.Eq(db.AddTransferRefundedAmountParams{
+						ID:     transfer.ID,
+						Amount: transfer.ToAmount,
+					})).
+					Times(1).
+					Return(reversed, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, reversal.ID, res.GetReversal().GetId())
+				require.Equal(t, transfer.ID, res.GetReversal().GetReversedTransferId())
+				require.Equal(t, transfer.ToAmount, res.GetTransfer().GetRefundedAmount())
+			},
+		},
+		{
+			name: "FullyRefunded",
+			req:  &pb.ReverseTransferRequest{TransferId: transfer.ID, Reason: "sent to the wrong account"},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditReverseTransfer, transferTarget(transfer.ID))
+
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(reversed, nil)
+				store.EXPECT().GetAccountForUpdate(gomock.Any(), gomock.Any()).Times(2)
+				store.EXPECT().GetTransferForUpdate(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(reversed, nil)
+				store.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "TransferNotFound",
+			req:  &pb.ReverseTransferRequest{TransferId: transfer.ID, Reason: "sent to the wrong account"},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditReverseTransfer, transferTarget(transfer.ID))
+
+				store.EXPECT().GetTransfer(gomock.Any(), gomock.Eq(transfer.ID)).Times(1).Return(db.Transfer{}, sql.ErrNoRows)
+				store.EXPECT().CreateTransfer(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				requireStatusCode(t, err, codes.NotFound)
+			},
+		},
+		{
+			name: "MissingReason",
+			req:  &pb.ReverseTransferRequest{TransferId: transfer.ID},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req:  &pb.ReverseTransferRequest{TransferId: transfer.ID, Reason: "sent to the wrong account"},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.ReverseTransferResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.ReverseTransfer(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// likeEscaper makes LIKE wildcards in a search query match literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func (server *Server) SearchUsers(ctx context.Context, req *pb.SearchUsersRequest) (*pb.SearchUsersResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSearchUsersRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	pageSize := req.GetPageSize()
+	if pageSize == 0 {
+		pageSize = util.DefaultPageSize
+	}
+
+	var users []db.User
+	err = server.audit(ctx, authPayload, auditSearchUsers, "users", req, func(q db.Querier) error {
+		var err error
+		users, err = q.SearchUsers(ctx, db.SearchUsersParams{
+			Pattern:  "%" + likeEscaper.Replace(req.GetQuery()) + "%",
+			PageSize: pageSize,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to search users: %s", err)
+	}
+
+	rsp := &pb.SearchUsersResponse{}
+	for _, user := range users {
+		rsp.Users = append(rsp.Users, convertUser(user))
+	}
+	return rsp, nil
+}
+
+func validateSearchUsersRequest(req *pb.SearchUsersRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateSearchQuery(req.GetQuery()); err != nil {
+		violations = append(violations, fieldViolation("query", err))
+	}
+
+	if err := val.ValidatePageSize(req.GetPageSize()); err != nil {
+		violations = append(violations, fieldViolation("page_size", err))
+	}
+
+	return violations
+}
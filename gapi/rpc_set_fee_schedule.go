@@ -0,0 +1,73 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/fee"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetFeeSchedule(ctx context.Context, req *pb.SetFeeScheduleRequest) (*pb.SetFeeScheduleResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSetFeeScheduleRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	var schedule db.FeeSchedule
+	target := feeScheduleTarget(req.GetOperation(), req.GetCurrency())
+	err = server.audit(ctx, authPayload, auditSetFeeSchedule, target, req, func(q db.Querier) error {
+		var err error
+		schedule, err = q.UpsertFeeSchedule(ctx, db.UpsertFeeScheduleParams{
+			Operation:    req.GetOperation(),
+			Currency:     req.GetCurrency(),
+			FlatAmount:   req.GetFlatAmount(),
+			Rate:         req.GetRate(),
+			MinAmount:    req.GetMinAmount(),
+			MaxAmount:    req.GetMaxAmount(),
+			FreePerMonth: req.GetFreePerMonth(),
+			UpdatedBy:    authPayload.Username,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set fee schedule: %s", err)
+	}
+
+	rsp := &pb.SetFeeScheduleResponse{
+		Schedule: convertFeeSchedule(schedule),
+	}
+	return rsp, nil
+}
+
+func validateSetFeeScheduleRequest(req *pb.SetFeeScheduleRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateFeeOperation(req.GetOperation()); err != nil {
+		violations = append(violations, fieldViolation("operation", err))
+	}
+
+	if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+		violations = append(violations, fieldViolation("currency", err))
+	}
+
+	schedule := fee.Schedule{
+		Flat:         req.GetFlatAmount(),
+		Rate:         req.GetRate(),
+		Min:          req.GetMinAmount(),
+		Max:          req.GetMaxAmount(),
+		FreePerMonth: req.GetFreePerMonth(),
+	}
+	if err := schedule.Validate(); err != nil {
+		violations = append(violations, fieldViolation("schedule", err))
+	}
+
+	return violations
+}
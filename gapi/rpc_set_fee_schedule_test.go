@@ -0,0 +1,142 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetFeeScheduleAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+	admin.Role = util.AdminRole
+
+	testCases := []struct {
+		name          string
+		req           *pb.SetFeeScheduleRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.SetFeeScheduleResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.SetFeeScheduleRequest{
+				Operation:    util.FeeTransfer,
+				Currency:     util.USD,
+				FlatAmount:   25,
+				Rate:         150,
+				MinAmount:    50,
+				MaxAmount:    1000,
+				FreePerMonth: 3,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditSetFeeSchedule, feeScheduleTarget(util.FeeTransfer, util.USD))
+
+				arg := db.UpsertFeeScheduleParams{
+					Operation:    util.FeeTransfer,
+					Currency:     util.USD,
+					FlatAmount:   25,
+					Rate:         150,
+					MinAmount:    50,
+					MaxAmount:    1000,
+					FreePerMonth: 3,
+					UpdatedBy:    admin.Username,
+				}
+				store.EXPECT().UpsertFeeSchedule(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.FeeSchedule{
+					Operation:    arg.Operation,
+					Currency:     arg.Currency,
+					FlatAmount:   arg.FlatAmount,
+					Rate:         arg.Rate,
+					MinAmount:    arg.MinAmount,
+					MaxAmount:    arg.MaxAmount,
+					FreePerMonth: arg.FreePerMonth,
+					UpdatedBy:    arg.UpdatedBy,
+				}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetFeeScheduleResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int32(150), res.GetSchedule().GetRate())
+				require.Equal(t, int32(3), res.GetSchedule().GetFreePerMonth())
+				require.Equal(t, admin.Username, res.GetSchedule().GetUpdatedBy())
+			},
+		},
+		{
+			name: "InvalidOperation",
+			req: &pb.SetFeeScheduleRequest{
+				Operation: "deposit",
+				Currency:  util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetFeeScheduleResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "MaxBelowMin",
+			req: &pb.SetFeeScheduleRequest{
+				Operation: util.FeeWithdrawal,
+				Currency:  util.USD,
+				MinAmount: 100,
+				MaxAmount: 50,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetFeeScheduleResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.SetFeeScheduleRequest{
+				Operation: util.FeeTransfer,
+				Currency:  util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.SetFeeScheduleResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SetFeeSchedule(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
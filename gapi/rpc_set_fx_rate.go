@@ -0,0 +1,59 @@
+package gapi
+
+import (
+	"context"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) SetFxRate(ctx context.Context, req *pb.SetFxRateRequest) (*pb.SetFxRateResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateSetFxRateRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	// already validated above
+	scaledRate, _ := util.ParseFXRate(req.GetRate())
+
+	var rate db.FxRate
+	target := fxRateTarget(req.GetFromCurrency(), req.GetToCurrency())
+	err = server.audit(ctx, authPayload, auditSetFxRate, target, req, func(q db.Querier) error {
+		var err error
+		rate, err = q.UpsertFxRate(ctx, db.UpsertFxRateParams{
+			FromCurrency: req.GetFromCurrency(),
+			ToCurrency:   req.GetToCurrency(),
+			Rate:         scaledRate,
+			UpdatedBy:    authPayload.Username,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to set fx rate: %s", err)
+	}
+
+	rsp := &pb.SetFxRateResponse{
+		Rate: convertFxRate(rate),
+	}
+	return rsp, nil
+}
+
+func validateSetFxRateRequest(req *pb.SetFxRateRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	violations = validateCurrencyPair(req)
+
+	if err := val.ValidateFxRate(req.GetRate()); err != nil {
+		violations = append(violations, fieldViolation("rate", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,113 @@
+package gapi
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestSetFxRateAPI(t *testing.T) {
+	admin, _ := randomUser(t)
+	admin.Role = util.AdminRole
+
+	testCases := []struct {
+		name          string
+		req           *pb.SetFxRateRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.SetFxRateResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.SetFxRateRequest{
+				FromCurrency: util.EUR,
+				ToCurrency:   util.USD,
+				Rate:         "1.0901",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				expectAuditTx(t, store, admin.Username, auditSetFxRate, fxRateTarget(util.EUR, util.USD))
+
+				arg := db.UpsertFxRateParams{
+					FromCurrency: util.EUR,
+					ToCurrency:   util.USD,
+					Rate:         109_010_000,
+					UpdatedBy:    admin.Username,
+				}
+				store.EXPECT().UpsertFxRate(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.FxRate{
+					FromCurrency: arg.FromCurrency,
+					ToCurrency:   arg.ToCurrency,
+					Rate:         arg.Rate,
+					UpdatedBy:    arg.UpdatedBy,
+				}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetFxRateResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "1.0901", res.GetRate().GetRate())
+				require.Equal(t, admin.Username, res.GetRate().GetUpdatedBy())
+			},
+		},
+		{
+			name: "InvalidRate",
+			req: &pb.SetFxRateRequest{
+				FromCurrency: util.EUR,
+				ToCurrency:   util.USD,
+				Rate:         "1.000000001",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, admin.Username, admin.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.SetFxRateResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.SetFxRateRequest{
+				FromCurrency: util.EUR,
+				ToCurrency:   util.USD,
+				Rate:         "1.0901",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().AuditTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.SetFxRateResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.SetFxRate(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
@@ -3,10 +3,15 @@ package gapi
 import (
 	"context"
 	"database/sql"
+	"errors"
+	"fmt"
 
 	db "github.com/JaidenShall/simplebank/db/sqlc"
 	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
 	"github.com/JaidenShall/simplebank/val"
+	"github.com/google/uuid"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -18,7 +23,11 @@ func (server *Server) TransferMoney(ctx context.Context, req *pb.TransferMoneyRe
 		return nil, unauthenticatedError(err)
 	}
 
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+
 	violations := validateTransferMoneyRequest(req)
+	violations = append(violations, validateAnnotation(req)...)
+	violations = append(violations, validateIdempotencyKey(key)...)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
@@ -42,8 +51,17 @@ func (server *Server) TransferMoney(ctx context.Context, req *pb.TransferMoneyRe
 		return nil, status.Errorf(codes.InvalidArgument, "from account currency mismatch: %s vs %s", fromAccount.Currency, req.GetCurrency())
 	}
 
+	toAccountID := req.GetToAccountId()
+	if req.PayeeId != nil {
+		payee, err := server.getOwnPayee(ctx, authPayload.Username, req.GetPayeeId())
+		if err != nil {
+			return nil, err
+		}
+		toAccountID = payee.AccountID
+	}
+
 	// Get and validate to account
-	toAccount, err := server.store.GetAccount(ctx, req.GetToAccountId())
+	toAccount, err := server.store.GetAccount(ctx, toAccountID)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, status.Errorf(codes.NotFound, "to account not found")
@@ -51,29 +69,106 @@ func (server *Server) TransferMoney(ctx context.Context, req *pb.TransferMoneyRe
 		return nil, status.Errorf(codes.Internal, "failed to get to account: %s", err)
 	}
 
-	// Validate to account currency
-	if toAccount.Currency != req.GetCurrency() {
+	// Accounts in different currencies need a quote, whose currencies are checked by the transaction
+	if req.FxQuoteId == nil && toAccount.Currency != req.GetCurrency() {
 		return nil, status.Errorf(codes.InvalidArgument, "to account currency mismatch: %s vs %s", toAccount.Currency, req.GetCurrency())
 	}
 
+	recipientName, err := server.recipientName(ctx, toAccount)
+	if err != nil {
+		return nil, err
+	}
+
 	// Perform the transfer transaction
+	idempotency := newIdempotencyParams(authPayload.Username, key, "TransferMoney",
+		req.GetFromAccountId(), toAccountID, req.GetAmount(), req.GetCurrency(), req.GetFxQuoteId(),
+		req.GetMemo(), req.GetReference(), req.GetCategory())
+
 	arg := db.TransferTxParams{
 		FromAccountID: req.GetFromAccountId(),
-		ToAccountID:   req.GetToAccountId(),
+		ToAccountID:   toAccountID,
 		Amount:        req.GetAmount(),
+		Annotation:    convertAnnotation(req),
+		NewPayeeLimit: db.NewPayeeLimitFromConfig(server.config),
+		Idempotency:   idempotency,
+	}
+
+	// fx quotes expire long before a review, so fx transfers can't be held
+	var request interface{}
+	if req.FxQuoteId == nil {
+		request = arg
 	}
 
-	result, err := server.store.TransferTx(ctx, arg)
+	held, decision, err := server.screenRisk(ctx, risk.Operation{
+		Kind:        util.RiskTransfer,
+		Username:    authPayload.Username,
+		AccountID:   fromAccount.ID,
+		ToAccountID: toAccount.ID,
+		Amount:      req.GetAmount(),
+		Currency:    fromAccount.Currency,
+	}, request, idempotency)
+	if err != nil {
+		return nil, err
+	}
+	if held != nil {
+		return &pb.TransferMoneyResponse{Review: convertRiskReview(*held)}, nil
+	}
+	arg.RiskDecision = decision
+
+	var result db.TransferTxResult
+	if req.FxQuoteId != nil {
+		result, err = server.store.FxTransferTx(ctx, db.FxTransferTxParams{
+			FromAccountID: req.GetFromAccountId(),
+			ToAccountID:   toAccountID,
+			Amount:        req.GetAmount(),
+			QuoteID:       uuid.MustParse(req.GetFxQuoteId()),
+			Username:      authPayload.Username,
+			Annotation:    convertAnnotation(req),
+			NewPayeeLimit: db.NewPayeeLimitFromConfig(server.config),
+			RiskDecision:  decision,
+			Idempotency:   idempotency,
+		})
+	} else {
+		result, err = server.store.TransferTx(ctx, arg)
+	}
 	if err != nil {
+		if errors.Is(err, db.ErrFxQuoteNotFound) {
+			return nil, status.Errorf(codes.NotFound, "%s", err)
+		}
+		if errors.Is(err, db.ErrFxQuoteExpired) || errors.Is(err, db.ErrFxQuoteUsed) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, db.ErrFxQuoteMismatch) || errors.Is(err, db.ErrFxAmountTooSmall) {
+			return nil, status.Errorf(codes.InvalidArgument, "%s", err)
+		}
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		var notActiveErr *db.AccountNotActiveError
+		if errors.As(err, &notActiveErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		var limitErr *db.SpendingLimitError
+		if errors.As(err, &limitErr) || errors.Is(err, db.ErrNewPayeeLimit) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, db.ErrIdempotencyKeyReused) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
+		}
 		return nil, status.Errorf(codes.Internal, "transfer transaction failed: %s", err)
 	}
 
 	rsp := &pb.TransferMoneyResponse{
-		Transfer:    convertTransfer(result.Transfer),
-		FromAccount: convertAccount(result.FromAccount),
-		ToAccount:   convertAccount(result.ToAccount),
-		FromEntry:   convertEntry(result.FromEntry),
-		ToEntry:     convertEntry(result.ToEntry),
+		Transfer:      convertTransfer(result.Transfer),
+		FromAccount:   convertAccount(result.FromAccount),
+		ToAccount:     convertAccount(result.ToAccount),
+		FromEntry:     convertEntry(result.FromEntry),
+		ToEntry:       convertEntry(result.ToEntry),
+		Fee:           convertFeeQuote(result.Fee, result.FromAccount.Currency),
+		RecipientName: recipientName,
+	}
+	if result.FeeEntry.ID != 0 {
+		rsp.FeeEntry = convertEntry(result.FeeEntry)
 	}
 
 	return rsp, nil
@@ -84,7 +179,14 @@ func validateTransferMoneyRequest(req *pb.TransferMoneyRequest) (violations []*e
 		violations = append(violations, fieldViolation("from_account_id", err))
 	}
 
-	if err := val.ValidateAccountID(req.GetToAccountId()); err != nil {
+	if req.PayeeId != nil {
+		if err := val.ValidatePayeeID(req.GetPayeeId()); err != nil {
+			violations = append(violations, fieldViolation("payee_id", err))
+		}
+		if req.GetToAccountId() != 0 {
+			violations = append(violations, fieldViolation("to_account_id", fmt.Errorf("must be left out when paying a payee")))
+		}
+	} else if err := val.ValidateAccountID(req.GetToAccountId()); err != nil {
 		violations = append(violations, fieldViolation("to_account_id", err))
 	}
 
@@ -96,5 +198,11 @@ func validateTransferMoneyRequest(req *pb.TransferMoneyRequest) (violations []*e
 		violations = append(violations, fieldViolation("currency", err))
 	}
 
+	if req.FxQuoteId != nil {
+		if err := val.ValidateFxQuoteID(req.GetFxQuoteId()); err != nil {
+			violations = append(violations, fieldViolation("fx_quote_id", err))
+		}
+	}
+
 	return violations
 }
@@ -0,0 +1,833 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestTransferMoneyAPI(t *testing.T) {
+	amount := int64(10)
+
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	account1 := randomAccount(user1.Username)
+	account2 := randomAccount(user2.Username)
+	account1.Currency = util.USD
+	account2.Currency = util.USD
+
+	eurAccount := randomAccount(user2.Username)
+	eurAccount.Currency = util.EUR
+	fxQuoteID := uuid.New().String()
+	invalidFxQuoteID := "not a uuid"
+
+	idempotencyKey := util.RandomString(32)
+	invalidIdempotencyKey := "not a valid key!"
+
+	testCases := []struct {
+		name          string
+		req           *pb.TransferMoneyRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.TransferMoneyResponse, err error)
+	}{
+		{
+			name: "OK",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+
+				arg := db.TransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Amount:        amount,
+				}
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.TransferTxResult{
+					FromAccount: account1,
+					ToAccount:   account2,
+				}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, res)
+				require.Equal(t, account1.ID, res.GetFromAccount().GetId())
+				require.Equal(t, account2.ID, res.GetToAccount().GetId())
+				require.Equal(t, util.MaskName(user2.FullName), res.GetRecipientName())
+			},
+		},
+		{
+			name: "InsufficientFunds",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferTxResult{}, fmt.Errorf("%w: account %d", db.ErrInsufficientFunds, account1.ID))
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.FailedPrecondition, st.Code())
+			},
+		},
+		{
+			name: "AccountFrozen",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferTxResult{}, &db.AccountNotActiveError{AccountID: account2.ID, Status: util.AccountFrozen})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "SpendingLimitExceeded",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferTxResult{}, &db.SpendingLimitError{
+						Scope: util.LimitScopeAccount,
+						Limit: util.LimitDaily,
+						Max:   amount,
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "FxTransfer",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   eurAccount.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				FxQuoteId:     &fxQuoteID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(eurAccount.ID)).Times(1).Return(eurAccount, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+
+				arg := db.FxTransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   eurAccount.ID,
+					Amount:        amount,
+					QuoteID:       uuid.MustParse(fxQuoteID),
+					Username:      user1.Username,
+				}
+				store.EXPECT().FxTransferTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.TransferTxResult{
+					Transfer: db.Transfer{
+						FromAccountID: account1.ID,
+						ToAccountID:   eurAccount.ID,
+						Amount:        amount,
+						ToAmount:      9,
+						FxRate:        sql.NullInt64{Int64: 91_734_000, Valid: true},
+						FxQuoteID:     uuid.NullUUID{UUID: arg.QuoteID, Valid: true},
+					},
+					FromAccount: account1,
+					ToAccount:   eurAccount,
+				}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, int64(9), res.GetTransfer().GetToAmount())
+				require.Equal(t, "0.91734", res.GetTransfer().GetFxRate())
+				require.Equal(t, fxQuoteID, res.GetTransfer().GetFxQuoteId())
+			},
+		},
+		{
+			name: "FxQuoteExpired",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   eurAccount.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				FxQuoteId:     &fxQuoteID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(eurAccount.ID)).Times(1).Return(eurAccount, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().FxTransferTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferTxResult{}, db.ErrFxQuoteExpired)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+		{
+			name: "CurrencyMismatchWithoutQuote",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   eurAccount.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(eurAccount.ID)).Times(1).Return(eurAccount, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().FxTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidFxQuoteID",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   eurAccount.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				FxQuoteId:     &invalidFxQuoteID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().FxTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "IdempotencyKeyFromHeader",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.TransferTxParams) (db.TransferTxResult, error) {
+						require.NotNil(t, arg.Idempotency)
+						require.Equal(t, user1.Username, arg.Idempotency.Username)
+						require.Equal(t, idempotencyKey, arg.Idempotency.Key)
+						require.NotEmpty(t, arg.Idempotency.RequestHash)
+						return db.TransferTxResult{FromAccount: account1, ToAccount: account2}, nil
+					})
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				ctx := newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+				md, _ := metadata.FromIncomingContext(ctx)
+				md = metadata.Join(md, metadata.Pairs(idempotencyKeyHeader, idempotencyKey))
+				return metadata.NewIncomingContext(ctx, md)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "IdempotencyKeyReused",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId:  account1.ID,
+				ToAccountId:    account2.ID,
+				Amount:         amount,
+				Currency:       util.USD,
+				IdempotencyKey: &idempotencyKey,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferTxResult{}, db.ErrIdempotencyKeyReused)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.AlreadyExists, st.Code())
+			},
+		},
+		{
+			name: "WithAnnotation",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				Memo:          "Dinner on Friday",
+				Reference:     "INV-42",
+				Category:      "food",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+
+				arg := db.TransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Amount:        amount,
+					Annotation: db.Annotation{
+						Memo:      "Dinner on Friday",
+						Reference: "INV-42",
+						Category:  "food",
+					},
+				}
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.TransferTxResult{
+					Transfer: db.Transfer{Memo: arg.Memo, Reference: arg.Reference, Category: arg.Category},
+				}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "Dinner on Friday", res.GetTransfer().GetMemo())
+				require.Equal(t, "INV-42", res.GetTransfer().GetReference())
+				require.Equal(t, "food", res.GetTransfer().GetCategory())
+			},
+		},
+		{
+			name: "InvalidAnnotation",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+				Memo:          "line\nbreak",
+				Reference:     "not a reference",
+				Category:      "Food",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "InvalidIdempotencyKey",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId:  account1.ID,
+				ToAccountId:    account2.ID,
+				Amount:         amount,
+				Currency:       util.USD,
+				IdempotencyKey: &invalidIdempotencyKey,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user1.Username, user1.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.InvalidArgument, st.Code())
+			},
+		},
+		{
+			name: "UnauthorizedUser",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user2.Username, user2.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.PermissionDenied, st.Code())
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.Error(t, err)
+				st, ok := status.FromError(err)
+				require.True(t, ok)
+				require.Equal(t, codes.Unauthenticated, st.Code())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.TransferMoney(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestTransferMoneyPayeeAPI(t *testing.T) {
+	newPayeeLimit := db.NewPayeeLimit{Amount: 100, Period: 24 * time.Hour}
+
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	account1 := randomAccount(user1.Username)
+	account2 := randomAccount(user2.Username)
+	ownAccount := randomAccount(user1.Username)
+	account1.Currency = util.USD
+	account2.Currency = util.USD
+	ownAccount.Currency = util.USD
+
+	payee := db.Payee{
+		ID:        util.RandomInt(1, 1000),
+		Owner:     user1.Username,
+		AccountID: account2.ID,
+		Nickname:  "landlord",
+	}
+	otherPayee := payee
+	otherPayee.Owner = user2.Username
+
+	testCases := []struct {
+		name          string
+		req           *pb.TransferMoneyRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.TransferMoneyResponse, err error)
+	}{
+		{
+			name: "PayPayee",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				PayeeId:       &payee.ID,
+				Amount:        newPayeeLimit.Amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetPayee(gomock.Any(), gomock.Eq(payee.ID)).Times(1).Return(payee, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+
+				arg := db.TransferTxParams{
+					FromAccountID: account1.ID,
+					ToAccountID:   account2.ID,
+					Amount:        newPayeeLimit.Amount,
+					NewPayeeLimit: newPayeeLimit,
+				}
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Eq(arg)).Times(1).Return(db.TransferTxResult{
+					FromAccount: account1,
+					ToAccount:   account2,
+				}, nil)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account2.ID, res.GetToAccount().GetId())
+				require.Equal(t, util.MaskName(user2.FullName), res.GetRecipientName())
+			},
+		},
+		{
+			name: "PayeeOfAnotherUser",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				PayeeId:       &otherPayee.ID,
+				Amount:        newPayeeLimit.Amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetPayee(gomock.Any(), gomock.Eq(otherPayee.ID)).Times(1).Return(otherPayee, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "PayeeAndToAccount",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				PayeeId:       &payee.ID,
+				Amount:        newPayeeLimit.Amount,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetPayee(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NewPayeeLimitExceeded",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        newPayeeLimit.Amount + 1,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).
+					Return(db.TransferTxResult{}, fmt.Errorf("%w: at most 100 can be sent to account %d", db.ErrNewPayeeLimit, account2.ID))
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.FailedPrecondition)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			server.config.NewPayeeTransferLimit = newPayeeLimit.Amount
+			server.config.NewPayeeTransferPeriod = newPayeeLimit.Period
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user1.Username, user1.Role, time.Minute)
+			res, err := server.TransferMoney(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func TestTransferMoneyRiskAPI(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+
+	account1 := randomAccount(user1.Username)
+	account2 := randomAccount(user2.Username)
+	account1.Currency = util.USD
+	account2.Currency = util.USD
+
+	eurAccount := randomAccount(user2.Username)
+	eurAccount.Currency = util.EUR
+	fxQuoteID := uuid.New().String()
+
+	idempotencyKey := util.RandomString(32)
+	idempotency := newIdempotencyParams(user1.Username, &idempotencyKey, "TransferMoney",
+		account1.ID, account2.ID, int64(1000), util.USD, "", "", "", "")
+
+	held := db.RiskDecision{
+		ID:             util.RandomInt(1, 1000),
+		Username:       user1.Username,
+		Operation:      util.RiskTransfer,
+		AccountID:      account1.ID,
+		ToAccountID:    sql.NullInt64{Int64: account2.ID, Valid: true},
+		Amount:         1000,
+		Currency:       util.USD,
+		Action:         util.RiskReview,
+		Rules:          []string{"review_amount"},
+		IdempotencyKey: sql.NullString{String: idempotencyKey, Valid: true},
+		RequestHash:    sql.NullString{String: idempotency.RequestHash, Valid: true},
+		ReviewStatus:   sql.NullString{String: util.RiskReviewPending, Valid: true},
+	}
+
+	testCases := []struct {
+		name          string
+		req           *pb.TransferMoneyRequest
+		buildStubs    func(store *mockdb.MockStore)
+		checkResponse func(t *testing.T, res *pb.TransferMoneyResponse, err error)
+	}{
+		{
+			name: "Allowed",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        10,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				// the transfer records the allowed decision along with the movement
+				store.EXPECT().CreateRiskDecision(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.TransferTxParams) (db.TransferTxResult, error) {
+						require.NotNil(t, arg.RiskDecision)
+						require.Equal(t, util.RiskAllow, arg.RiskDecision.Action)
+						require.Empty(t, arg.RiskDecision.Rules)
+						require.False(t, arg.RiskDecision.ReviewStatus.Valid)
+						require.Equal(t, int64(10), arg.RiskDecision.Amount)
+						return db.TransferTxResult{FromAccount: account1, ToAccount: account2}, nil
+					})
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.Nil(t, res.GetReview())
+				require.Equal(t, account1.ID, res.GetFromAccount().GetId())
+			},
+		},
+		{
+			name: "HeldForReview",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        1000,
+				Currency:      util.USD,
+				Memo:          "rent",
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().CreateRiskDecision(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateRiskDecisionParams) (db.RiskDecision, error) {
+						require.Equal(t, util.RiskReview, arg.Action)
+						require.Equal(t, []string{"review_amount"}, arg.Rules)
+						require.Equal(t, util.RiskReviewPending, arg.ReviewStatus.String)
+						require.Equal(t, account2.ID, arg.ToAccountID.Int64)
+
+						var request db.TransferTxParams
+						require.NoError(t, json.Unmarshal(arg.Request, &request))
+						require.Equal(t, int64(1000), request.Amount)
+						require.Equal(t, "rent", request.Memo)
+						return held, nil
+					})
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.Nil(t, res.GetTransfer())
+				require.Equal(t, held.ID, res.GetReview().GetId())
+				require.Equal(t, util.RiskReviewPending, res.GetReview().GetReviewStatus())
+				// customers aren't told which rules matched
+				require.Empty(t, res.GetReview().GetRules())
+			},
+		},
+		{
+			name: "Denied",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   account2.ID,
+				Amount:        100_000,
+				Currency:      util.USD,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().CreateRiskDecision(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateRiskDecisionParams) (db.RiskDecision, error) {
+						require.Equal(t, util.RiskDeny, arg.Action)
+						require.Equal(t, []string{"deny_amount", "review_amount"}, arg.Rules)
+						require.False(t, arg.ReviewStatus.Valid)
+						return db.RiskDecision{ID: 1, Action: arg.Action}, nil
+					})
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "FxTransferCantBeHeld",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId: account1.ID,
+				ToAccountId:   eurAccount.ID,
+				Amount:        1000,
+				Currency:      util.USD,
+				FxQuoteId:     &fxQuoteID,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(eurAccount.ID)).Times(1).Return(eurAccount, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().CreateRiskDecision(gomock.Any(), gomock.Any()).Times(1).
+					DoAndReturn(func(ctx context.Context, arg db.CreateRiskDecisionParams) (db.RiskDecision, error) {
+						require.Equal(t, util.RiskDeny, arg.Action)
+						require.Equal(t, []string{"review_amount"}, arg.Rules)
+						return db.RiskDecision{ID: 1, Action: arg.Action}, nil
+					})
+				store.EXPECT().FxTransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "RetryWhileHeld",
+			req: &pb.TransferMoneyRequest{
+				FromAccountId:  account1.ID,
+				ToAccountId:    account2.ID,
+				Amount:         1000,
+				Currency:       util.USD,
+				IdempotencyKey: &idempotencyKey,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account1.ID)).Times(1).Return(account1, nil)
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account2.ID)).Times(1).Return(account2, nil)
+				store.EXPECT().GetUser(gomock.Any(), gomock.Eq(user2.Username)).Times(1).Return(user2, nil)
+				store.EXPECT().GetIdempotencyKey(gomock.Any(), gomock.Eq(db.GetIdempotencyKeyParams{
+					Username:       user1.Username,
+					IdempotencyKey: idempotencyKey,
+				})).Times(1).Return(db.IdempotencyKey{}, sql.ErrNoRows)
+				store.EXPECT().GetRiskReviewByIdempotencyKey(gomock.Any(), gomock.Eq(db.GetRiskReviewByIdempotencyKeyParams{
+					Username:       user1.Username,
+					IdempotencyKey: sql.NullString{String: idempotencyKey, Valid: true},
+				})).Times(1).Return(held, nil)
+				store.EXPECT().CreateRiskDecision(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().TransferTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, res *pb.TransferMoneyResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, held.ID, res.GetReview().GetId())
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			server.riskEngine = risk.NewEngineFromConfig(util.Config{RiskReviewAmount: 1000, RiskDenyAmount: 100_000})
+			ctx := newContextWithBearerToken(t, server.tokenMaker, user1.Username, user1.Role, time.Minute)
+			res, err := server.TransferMoney(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
+
+func randomAccount(owner string) db.Account {
+	return db.Account{
+		ID:       util.RandomInt(1, 1000),
+		Owner:    owner,
+		Balance:  util.RandomMoney(),
+		Currency: util.RandomCurrency(),
+	}
+}
+
+func newContextWithBearerToken(t *testing.T, tokenMaker token.Maker, username string, role string, duration time.Duration) context.Context {
+	accessToken, _, err := tokenMaker.CreateToken(username, role, duration)
+	require.NoError(t, err)
+
+	bearerToken := fmt.Sprintf("%s %s", authorizationBearer, accessToken)
+	md := metadata.MD{
+		authorizationHeader: []string{
+			bearerToken,
+		},
+	}
+
+	return metadata.NewIncomingContext(context.Background(), md)
+}
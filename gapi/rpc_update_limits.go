@@ -0,0 +1,99 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateLimits(ctx context.Context, req *pb.UpdateLimitsRequest) (*pb.UpdateLimitsResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateLimitsRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	arg := db.UpdateSpendingLimitTxParams{
+		Username: authPayload.Username,
+		Currency: req.GetCurrency(),
+		Limits: util.SpendingLimits{
+			PerTransaction: req.GetLimits().GetPerTransaction(),
+			Daily:          req.GetLimits().GetDaily(),
+			Monthly:        req.GetLimits().GetMonthly(),
+		},
+		CoolingOff: server.config.SpendingLimitCoolingOff,
+	}
+
+	if req.AccountId != nil {
+		account, err := server.store.GetAccount(ctx, req.GetAccountId())
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return nil, status.Errorf(codes.NotFound, "account not found")
+			}
+			return nil, status.Errorf(codes.Internal, "failed to get account: %s", err)
+		}
+
+		if account.Owner != authPayload.Username {
+			return nil, status.Errorf(codes.PermissionDenied, "account doesn't belong to the authenticated user")
+		}
+
+		if arg.Currency != "" && arg.Currency != account.Currency {
+			err := fmt.Errorf("account %d is in %s", account.ID, account.Currency)
+			return nil, invalidArgumentError([]*errdetails.BadRequest_FieldViolation{fieldViolation("currency", err)})
+		}
+
+		arg.AccountID = sql.NullInt64{Int64: account.ID, Valid: true}
+		arg.Currency = account.Currency
+	}
+
+	result, err := server.store.UpdateSpendingLimitTx(ctx, arg)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update spending limits: %s", err)
+	}
+
+	rsp := &pb.UpdateLimitsResponse{
+		Limit: convertSpendingLimit(result.SpendingLimit, time.Now()),
+	}
+	return rsp, nil
+}
+
+func validateUpdateLimitsRequest(req *pb.UpdateLimitsRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if req.AccountId != nil {
+		if err := val.ValidateAccountID(req.GetAccountId()); err != nil {
+			violations = append(violations, fieldViolation("account_id", err))
+		}
+	}
+
+	if req.AccountId == nil || req.GetCurrency() != "" {
+		if err := val.ValidateCurrency(req.GetCurrency()); err != nil {
+			violations = append(violations, fieldViolation("currency", err))
+		}
+	}
+
+	if err := val.ValidateSpendingLimit(req.GetLimits().GetPerTransaction()); err != nil {
+		violations = append(violations, fieldViolation("limits.per_transaction", err))
+	}
+
+	if err := val.ValidateSpendingLimit(req.GetLimits().GetDaily()); err != nil {
+		violations = append(violations, fieldViolation("limits.daily", err))
+	}
+
+	if err := val.ValidateSpendingLimit(req.GetLimits().GetMonthly()); err != nil {
+		violations = append(violations, fieldViolation("limits.monthly", err))
+	}
+
+	return violations
+}
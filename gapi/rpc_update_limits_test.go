@@ -0,0 +1,187 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/token"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestUpdateLimitsAPI(t *testing.T) {
+	user, _ := randomUser(t)
+	otherUser, _ := randomUser(t)
+	account := randomAccount(user.Username)
+	limits := &pb.SpendingLimits{PerTransaction: 1000, Daily: 5000, Monthly: 20000}
+
+	testCases := []struct {
+		name          string
+		req           *pb.UpdateLimitsRequest
+		buildStubs    func(store *mockdb.MockStore)
+		buildContext  func(t *testing.T, tokenMaker token.Maker) context.Context
+		checkResponse func(t *testing.T, res *pb.UpdateLimitsResponse, err error)
+	}{
+		{
+			name: "UserLimits",
+			req: &pb.UpdateLimitsRequest{
+				Currency: util.USD,
+				Limits:   limits,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.UpdateSpendingLimitTxParams{
+					Username:   user.Username,
+					Currency:   util.USD,
+					Limits:     util.SpendingLimits{PerTransaction: 1000, Daily: 5000, Monthly: 20000},
+					CoolingOff: 24 * time.Hour,
+				}
+				store.EXPECT().UpdateSpendingLimitTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.UpdateSpendingLimitTxResult{SpendingLimit: db.SpendingLimit{
+						Username:            user.Username,
+						Currency:            util.USD,
+						PerTransactionLimit: 1000,
+						DailyLimit:          5000,
+						MonthlyLimit:        20000,
+					}}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateLimitsResponse, err error) {
+				require.NoError(t, err)
+				require.Zero(t, res.GetLimit().GetAccountId())
+				require.Equal(t, int64(5000), res.GetLimit().GetLimits().GetDaily())
+				require.Nil(t, res.GetLimit().GetPendingLimits())
+			},
+		},
+		{
+			name: "AccountLimitsPending",
+			req: &pb.UpdateLimitsRequest{
+				AccountId: &account.ID,
+				Limits:    limits,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+
+				arg := db.UpdateSpendingLimitTxParams{
+					Username:   user.Username,
+					Currency:   account.Currency,
+					AccountID:  sql.NullInt64{Int64: account.ID, Valid: true},
+					Limits:     util.SpendingLimits{PerTransaction: 1000, Daily: 5000, Monthly: 20000},
+					CoolingOff: 24 * time.Hour,
+				}
+				store.EXPECT().UpdateSpendingLimitTx(gomock.Any(), gomock.Eq(arg)).Times(1).
+					Return(db.UpdateSpendingLimitTxResult{SpendingLimit: db.SpendingLimit{
+						Username:                   user.Username,
+						Currency:                   account.Currency,
+						AccountID:                  arg.AccountID,
+						PerTransactionLimit:        500,
+						DailyLimit:                 5000,
+						MonthlyLimit:               20000,
+						PendingPerTransactionLimit: sql.NullInt64{Int64: 1000, Valid: true},
+						PendingDailyLimit:          sql.NullInt64{Int64: 5000, Valid: true},
+						PendingMonthlyLimit:        sql.NullInt64{Int64: 20000, Valid: true},
+						PendingEffectiveAt:         sql.NullTime{Time: time.Now().Add(24 * time.Hour), Valid: true},
+					}}, nil)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateLimitsResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, account.ID, res.GetLimit().GetAccountId())
+				require.Equal(t, int64(500), res.GetLimit().GetLimits().GetPerTransaction())
+				require.Equal(t, int64(1000), res.GetLimit().GetPendingLimits().GetPerTransaction())
+				require.NotNil(t, res.GetLimit().GetPendingEffectiveAt())
+			},
+		},
+		{
+			name: "AccountNotOwned",
+			req: &pb.UpdateLimitsRequest{
+				AccountId: &account.ID,
+				Limits:    limits,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().UpdateSpendingLimitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, otherUser.Username, otherUser.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateLimitsResponse, err error) {
+				requireStatusCode(t, err, codes.PermissionDenied)
+			},
+		},
+		{
+			name: "MissingCurrency",
+			req: &pb.UpdateLimitsRequest{
+				Limits: limits,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateSpendingLimitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateLimitsResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NegativeLimit",
+			req: &pb.UpdateLimitsRequest{
+				Currency: util.USD,
+				Limits:   &pb.SpendingLimits{Daily: -1},
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateSpendingLimitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return newContextWithBearerToken(t, tokenMaker, user.Username, user.Role, time.Minute)
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateLimitsResponse, err error) {
+				requireStatusCode(t, err, codes.InvalidArgument)
+			},
+		},
+		{
+			name: "NoAuthorization",
+			req: &pb.UpdateLimitsRequest{
+				Currency: util.USD,
+				Limits:   limits,
+			},
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().UpdateSpendingLimitTx(gomock.Any(), gomock.Any()).Times(0)
+			},
+			buildContext: func(t *testing.T, tokenMaker token.Maker) context.Context {
+				return context.Background()
+			},
+			checkResponse: func(t *testing.T, res *pb.UpdateLimitsResponse, err error) {
+				requireStatusCode(t, err, codes.Unauthenticated)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			ctx := tc.buildContext(t, server.tokenMaker)
+			res, err := server.UpdateLimits(ctx, tc.req)
+			tc.checkResponse(t, res, err)
+		})
+	}
+}
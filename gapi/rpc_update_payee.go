@@ -0,0 +1,55 @@
+package gapi
+
+import (
+	"context"
+	"strings"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func (server *Server) UpdatePayee(ctx context.Context, req *pb.UpdatePayeeRequest) (*pb.UpdatePayeeResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdatePayeeRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.getOwnPayee(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	payee, err := server.store.UpdatePayee(ctx, db.UpdatePayeeParams{
+		ID:       req.GetId(),
+		Nickname: strings.TrimSpace(req.GetNickname()),
+	})
+	if err != nil {
+		return nil, payeeError(err)
+	}
+
+	rsp := &pb.UpdatePayeeResponse{}
+	rsp.Payee, err = server.describePayee(ctx, payee)
+	if err != nil {
+		return nil, err
+	}
+	return rsp, nil
+}
+
+func validateUpdatePayeeRequest(req *pb.UpdatePayeeRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidatePayeeID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if err := val.ValidateNickname(req.GetNickname()); err != nil {
+		violations = append(violations, fieldViolation("nickname", err))
+	}
+
+	return violations
+}
@@ -0,0 +1,101 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/val"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (server *Server) UpdateScheduledTransfer(ctx context.Context, req *pb.UpdateScheduledTransferRequest) (*pb.UpdateScheduledTransferResponse, error) {
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return nil, unauthenticatedError(err)
+	}
+
+	violations := validateUpdateScheduledTransferRequest(req)
+	if violations != nil {
+		return nil, invalidArgumentError(violations)
+	}
+
+	_, err = server.getOwnScheduledTransfer(ctx, authPayload.Username, req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	arg := db.UpdateScheduledTransferParams{
+		ID: req.GetId(),
+		Amount: sql.NullInt64{
+			Int64: req.GetAmount(),
+			Valid: req.Amount != nil,
+		},
+		FailurePolicy: sql.NullString{
+			String: req.GetFailurePolicy(),
+			Valid:  req.FailurePolicy != nil,
+		},
+		Status: sql.NullString{
+			String: req.GetStatus(),
+			Valid:  req.Status != nil,
+		},
+	}
+	if req.EndAt != nil {
+		arg.EndAt = sql.NullTime{Time: req.GetEndAt().AsTime(), Valid: true}
+	}
+
+	scheduled, err := server.store.UpdateScheduledTransfer(ctx, arg)
+	if err != nil {
+		return nil, scheduledTransferEndedError(err)
+	}
+
+	rsp := &pb.UpdateScheduledTransferResponse{
+		ScheduledTransfer: convertScheduledTransfer(scheduled),
+	}
+	return rsp, nil
+}
+
+// scheduledTransferEndedError converts an error returned when changing a scheduled transfer,
+// which only finds active and paused ones
+func scheduledTransferEndedError(err error) error {
+	if errors.Is(err, sql.ErrNoRows) {
+		return status.Errorf(codes.FailedPrecondition, "scheduled transfer has already ended")
+	}
+	return status.Errorf(codes.Internal, "failed to update scheduled transfer: %s", err)
+}
+
+func validateUpdateScheduledTransferRequest(req *pb.UpdateScheduledTransferRequest) (violations []*errdetails.BadRequest_FieldViolation) {
+	if err := val.ValidateScheduledTransferID(req.GetId()); err != nil {
+		violations = append(violations, fieldViolation("id", err))
+	}
+
+	if req.Amount != nil {
+		if err := val.ValidateAmount(req.GetAmount()); err != nil {
+			violations = append(violations, fieldViolation("amount", err))
+		}
+	}
+
+	if req.EndAt != nil {
+		if err := req.GetEndAt().CheckValid(); err != nil {
+			violations = append(violations, fieldViolation("end_at", err))
+		}
+	}
+
+	if req.FailurePolicy != nil {
+		if err := val.ValidateFailurePolicy(req.GetFailurePolicy()); err != nil {
+			violations = append(violations, fieldViolation("failure_policy", err))
+		}
+	}
+
+	if req.Status != nil {
+		if err := val.ValidateScheduleStatus(req.GetStatus()); err != nil {
+			violations = append(violations, fieldViolation("status", err))
+		}
+	}
+
+	return violations
+}
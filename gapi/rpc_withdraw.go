@@ -3,9 +3,12 @@ package gapi
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	db "github.com/JaidenShall/simplebank/db/sqlc"
 	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
 	"github.com/JaidenShall/simplebank/val"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
@@ -19,8 +22,12 @@ func (server *Server) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 		return nil, unauthenticatedError(err)
 	}
 
+	key := idempotencyKey(ctx, req.IdempotencyKey)
+
 	// Validate input
 	violations := validateWithdrawRequest(req)
+	violations = append(violations, validateAnnotation(req)...)
+	violations = append(violations, validateIdempotencyKey(key)...)
 	if violations != nil {
 		return nil, invalidArgumentError(violations)
 	}
@@ -39,15 +46,46 @@ func (server *Server) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 	}
 
 	// Perform withdrawal transaction
+	idempotency := newIdempotencyParams(authPayload.Username, key, "Withdraw",
+		req.GetAccountId(), req.GetAmount(), req.GetMemo(), req.GetReference(), req.GetCategory())
+
 	arg := db.WithdrawTxParams{
-		AccountID: req.GetAccountId(),
+		AccountID:   req.GetAccountId(),
+		Amount:      req.GetAmount(),
+		Annotation:  convertAnnotation(req),
+		Idempotency: idempotency,
+	}
+
+	held, decision, err := server.screenRisk(ctx, risk.Operation{
+		Kind:      util.RiskWithdrawal,
+		Username:  authPayload.Username,
+		AccountID: account.ID,
 		Amount:    req.GetAmount(),
+		Currency:  account.Currency,
+	}, arg, idempotency)
+	if err != nil {
+		return nil, err
 	}
+	if held != nil {
+		return &pb.WithdrawResponse{Review: convertRiskReview(*held)}, nil
+	}
+	arg.RiskDecision = decision
 
 	result, err := server.store.WithdrawTx(ctx, arg)
 	if err != nil {
-		if err.Error() == "insufficient balance" {
-			return nil, status.Errorf(codes.FailedPrecondition, "insufficient balance")
+		if errors.Is(err, db.ErrInsufficientFunds) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		var notActiveErr *db.AccountNotActiveError
+		if errors.As(err, &notActiveErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		var limitErr *db.SpendingLimitError
+		if errors.As(err, &limitErr) {
+			return nil, status.Errorf(codes.FailedPrecondition, "%s", err)
+		}
+		if errors.Is(err, db.ErrIdempotencyKeyReused) {
+			return nil, status.Errorf(codes.AlreadyExists, "%s", err)
 		}
 		return nil, status.Errorf(codes.Internal, "withdrawal transaction failed: %s", err)
 	}
@@ -59,6 +97,7 @@ func (server *Server) Withdraw(ctx context.Context, req *pb.WithdrawRequest) (*p
 		Currency:  result.Account.Currency,
 		CreatedAt: timestamppb.New(result.Account.CreatedAt),
 		UpdatedAt: timestamppb.New(result.Account.CreatedAt),
+		Fee:       convertFeeQuote(result.Fee, result.Account.Currency),
 	}
 
 	return rsp, nil
@@ -0,0 +1,27 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getOwnScheduledTransfer returns the scheduled transfer if it was set up by the user
+func (server *Server) getOwnScheduledTransfer(ctx context.Context, username string, id int64) (db.ScheduledTransfer, error) {
+	scheduled, err := server.store.GetScheduledTransfer(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return scheduled, status.Errorf(codes.NotFound, "scheduled transfer not found")
+		}
+		return scheduled, status.Errorf(codes.Internal, "failed to get scheduled transfer: %s", err)
+	}
+
+	if scheduled.Owner != username {
+		return scheduled, status.Errorf(codes.PermissionDenied, "scheduled transfer doesn't belong to the authenticated user")
+	}
+	return scheduled, nil
+}
@@ -5,18 +5,22 @@ import (
 
 	db "github.com/JaidenShall/simplebank/db/sqlc"
 	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/risk"
 	"github.com/JaidenShall/simplebank/token"
 	"github.com/JaidenShall/simplebank/util"
 	"github.com/JaidenShall/simplebank/worker"
 )
 
-// Server serves gRPC requests for our banking service.
+// Server serves gRPC requests for our banking service and its admin service.
 type Server struct {
 	pb.UnimplementedSimpleBankServer
+	pb.UnimplementedAdminServiceServer
 	config          util.Config
 	store           db.Store
 	tokenMaker      token.Maker
 	taskDistributor worker.TaskDistributor
+	// riskEngine screens money movements, nil when no risk rule is enabled
+	riskEngine *risk.Engine
 }
 
 // NewServer creates a new gRPC server.
@@ -31,6 +35,7 @@ func NewServer(config util.Config, store db.Store, distributor worker.TaskDistri
 		store:           store,
 		tokenMaker:      tokenMaker,
 		taskDistributor: distributor,
+		riskEngine:      risk.NewEngineFromConfig(config),
 	}
 
 	return server, nil
@@ -0,0 +1,37 @@
+package gapi
+
+import (
+	"context"
+	"database/sql"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// getRefreshSession returns the session a refresh token was issued for.
+// It does not check whether the session is still usable.
+func (server *Server) getRefreshSession(ctx context.Context, refreshToken string) (db.Session, error) {
+	refreshPayload, err := server.tokenMaker.VerifyToken(refreshToken)
+	if err != nil {
+		return db.Session{}, status.Errorf(codes.Unauthenticated, "invalid refresh token: %s", err)
+	}
+
+	session, err := server.store.GetSession(ctx, refreshPayload.ID)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return db.Session{}, status.Errorf(codes.NotFound, "session not found")
+		}
+		return db.Session{}, status.Errorf(codes.Internal, "failed to get session: %s", err)
+	}
+
+	if session.Username != refreshPayload.Username {
+		return db.Session{}, status.Errorf(codes.Unauthenticated, "incorrect session user")
+	}
+
+	if session.RefreshToken != refreshToken {
+		return db.Session{}, status.Errorf(codes.Unauthenticated, "mismatched session token")
+	}
+
+	return session, nil
+}
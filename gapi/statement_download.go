@@ -0,0 +1,101 @@
+package gapi
+
+import (
+	"mime"
+	"net/http"
+
+	"github.com/JaidenShall/simplebank/pb"
+	"github.com/JaidenShall/simplebank/statement"
+	"github.com/JaidenShall/simplebank/val"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StatementDownloadPath is the gateway route serving statements as files. It takes the query
+// of GetStatement plus the format, and shares its access policy.
+const StatementDownloadPath = "/v1/accounts/{account_id}/statement/download"
+
+// RegisterStatementDownload adds the statement download route to the gateway mux.
+// The file is written to the response as it is rendered instead of going through
+// a gRPC response, which could not hold a statement of any size.
+func (server *Server) RegisterStatementDownload(mux *runtime.ServeMux) error {
+	return mux.HandlePath(http.MethodGet, StatementDownloadPath, func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		err := server.downloadStatement(w, r, mux, pathParams)
+		if err != nil {
+			_, outbound := runtime.MarshalerForRequest(mux, r)
+			runtime.HTTPError(r.Context(), mux, outbound, w, r, err)
+		}
+	})
+}
+
+// downloadStatement writes the statement file, or returns an error status if nothing was written
+func (server *Server) downloadStatement(w http.ResponseWriter, r *http.Request, mux *runtime.ServeMux, pathParams map[string]string) error {
+	ctx, err := runtime.AnnotateIncomingContext(r.Context(), mux, r, pb.SimpleBank_GetStatement_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	ctx, err = server.authorizeMethod(ctx, pb.SimpleBank_GetStatement_FullMethodName)
+	if err != nil {
+		return err
+	}
+
+	authPayload, err := server.authorizeUser(ctx)
+	if err != nil {
+		return unauthenticatedError(err)
+	}
+
+	req, format, err := parseStatementDownloadRequest(r, pathParams)
+	if err != nil {
+		return err
+	}
+
+	violations := validateGetStatementRequest(req)
+	if err := val.ValidateStatementFormat(format); err != nil {
+		violations = append(violations, fieldViolation("format", err))
+	}
+	if violations != nil {
+		return invalidArgumentError(violations)
+	}
+
+	result, err := server.getStatement(ctx, authPayload, req)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", statement.ContentType(format))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{
+		"filename": statement.FileName(result, format),
+	}))
+	w.WriteHeader(http.StatusOK)
+
+	// the status is already sent, a failure can only cut the file short
+	err = statement.Write(w, format, result)
+	if err != nil && ctx.Err() == nil {
+		log.Error().Err(err).Int64("account_id", req.GetAccountId()).Msg("failed to write statement")
+	}
+	return nil
+}
+
+func parseStatementDownloadRequest(r *http.Request, pathParams map[string]string) (*pb.GetStatementRequest, string, error) {
+	query := r.URL.Query()
+	format := query.Get("format")
+	query.Del("format")
+
+	req := &pb.GetStatementRequest{}
+	if err := runtime.PopulateQueryParameters(req, query, &utilities.DoubleArray{}); err != nil {
+		return nil, "", status.Errorf(codes.InvalidArgument, "%s", err)
+	}
+	// the path wins over an account_id in the query
+	if err := runtime.PopulateFieldFromPath(req, "account_id", pathParams["account_id"]); err != nil {
+		return nil, "", invalidArgumentError([]*errdetails.BadRequest_FieldViolation{
+			fieldViolation("account_id", err),
+		})
+	}
+
+	return req, format, nil
+}
@@ -0,0 +1,149 @@
+package gapi
+
+import (
+	"encoding/csv"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	mockdb "github.com/JaidenShall/simplebank/db/mock"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/golang/mock/gomock"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDownloadStatement(t *testing.T) {
+	user1, _ := randomUser(t)
+	user2, _ := randomUser(t)
+	account := randomAccount(user1.Username)
+	account.Currency = util.USD
+
+	fromTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	statement := randomStatement(account, fromTime)
+
+	query := func(format string) url.Values {
+		return url.Values{
+			"from_time": {statement.FromTime.Format(time.RFC3339)},
+			"to_time":   {statement.ToTime.Format(time.RFC3339)},
+			"format":    {format},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		query         url.Values
+		buildStubs    func(store *mockdb.MockStore)
+		username      string
+		checkResponse func(t *testing.T, recorder *httptest.ResponseRecorder)
+	}{
+		{
+			name:  "CSV",
+			query: query(util.StatementCSV),
+			buildStubs: func(store *mockdb.MockStore) {
+				arg := db.GetStatementParams{
+					AccountID: account.ID,
+					FromTime:  statement.FromTime,
+					ToTime:    statement.ToTime,
+				}
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Eq(arg)).Times(1).Return(statement, nil)
+			},
+			username: user1.Username,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "text/csv; charset=utf-8", recorder.Header().Get("Content-Type"))
+				require.Equal(t,
+					fmt.Sprintf("attachment; filename=statement-%d-2024-01-01-2024-02-01.csv", account.ID),
+					recorder.Header().Get("Content-Disposition"))
+
+				records, err := csv.NewReader(recorder.Body).ReadAll()
+				require.NoError(t, err)
+				require.Len(t, records, len(statement.Entries)+3)
+			},
+		},
+		{
+			name:  "PDF",
+			query: query(util.StatementPDF),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Any()).Times(1).Return(statement, nil)
+			},
+			username: user1.Username,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, recorder.Code)
+				require.Equal(t, "application/pdf", recorder.Header().Get("Content-Type"))
+				require.Contains(t, recorder.Body.String(), "%PDF-1.4")
+			},
+		},
+		{
+			name:  "InvalidFormat",
+			query: query("xls"),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			username: user1.Username,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusBadRequest, recorder.Code)
+			},
+		},
+		{
+			name:  "UnauthorizedUser",
+			query: query(util.StatementOFX),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Eq(account.ID)).Times(1).Return(account, nil)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			username: user2.Username,
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusForbidden, recorder.Code)
+			},
+		},
+		{
+			name:  "NoAuthorization",
+			query: query(util.StatementOFX),
+			buildStubs: func(store *mockdb.MockStore) {
+				store.EXPECT().GetAccount(gomock.Any(), gomock.Any()).Times(0)
+				store.EXPECT().GetStatement(gomock.Any(), gomock.Any()).Times(0)
+			},
+			checkResponse: func(t *testing.T, recorder *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusUnauthorized, recorder.Code)
+			},
+		},
+	}
+
+	for i := range testCases {
+		tc := testCases[i]
+
+		t.Run(tc.name, func(t *testing.T) {
+			storeCtrl := gomock.NewController(t)
+			defer storeCtrl.Finish()
+			store := mockdb.NewMockStore(storeCtrl)
+
+			tc.buildStubs(store)
+
+			server := newTestServer(t, store, nil)
+			mux := runtime.NewServeMux(runtime.WithIncomingHeaderMatcher(IncomingHeaderMatcher))
+			require.NoError(t, server.RegisterStatementDownload(mux))
+
+			url := fmt.Sprintf("/v1/accounts/%d/statement/download?%s", account.ID, tc.query.Encode())
+			request, err := http.NewRequest(http.MethodGet, url, nil)
+			require.NoError(t, err)
+
+			if tc.username != "" {
+				accessToken, _, err := server.tokenMaker.CreateToken(tc.username, util.CustomerRole, time.Minute)
+				require.NoError(t, err)
+				request.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+			}
+
+			recorder := httptest.NewRecorder()
+			mux.ServeHTTP(recorder, request)
+			tc.checkResponse(t, recorder)
+		})
+	}
+}
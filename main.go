@@ -3,11 +3,15 @@ package main
 import (
 	"context"
 	"database/sql"
+	"flag"
+	"fmt"
 	"net"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/JaidenShall/simplebank/api"
+	"github.com/JaidenShall/simplebank/currency"
 	db "github.com/JaidenShall/simplebank/db/sqlc"
 	_ "github.com/JaidenShall/simplebank/doc/statik"
 	"github.com/JaidenShall/simplebank/gapi"
@@ -40,6 +44,11 @@ func main() {
 		log.Logger = log.Output(zerolog.ConsoleWriter{Out: os.Stderr})
 	}
 
+	err = currency.Enable(config.EnabledCurrencies...)
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot enable currencies")
+	}
+
 	conn, err := sql.Open(config.DBDriver, config.DBSource)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot connect to db")
@@ -49,12 +58,19 @@ func main() {
 
 	store := db.NewStore(conn)
 
+	// "reconcile" runs the balance reconciliation once instead of starting the servers
+	if len(os.Args) > 1 && os.Args[1] == "reconcile" {
+		runReconcile(store, os.Args[2:])
+		return
+	}
+
 	redisOpt := asynq.RedisClientOpt{
 		Addr: config.RedisAddress,
 	}
 
 	taskDistributor := worker.NewRedisTaskDistributor(redisOpt)
-	go runTaskProcessor(config, redisOpt, store)
+	go runTaskProcessor(config, redisOpt, store, taskDistributor)
+	go runTaskScheduler(config, redisOpt)
 	go runGatewayServer(config, store, taskDistributor)
 	runGrpcServer(config, store, taskDistributor)
 }
@@ -72,9 +88,39 @@ func runDBMigration(migrationURL string, dbSource string) {
 	log.Info().Msg("db migrated successfully")
 }
 
-func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store) {
+// runReconcile reconciles the balances at the end of a UTC day, yesterday unless -date is given,
+// and prints the discrepancies. It exits with status 1 if any were found.
+func runReconcile(store db.Store, args []string) {
+	flags := flag.NewFlagSet("reconcile", flag.ExitOnError)
+	yesterday := time.Now().UTC().AddDate(0, 0, -1)
+	date := flags.String("date", yesterday.Format(time.DateOnly), "UTC day to reconcile, as YYYY-MM-DD")
+	flags.Parse(args)
+
+	day, err := time.Parse(time.DateOnly, *date)
+	if err != nil {
+		log.Fatal().Err(err).Msg("invalid reconciliation date")
+	}
+
+	result, err := store.ReconcileBalances(context.Background(), db.ReconcileBalancesParams{Date: day})
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to reconcile balances")
+	}
+
+	fmt.Printf("reconciled %d accounts at the end of %s, %d discrepancies\n",
+		result.Accounts, result.Date.Format(time.DateOnly), len(result.Discrepancies))
+	for _, discrepancy := range result.Discrepancies {
+		fmt.Printf("account %d: balance %d, entry sum %d, difference %d\n", discrepancy.AccountID,
+			discrepancy.Balance, discrepancy.EntrySum, discrepancy.Balance-discrepancy.EntrySum)
+	}
+
+	if len(result.Discrepancies) > 0 {
+		os.Exit(1)
+	}
+}
+
+func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store db.Store, taskDistributor worker.TaskDistributor) {
 	mailer := mail.NewGmailSender(config.EmailSenderName, config.EmailSenderAddress, config.EmailSenderPassword)
-	taskProcessor := worker.NewRedisTaskProcessor(redisOpt, store, mailer)
+	taskProcessor := worker.NewRedisTaskProcessor(config, redisOpt, store, mailer, taskDistributor)
 	log.Info().Msg("start task processor")
 	err := taskProcessor.Start()
 	if err != nil {
@@ -82,15 +128,26 @@ func runTaskProcessor(config util.Config, redisOpt asynq.RedisClientOpt, store d
 	}
 }
 
+func runTaskScheduler(config util.Config, redisOpt asynq.RedisClientOpt) {
+	taskScheduler := worker.NewRedisTaskScheduler(config, redisOpt)
+	log.Info().Msg("start task scheduler")
+	err := taskScheduler.Start()
+	if err != nil {
+		log.Fatal().Err(err).Msg("failed to start task scheduler")
+	}
+}
+
 func runGrpcServer(config util.Config, store db.Store, taskDistributor worker.TaskDistributor) {
 	server, err := gapi.NewServer(config, store, taskDistributor)
 	if err != nil {
 		log.Fatal().Err(err).Msg("cannot create server")
 	}
 
-	gprcLogger := grpc.UnaryInterceptor(gapi.GrpcLogger)
-	grpcServer := grpc.NewServer(gprcLogger)
+	unaryInterceptors := grpc.ChainUnaryInterceptor(gapi.GrpcLogger, server.AuthInterceptor)
+	streamInterceptor := grpc.StreamInterceptor(server.AuthStreamInterceptor)
+	grpcServer := grpc.NewServer(unaryInterceptors, streamInterceptor)
 	pb.RegisterSimpleBankServer(grpcServer, server)
+	pb.RegisterAdminServiceServer(grpcServer, server)
 	reflection.Register(grpcServer)
 
 	listener, err := net.Listen("tcp", config.GRPCServerAddress)
@@ -120,14 +177,29 @@ func runGatewayServer(config util.Config, store db.Store, taskDistributor worker
 		},
 	})
 
-	grpcMux := runtime.NewServeMux(jsonOption)
+	headerOption := runtime.WithIncomingHeaderMatcher(gapi.IncomingHeaderMatcher)
+
+	grpcMux := runtime.NewServeMux(jsonOption, headerOption)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 
-	err = pb.RegisterSimpleBankHandlerServer(ctx, grpcMux, server)
+	// go through the auth interceptor just like requests to the gRPC server
+	conn := gapi.NewInProcessConn(&pb.SimpleBank_ServiceDesc, server, server.AuthInterceptor)
+	err = pb.RegisterSimpleBankHandlerClient(ctx, grpcMux, pb.NewSimpleBankClient(conn))
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot register handler client")
+	}
+
+	adminConn := gapi.NewInProcessConn(&pb.AdminService_ServiceDesc, server, server.AuthInterceptor)
+	err = pb.RegisterAdminServiceHandlerClient(ctx, grpcMux, pb.NewAdminServiceClient(adminConn))
+	if err != nil {
+		log.Fatal().Err(err).Msg("cannot register admin handler client")
+	}
+
+	err = server.RegisterStatementDownload(grpcMux)
 	if err != nil {
-		log.Fatal().Err(err).Msg("cannot register handler server")
+		log.Fatal().Err(err).Msg("cannot register statement download")
 	}
 
 	mux := http.NewServeMux()
@@ -0,0 +1,296 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: fee.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Fee amounts are in the smallest unit of currency, rates are in basis points
+type FeeSchedule struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"` // transfer or withdrawal
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	FlatAmount    int64                  `protobuf:"varint,3,opt,name=flat_amount,json=flatAmount,proto3" json:"flat_amount,omitempty"`
+	Rate          int32                  `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"` // 150 is 1.5% of the amount
+	MinAmount     int64                  `protobuf:"varint,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount     int64                  `protobuf:"varint,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`            // 0 leaves the fee uncapped
+	FreePerMonth  int32                  `protobuf:"varint,7,opt,name=free_per_month,json=freePerMonth,proto3" json:"free_per_month,omitempty"` // Operations of an account that are free every calendar month
+	UpdatedBy     string                 `protobuf:"bytes,8,opt,name=updated_by,json=updatedBy,proto3" json:"updated_by,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FeeSchedule) Reset() {
+	*x = FeeSchedule{}
+	mi := &file_fee_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeSchedule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeSchedule) ProtoMessage() {}
+
+func (x *FeeSchedule) ProtoReflect() protoreflect.Message {
+	mi := &file_fee_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeSchedule.ProtoReflect.Descriptor instead.
+func (*FeeSchedule) Descriptor() ([]byte, []int) {
+	return file_fee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FeeSchedule) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetFlatAmount() int64 {
+	if x != nil {
+		return x.FlatAmount
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetFreePerMonth() int32 {
+	if x != nil {
+		return x.FreePerMonth
+	}
+	return 0
+}
+
+func (x *FeeSchedule) GetUpdatedBy() string {
+	if x != nil {
+		return x.UpdatedBy
+	}
+	return ""
+}
+
+func (x *FeeSchedule) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type FeeQuote struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Amount           int64                  `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"` // Charged on top of the amount moved, 0 when free
+	Currency         string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	FlatAmount       int64                  `protobuf:"varint,3,opt,name=flat_amount,json=flatAmount,proto3" json:"flat_amount,omitempty"`
+	PercentageAmount int64                  `protobuf:"varint,4,opt,name=percentage_amount,json=percentageAmount,proto3" json:"percentage_amount,omitempty"`
+	Free             bool                   `protobuf:"varint,5,opt,name=free,proto3" json:"free,omitempty"`                                        // Covered by the free allowance of the month
+	FreeRemaining    int32                  `protobuf:"varint,6,opt,name=free_remaining,json=freeRemaining,proto3" json:"free_remaining,omitempty"` // Free operations left this month
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *FeeQuote) Reset() {
+	*x = FeeQuote{}
+	mi := &file_fee_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FeeQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeQuote) ProtoMessage() {}
+
+func (x *FeeQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_fee_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeeQuote.ProtoReflect.Descriptor instead.
+func (*FeeQuote) Descriptor() ([]byte, []int) {
+	return file_fee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeQuote) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *FeeQuote) GetFlatAmount() int64 {
+	if x != nil {
+		return x.FlatAmount
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetPercentageAmount() int64 {
+	if x != nil {
+		return x.PercentageAmount
+	}
+	return 0
+}
+
+func (x *FeeQuote) GetFree() bool {
+	if x != nil {
+		return x.Free
+	}
+	return false
+}
+
+func (x *FeeQuote) GetFreeRemaining() int32 {
+	if x != nil {
+		return x.FreeRemaining
+	}
+	return 0
+}
+
+var File_fee_proto protoreflect.FileDescriptor
+
+const file_fee_proto_rawDesc = "" +
+	"\n" +
+	"\tfee.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xba\x02\n" +
+	"\vFeeSchedule\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vflat_amount\x18\x03 \x01(\x03R\n" +
+	"flatAmount\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x05R\x04rate\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\x03R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x06 \x01(\x03R\tmaxAmount\x12$\n" +
+	"\x0efree_per_month\x18\a \x01(\x05R\ffreePerMonth\x12\x1d\n" +
+	"\n" +
+	"updated_by\x18\b \x01(\tR\tupdatedBy\x129\n" +
+	"\n" +
+	"updated_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xc7\x01\n" +
+	"\bFeeQuote\x12\x16\n" +
+	"\x06amount\x18\x01 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vflat_amount\x18\x03 \x01(\x03R\n" +
+	"flatAmount\x12+\n" +
+	"\x11percentage_amount\x18\x04 \x01(\x03R\x10percentageAmount\x12\x12\n" +
+	"\x04free\x18\x05 \x01(\bR\x04free\x12%\n" +
+	"\x0efree_remaining\x18\x06 \x01(\x05R\rfreeRemainingB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_fee_proto_rawDescOnce sync.Once
+	file_fee_proto_rawDescData []byte
+)
+
+func file_fee_proto_rawDescGZIP() []byte {
+	file_fee_proto_rawDescOnce.Do(func() {
+		file_fee_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_fee_proto_rawDesc), len(file_fee_proto_rawDesc)))
+	})
+	return file_fee_proto_rawDescData
+}
+
+var file_fee_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_fee_proto_goTypes = []any{
+	(*FeeSchedule)(nil),           // 0: pb.FeeSchedule
+	(*FeeQuote)(nil),              // 1: pb.FeeQuote
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_fee_proto_depIdxs = []int32{
+	2, // 0: pb.FeeSchedule.updated_at:type_name -> google.protobuf.Timestamp
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_fee_proto_init() }
+func file_fee_proto_init() {
+	if File_fee_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_fee_proto_rawDesc), len(file_fee_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_fee_proto_goTypes,
+		DependencyIndexes: file_fee_proto_depIdxs,
+		MessageInfos:      file_fee_proto_msgTypes,
+	}.Build()
+	File_fee_proto = out.File
+	file_fee_proto_goTypes = nil
+	file_fee_proto_depIdxs = nil
+}
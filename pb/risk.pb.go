@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: risk.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Outcome of screening a money movement with the risk rules; amounts are in minor units
+type RiskDecision struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Operation     string                 `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"` // transfer, withdrawal or deposit
+	AccountId     int64                  `protobuf:"varint,4,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	ToAccountId   int64                  `protobuf:"varint,5,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"` // Set on transfers
+	Amount        int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Action        string                 `protobuf:"bytes,8,opt,name=action,proto3" json:"action,omitempty"`                                  // allow, review or deny
+	Rules         []string               `protobuf:"bytes,9,rep,name=rules,proto3" json:"rules,omitempty"`                                    // Rules that matched, only shown to staff
+	UserAgent     string                 `protobuf:"bytes,10,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`          // Only shown to staff
+	ClientIp      string                 `protobuf:"bytes,11,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`             // Only shown to staff
+	ReviewStatus  string                 `protobuf:"bytes,12,opt,name=review_status,json=reviewStatus,proto3" json:"review_status,omitempty"` // pending, approved or rejected; empty unless held for review
+	ReviewedBy    string                 `protobuf:"bytes,13,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt    *timestamppb.Timestamp `protobuf:"bytes,14,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	TransferId    int64                  `protobuf:"varint,15,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"` // The transfer made once the review was approved
+	EntryId       int64                  `protobuf:"varint,16,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`          // The entry of the withdrawal or deposit made once the review was approved
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,17,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RiskDecision) Reset() {
+	*x = RiskDecision{}
+	mi := &file_risk_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RiskDecision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RiskDecision) ProtoMessage() {}
+
+func (x *RiskDecision) ProtoReflect() protoreflect.Message {
+	mi := &file_risk_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RiskDecision.ProtoReflect.Descriptor instead.
+func (*RiskDecision) Descriptor() ([]byte, []int) {
+	return file_risk_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RiskDecision) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RiskDecision) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *RiskDecision) GetToAccountId() int64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
+
+func (x *RiskDecision) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RiskDecision) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetRules() []string {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *RiskDecision) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetReviewStatus() string {
+	if x != nil {
+		return x.ReviewStatus
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetReviewedBy() string {
+	if x != nil {
+		return x.ReviewedBy
+	}
+	return ""
+}
+
+func (x *RiskDecision) GetReviewedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return nil
+}
+
+func (x *RiskDecision) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *RiskDecision) GetEntryId() int64 {
+	if x != nil {
+		return x.EntryId
+	}
+	return 0
+}
+
+func (x *RiskDecision) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_risk_proto protoreflect.FileDescriptor
+
+const file_risk_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"risk.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb3\x04\n" +
+	"\fRiskDecision\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x04 \x01(\x03R\taccountId\x12\"\n" +
+	"\rto_account_id\x18\x05 \x01(\x03R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06action\x18\b \x01(\tR\x06action\x12\x14\n" +
+	"\x05rules\x18\t \x03(\tR\x05rules\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\n" +
+	" \x01(\tR\tuserAgent\x12\x1b\n" +
+	"\tclient_ip\x18\v \x01(\tR\bclientIp\x12#\n" +
+	"\rreview_status\x18\f \x01(\tR\freviewStatus\x12\x1f\n" +
+	"\vreviewed_by\x18\r \x01(\tR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12\x1f\n" +
+	"\vtransfer_id\x18\x0f \x01(\x03R\n" +
+	"transferId\x12\x19\n" +
+	"\bentry_id\x18\x10 \x01(\x03R\aentryId\x129\n" +
+	"\n" +
+	"created_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_risk_proto_rawDescOnce sync.Once
+	file_risk_proto_rawDescData []byte
+)
+
+func file_risk_proto_rawDescGZIP() []byte {
+	file_risk_proto_rawDescOnce.Do(func() {
+		file_risk_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_risk_proto_rawDesc), len(file_risk_proto_rawDesc)))
+	})
+	return file_risk_proto_rawDescData
+}
+
+var file_risk_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_risk_proto_goTypes = []any{
+	(*RiskDecision)(nil),          // 0: pb.RiskDecision
+	(*timestamppb.Timestamp)(nil), // 1: google.protobuf.Timestamp
+}
+var file_risk_proto_depIdxs = []int32{
+	1, // 0: pb.RiskDecision.reviewed_at:type_name -> google.protobuf.Timestamp
+	1, // 1: pb.RiskDecision.created_at:type_name -> google.protobuf.Timestamp
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_risk_proto_init() }
+func file_risk_proto_init() {
+	if File_risk_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_risk_proto_rawDesc), len(file_risk_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_risk_proto_goTypes,
+		DependencyIndexes: file_risk_proto_depIdxs,
+		MessageInfos:      file_risk_proto_msgTypes,
+	}.Build()
+	File_risk_proto = out.File
+	file_risk_proto_goTypes = nil
+	file_risk_proto_depIdxs = nil
+}
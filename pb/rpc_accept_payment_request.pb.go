@@ -0,0 +1,239 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_accept_payment_request.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type AcceptPaymentRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromAccountId int64                  `protobuf:"varint,2,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"` // Your account the request is paid from, in the currency of the request
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AcceptPaymentRequestRequest) Reset() {
+	*x = AcceptPaymentRequestRequest{}
+	mi := &file_rpc_accept_payment_request_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptPaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPaymentRequestRequest) ProtoMessage() {}
+
+func (x *AcceptPaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_accept_payment_request_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*AcceptPaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_accept_payment_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AcceptPaymentRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AcceptPaymentRequestRequest) GetFromAccountId() int64 {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return 0
+}
+
+type AcceptPaymentRequestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	Transfer       *Transfer              `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	FromAccount    *Account               `protobuf:"bytes,3,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	FromEntry      *Entry                 `protobuf:"bytes,4,opt,name=from_entry,json=fromEntry,proto3" json:"from_entry,omitempty"`
+	Fee            *FeeQuote              `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeEntry       *Entry                 `protobuf:"bytes,6,opt,name=fee_entry,json=feeEntry,proto3" json:"fee_entry,omitempty"` // Debit of the fee, unset when nothing was charged
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AcceptPaymentRequestResponse) Reset() {
+	*x = AcceptPaymentRequestResponse{}
+	mi := &file_rpc_accept_payment_request_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AcceptPaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AcceptPaymentRequestResponse) ProtoMessage() {}
+
+func (x *AcceptPaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_accept_payment_request_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AcceptPaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*AcceptPaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_accept_payment_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AcceptPaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetFromAccount() *Account {
+	if x != nil {
+		return x.FromAccount
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetFromEntry() *Entry {
+	if x != nil {
+		return x.FromEntry
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetFee() *FeeQuote {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *AcceptPaymentRequestResponse) GetFeeEntry() *Entry {
+	if x != nil {
+		return x.FeeEntry
+	}
+	return nil
+}
+
+var File_rpc_accept_payment_request_proto protoreflect.FileDescriptor
+
+const file_rpc_accept_payment_request_proto_rawDesc = "" +
+	"\n" +
+	" rpc_accept_payment_request.proto\x12\x02pb\x1a\raccount.proto\x1a\tfee.proto\x1a\x15payment_request.proto\x1a\x18rpc_transfer_money.proto\"U\n" +
+	"\x1bAcceptPaymentRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
+	"\x0ffrom_account_id\x18\x02 \x01(\x03R\rfromAccountId\"\xa7\x02\n" +
+	"\x1cAcceptPaymentRequestResponse\x12;\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x12.pb.PaymentRequestR\x0epaymentRequest\x12(\n" +
+	"\btransfer\x18\x02 \x01(\v2\f.pb.TransferR\btransfer\x12.\n" +
+	"\ffrom_account\x18\x03 \x01(\v2\v.pb.AccountR\vfromAccount\x12(\n" +
+	"\n" +
+	"from_entry\x18\x04 \x01(\v2\t.pb.EntryR\tfromEntry\x12\x1e\n" +
+	"\x03fee\x18\x05 \x01(\v2\f.pb.FeeQuoteR\x03fee\x12&\n" +
+	"\tfee_entry\x18\x06 \x01(\v2\t.pb.EntryR\bfeeEntryB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_accept_payment_request_proto_rawDescOnce sync.Once
+	file_rpc_accept_payment_request_proto_rawDescData []byte
+)
+
+func file_rpc_accept_payment_request_proto_rawDescGZIP() []byte {
+	file_rpc_accept_payment_request_proto_rawDescOnce.Do(func() {
+		file_rpc_accept_payment_request_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_accept_payment_request_proto_rawDesc), len(file_rpc_accept_payment_request_proto_rawDesc)))
+	})
+	return file_rpc_accept_payment_request_proto_rawDescData
+}
+
+var file_rpc_accept_payment_request_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_accept_payment_request_proto_goTypes = []any{
+	(*AcceptPaymentRequestRequest)(nil),  // 0: pb.AcceptPaymentRequestRequest
+	(*AcceptPaymentRequestResponse)(nil), // 1: pb.AcceptPaymentRequestResponse
+	(*PaymentRequest)(nil),               // 2: pb.PaymentRequest
+	(*Transfer)(nil),                     // 3: pb.Transfer
+	(*Account)(nil),                      // 4: pb.Account
+	(*Entry)(nil),                        // 5: pb.Entry
+	(*FeeQuote)(nil),                     // 6: pb.FeeQuote
+}
+var file_rpc_accept_payment_request_proto_depIdxs = []int32{
+	2, // 0: pb.AcceptPaymentRequestResponse.payment_request:type_name -> pb.PaymentRequest
+	3, // 1: pb.AcceptPaymentRequestResponse.transfer:type_name -> pb.Transfer
+	4, // 2: pb.AcceptPaymentRequestResponse.from_account:type_name -> pb.Account
+	5, // 3: pb.AcceptPaymentRequestResponse.from_entry:type_name -> pb.Entry
+	6, // 4: pb.AcceptPaymentRequestResponse.fee:type_name -> pb.FeeQuote
+	5, // 5: pb.AcceptPaymentRequestResponse.fee_entry:type_name -> pb.Entry
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
+}
+
+func init() { file_rpc_accept_payment_request_proto_init() }
+func file_rpc_accept_payment_request_proto_init() {
+	if File_rpc_accept_payment_request_proto != nil {
+		return
+	}
+	file_account_proto_init()
+	file_fee_proto_init()
+	file_payment_request_proto_init()
+	file_rpc_transfer_money_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_accept_payment_request_proto_rawDesc), len(file_rpc_accept_payment_request_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_accept_payment_request_proto_goTypes,
+		DependencyIndexes: file_rpc_accept_payment_request_proto_depIdxs,
+		MessageInfos:      file_rpc_accept_payment_request_proto_msgTypes,
+	}.Build()
+	File_rpc_accept_payment_request_proto = out.File
+	file_rpc_accept_payment_request_proto_goTypes = nil
+	file_rpc_accept_payment_request_proto_depIdxs = nil
+}
@@ -0,0 +1,190 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_block_user_sessions.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type BlockUserSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Username      string                 `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	SessionId     *string                `protobuf:"bytes,2,opt,name=session_id,json=sessionId,proto3,oneof" json:"session_id,omitempty"` // Only blocks this session when set
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                              // Recorded in the audit log
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockUserSessionsRequest) Reset() {
+	*x = BlockUserSessionsRequest{}
+	mi := &file_rpc_block_user_sessions_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserSessionsRequest) ProtoMessage() {}
+
+func (x *BlockUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_block_user_sessions_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_block_user_sessions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BlockUserSessionsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BlockUserSessionsRequest) GetSessionId() string {
+	if x != nil && x.SessionId != nil {
+		return *x.SessionId
+	}
+	return ""
+}
+
+func (x *BlockUserSessionsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type BlockUserSessionsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	BlockedSessions int64                  `protobuf:"varint,1,opt,name=blocked_sessions,json=blockedSessions,proto3" json:"blocked_sessions,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *BlockUserSessionsResponse) Reset() {
+	*x = BlockUserSessionsResponse{}
+	mi := &file_rpc_block_user_sessions_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockUserSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockUserSessionsResponse) ProtoMessage() {}
+
+func (x *BlockUserSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_block_user_sessions_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockUserSessionsResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_block_user_sessions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BlockUserSessionsResponse) GetBlockedSessions() int64 {
+	if x != nil {
+		return x.BlockedSessions
+	}
+	return 0
+}
+
+var File_rpc_block_user_sessions_proto protoreflect.FileDescriptor
+
+const file_rpc_block_user_sessions_proto_rawDesc = "" +
+	"\n" +
+	"\x1drpc_block_user_sessions.proto\x12\x02pb\"\x81\x01\n" +
+	"\x18BlockUserSessionsRequest\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\"\n" +
+	"\n" +
+	"session_id\x18\x02 \x01(\tH\x00R\tsessionId\x88\x01\x01\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reasonB\r\n" +
+	"\v_session_id\"F\n" +
+	"\x19BlockUserSessionsResponse\x12)\n" +
+	"\x10blocked_sessions\x18\x01 \x01(\x03R\x0fblockedSessionsB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_block_user_sessions_proto_rawDescOnce sync.Once
+	file_rpc_block_user_sessions_proto_rawDescData []byte
+)
+
+func file_rpc_block_user_sessions_proto_rawDescGZIP() []byte {
+	file_rpc_block_user_sessions_proto_rawDescOnce.Do(func() {
+		file_rpc_block_user_sessions_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_block_user_sessions_proto_rawDesc), len(file_rpc_block_user_sessions_proto_rawDesc)))
+	})
+	return file_rpc_block_user_sessions_proto_rawDescData
+}
+
+var file_rpc_block_user_sessions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_block_user_sessions_proto_goTypes = []any{
+	(*BlockUserSessionsRequest)(nil),  // 0: pb.BlockUserSessionsRequest
+	(*BlockUserSessionsResponse)(nil), // 1: pb.BlockUserSessionsResponse
+}
+var file_rpc_block_user_sessions_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_rpc_block_user_sessions_proto_init() }
+func file_rpc_block_user_sessions_proto_init() {
+	if File_rpc_block_user_sessions_proto != nil {
+		return
+	}
+	file_rpc_block_user_sessions_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_block_user_sessions_proto_rawDesc), len(file_rpc_block_user_sessions_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_block_user_sessions_proto_goTypes,
+		DependencyIndexes: file_rpc_block_user_sessions_proto_depIdxs,
+		MessageInfos:      file_rpc_block_user_sessions_proto_msgTypes,
+	}.Build()
+	File_rpc_block_user_sessions_proto = out.File
+	file_rpc_block_user_sessions_proto_goTypes = nil
+	file_rpc_block_user_sessions_proto_depIdxs = nil
+}
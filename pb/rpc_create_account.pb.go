@@ -24,6 +24,7 @@ const (
 type CreateAccountRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Type          *string                `protobuf:"bytes,2,opt,name=type,proto3,oneof" json:"type,omitempty"` // checking or savings, defaults to checking
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -65,6 +66,13 @@ func (x *CreateAccountRequest) GetCurrency() string {
 	return ""
 }
 
+func (x *CreateAccountRequest) GetType() string {
+	if x != nil && x.Type != nil {
+		return *x.Type
+	}
+	return ""
+}
+
 type CreateAccountResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
@@ -113,9 +121,11 @@ var File_rpc_create_account_proto protoreflect.FileDescriptor
 
 const file_rpc_create_account_proto_rawDesc = "" +
 	"\n" +
-	"\x18rpc_create_account.proto\x12\x02pb\x1a\raccount.proto\"2\n" +
+	"\x18rpc_create_account.proto\x12\x02pb\x1a\raccount.proto\"T\n" +
 	"\x14CreateAccountRequest\x12\x1a\n" +
-	"\bcurrency\x18\x01 \x01(\tR\bcurrency\">\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x17\n" +
+	"\x04type\x18\x02 \x01(\tH\x00R\x04type\x88\x01\x01B\a\n" +
+	"\x05_type\">\n" +
 	"\x15CreateAccountResponse\x12%\n" +
 	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccountB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
 
@@ -152,6 +162,7 @@ func file_rpc_create_account_proto_init() {
 		return
 	}
 	file_account_proto_init()
+	file_rpc_create_account_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
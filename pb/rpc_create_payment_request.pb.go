@@ -0,0 +1,232 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_create_payment_request.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type CreatePaymentRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToAccountId   int64                  `protobuf:"varint,1,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`    // Your account the money is paid into
+	PayerUsername string                 `protobuf:"bytes,2,opt,name=payer_username,json=payerUsername,proto3" json:"payer_username,omitempty"` // User asked to pay, leave out when payee_id is set
+	PayeeId       *int64                 `protobuf:"varint,3,opt,name=payee_id,json=payeeId,proto3,oneof" json:"payee_id,omitempty"`            // Asks the owner of a saved payee's account to pay
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`                    // Must be the currency of to_account_id
+	Memo          string                 `protobuf:"bytes,6,opt,name=memo,proto3" json:"memo,omitempty"`                            // Optional free text shown to the payer
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"` // Defaults to the longest expiry allowed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestRequest) Reset() {
+	*x = CreatePaymentRequestRequest{}
+	mi := &file_rpc_create_payment_request_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestRequest) ProtoMessage() {}
+
+func (x *CreatePaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_payment_request_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_payment_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreatePaymentRequestRequest) GetToAccountId() int64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
+
+func (x *CreatePaymentRequestRequest) GetPayerUsername() string {
+	if x != nil {
+		return x.PayerUsername
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetPayeeId() int64 {
+	if x != nil && x.PayeeId != nil {
+		return *x.PayeeId
+	}
+	return 0
+}
+
+func (x *CreatePaymentRequestRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreatePaymentRequestRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CreatePaymentRequestRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type CreatePaymentRequestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreatePaymentRequestResponse) Reset() {
+	*x = CreatePaymentRequestResponse{}
+	mi := &file_rpc_create_payment_request_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePaymentRequestResponse) ProtoMessage() {}
+
+func (x *CreatePaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_payment_request_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*CreatePaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_payment_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+var File_rpc_create_payment_request_proto protoreflect.FileDescriptor
+
+const file_rpc_create_payment_request_proto_rawDesc = "" +
+	"\n" +
+	" rpc_create_payment_request.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x15payment_request.proto\"\x98\x02\n" +
+	"\x1bCreatePaymentRequestRequest\x12\"\n" +
+	"\rto_account_id\x18\x01 \x01(\x03R\vtoAccountId\x12%\n" +
+	"\x0epayer_username\x18\x02 \x01(\tR\rpayerUsername\x12\x1e\n" +
+	"\bpayee_id\x18\x03 \x01(\x03H\x00R\apayeeId\x88\x01\x01\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x12\n" +
+	"\x04memo\x18\x06 \x01(\tR\x04memo\x129\n" +
+	"\n" +
+	"expires_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAtB\v\n" +
+	"\t_payee_id\"[\n" +
+	"\x1cCreatePaymentRequestResponse\x12;\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x12.pb.PaymentRequestR\x0epaymentRequestB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_create_payment_request_proto_rawDescOnce sync.Once
+	file_rpc_create_payment_request_proto_rawDescData []byte
+)
+
+func file_rpc_create_payment_request_proto_rawDescGZIP() []byte {
+	file_rpc_create_payment_request_proto_rawDescOnce.Do(func() {
+		file_rpc_create_payment_request_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_payment_request_proto_rawDesc), len(file_rpc_create_payment_request_proto_rawDesc)))
+	})
+	return file_rpc_create_payment_request_proto_rawDescData
+}
+
+var file_rpc_create_payment_request_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_create_payment_request_proto_goTypes = []any{
+	(*CreatePaymentRequestRequest)(nil),  // 0: pb.CreatePaymentRequestRequest
+	(*CreatePaymentRequestResponse)(nil), // 1: pb.CreatePaymentRequestResponse
+	(*timestamppb.Timestamp)(nil),        // 2: google.protobuf.Timestamp
+	(*PaymentRequest)(nil),               // 3: pb.PaymentRequest
+}
+var file_rpc_create_payment_request_proto_depIdxs = []int32{
+	2, // 0: pb.CreatePaymentRequestRequest.expires_at:type_name -> google.protobuf.Timestamp
+	3, // 1: pb.CreatePaymentRequestResponse.payment_request:type_name -> pb.PaymentRequest
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_payment_request_proto_init() }
+func file_rpc_create_payment_request_proto_init() {
+	if File_rpc_create_payment_request_proto != nil {
+		return
+	}
+	file_payment_request_proto_init()
+	file_rpc_create_payment_request_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_payment_request_proto_rawDesc), len(file_rpc_create_payment_request_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_payment_request_proto_goTypes,
+		DependencyIndexes: file_rpc_create_payment_request_proto_depIdxs,
+		MessageInfos:      file_rpc_create_payment_request_proto_msgTypes,
+	}.Build()
+	File_rpc_create_payment_request_proto = out.File
+	file_rpc_create_payment_request_proto_goTypes = nil
+	file_rpc_create_payment_request_proto_depIdxs = nil
+}
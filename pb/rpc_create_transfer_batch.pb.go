@@ -0,0 +1,348 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_create_transfer_batch.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TransferBatchLeg struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ToAccountId   int64                  `protobuf:"varint,1,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferBatchLeg) Reset() {
+	*x = TransferBatchLeg{}
+	mi := &file_rpc_create_transfer_batch_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferBatchLeg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferBatchLeg) ProtoMessage() {}
+
+func (x *TransferBatchLeg) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_transfer_batch_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferBatchLeg.ProtoReflect.Descriptor instead.
+func (*TransferBatchLeg) Descriptor() ([]byte, []int) {
+	return file_rpc_create_transfer_batch_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TransferBatchLeg) GetToAccountId() int64 {
+	if x != nil {
+		return x.ToAccountId
+	}
+	return 0
+}
+
+func (x *TransferBatchLeg) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type TransferBatchLegResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"` // Not set when the leg failed
+	Error         string                 `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`       // Why the leg failed in best-effort mode
+	Fee           *FeeQuote              `protobuf:"bytes,3,opt,name=fee,proto3" json:"fee,omitempty"`           // Not set when the leg failed
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferBatchLegResult) Reset() {
+	*x = TransferBatchLegResult{}
+	mi := &file_rpc_create_transfer_batch_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferBatchLegResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferBatchLegResult) ProtoMessage() {}
+
+func (x *TransferBatchLegResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_transfer_batch_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferBatchLegResult.ProtoReflect.Descriptor instead.
+func (*TransferBatchLegResult) Descriptor() ([]byte, []int) {
+	return file_rpc_create_transfer_batch_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TransferBatchLegResult) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *TransferBatchLegResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *TransferBatchLegResult) GetFee() *FeeQuote {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+type CreateTransferBatchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId  int64                  `protobuf:"varint,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	Currency       string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // Every account of the batch must be in this currency
+	Legs           []*TransferBatchLeg    `protobuf:"bytes,3,rep,name=legs,proto3" json:"legs,omitempty"`
+	Atomic         bool                   `protobuf:"varint,4,opt,name=atomic,proto3" json:"atomic,omitempty"`                                            // Make all legs or none; otherwise every leg that can be made is
+	IdempotencyKey *string                `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"` // Falls back to the Idempotency-Key header
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateTransferBatchRequest) Reset() {
+	*x = CreateTransferBatchRequest{}
+	mi := &file_rpc_create_transfer_batch_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferBatchRequest) ProtoMessage() {}
+
+func (x *CreateTransferBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_transfer_batch_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferBatchRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransferBatchRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_create_transfer_batch_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateTransferBatchRequest) GetFromAccountId() int64 {
+	if x != nil {
+		return x.FromAccountId
+	}
+	return 0
+}
+
+func (x *CreateTransferBatchRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateTransferBatchRequest) GetLegs() []*TransferBatchLeg {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+func (x *CreateTransferBatchRequest) GetAtomic() bool {
+	if x != nil {
+		return x.Atomic
+	}
+	return false
+}
+
+func (x *CreateTransferBatchRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+type CreateTransferBatchResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	FromAccount   *Account                  `protobuf:"bytes,1,opt,name=from_account,json=fromAccount,proto3" json:"from_account,omitempty"`
+	Legs          []*TransferBatchLegResult `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"` // In the order of the request legs
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransferBatchResponse) Reset() {
+	*x = CreateTransferBatchResponse{}
+	mi := &file_rpc_create_transfer_batch_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferBatchResponse) ProtoMessage() {}
+
+func (x *CreateTransferBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_create_transfer_batch_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferBatchResponse.ProtoReflect.Descriptor instead.
+func (*CreateTransferBatchResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_create_transfer_batch_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateTransferBatchResponse) GetFromAccount() *Account {
+	if x != nil {
+		return x.FromAccount
+	}
+	return nil
+}
+
+func (x *CreateTransferBatchResponse) GetLegs() []*TransferBatchLegResult {
+	if x != nil {
+		return x.Legs
+	}
+	return nil
+}
+
+var File_rpc_create_transfer_batch_proto protoreflect.FileDescriptor
+
+const file_rpc_create_transfer_batch_proto_rawDesc = "" +
+	"\n" +
+	"\x1frpc_create_transfer_batch.proto\x12\x02pb\x1a\raccount.proto\x1a\tfee.proto\x1a\x18rpc_transfer_money.proto\"N\n" +
+	"\x10TransferBatchLeg\x12\"\n" +
+	"\rto_account_id\x18\x01 \x01(\x03R\vtoAccountId\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\"x\n" +
+	"\x16TransferBatchLegResult\x12(\n" +
+	"\btransfer\x18\x01 \x01(\v2\f.pb.TransferR\btransfer\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12\x1e\n" +
+	"\x03fee\x18\x03 \x01(\v2\f.pb.FeeQuoteR\x03fee\"\xe4\x01\n" +
+	"\x1aCreateTransferBatchRequest\x12&\n" +
+	"\x0ffrom_account_id\x18\x01 \x01(\x03R\rfromAccountId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12(\n" +
+	"\x04legs\x18\x03 \x03(\v2\x14.pb.TransferBatchLegR\x04legs\x12\x16\n" +
+	"\x06atomic\x18\x04 \x01(\bR\x06atomic\x12,\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01B\x12\n" +
+	"\x10_idempotency_key\"}\n" +
+	"\x1bCreateTransferBatchResponse\x12.\n" +
+	"\ffrom_account\x18\x01 \x01(\v2\v.pb.AccountR\vfromAccount\x12.\n" +
+	"\x04legs\x18\x02 \x03(\v2\x1a.pb.TransferBatchLegResultR\x04legsB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_create_transfer_batch_proto_rawDescOnce sync.Once
+	file_rpc_create_transfer_batch_proto_rawDescData []byte
+)
+
+func file_rpc_create_transfer_batch_proto_rawDescGZIP() []byte {
+	file_rpc_create_transfer_batch_proto_rawDescOnce.Do(func() {
+		file_rpc_create_transfer_batch_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_create_transfer_batch_proto_rawDesc), len(file_rpc_create_transfer_batch_proto_rawDesc)))
+	})
+	return file_rpc_create_transfer_batch_proto_rawDescData
+}
+
+var file_rpc_create_transfer_batch_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_create_transfer_batch_proto_goTypes = []any{
+	(*TransferBatchLeg)(nil),            // 0: pb.TransferBatchLeg
+	(*TransferBatchLegResult)(nil),      // 1: pb.TransferBatchLegResult
+	(*CreateTransferBatchRequest)(nil),  // 2: pb.CreateTransferBatchRequest
+	(*CreateTransferBatchResponse)(nil), // 3: pb.CreateTransferBatchResponse
+	(*Transfer)(nil),                    // 4: pb.Transfer
+	(*FeeQuote)(nil),                    // 5: pb.FeeQuote
+	(*Account)(nil),                     // 6: pb.Account
+}
+var file_rpc_create_transfer_batch_proto_depIdxs = []int32{
+	4, // 0: pb.TransferBatchLegResult.transfer:type_name -> pb.Transfer
+	5, // 1: pb.TransferBatchLegResult.fee:type_name -> pb.FeeQuote
+	0, // 2: pb.CreateTransferBatchRequest.legs:type_name -> pb.TransferBatchLeg
+	6, // 3: pb.CreateTransferBatchResponse.from_account:type_name -> pb.Account
+	1, // 4: pb.CreateTransferBatchResponse.legs:type_name -> pb.TransferBatchLegResult
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_rpc_create_transfer_batch_proto_init() }
+func file_rpc_create_transfer_batch_proto_init() {
+	if File_rpc_create_transfer_batch_proto != nil {
+		return
+	}
+	file_account_proto_init()
+	file_fee_proto_init()
+	file_rpc_transfer_money_proto_init()
+	file_rpc_create_transfer_batch_proto_msgTypes[2].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_create_transfer_batch_proto_rawDesc), len(file_rpc_create_transfer_batch_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_create_transfer_batch_proto_goTypes,
+		DependencyIndexes: file_rpc_create_transfer_batch_proto_depIdxs,
+		MessageInfos:      file_rpc_create_transfer_batch_proto_msgTypes,
+	}.Build()
+	File_rpc_create_transfer_batch_proto = out.File
+	file_rpc_create_transfer_batch_proto_goTypes = nil
+	file_rpc_create_transfer_batch_proto_depIdxs = nil
+}
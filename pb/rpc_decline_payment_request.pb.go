@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_decline_payment_request.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type DeclinePaymentRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeclinePaymentRequestRequest) Reset() {
+	*x = DeclinePaymentRequestRequest{}
+	mi := &file_rpc_decline_payment_request_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclinePaymentRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclinePaymentRequestRequest) ProtoMessage() {}
+
+func (x *DeclinePaymentRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_decline_payment_request_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclinePaymentRequestRequest.ProtoReflect.Descriptor instead.
+func (*DeclinePaymentRequestRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_decline_payment_request_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeclinePaymentRequestRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeclinePaymentRequestResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequest *PaymentRequest        `protobuf:"bytes,1,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *DeclinePaymentRequestResponse) Reset() {
+	*x = DeclinePaymentRequestResponse{}
+	mi := &file_rpc_decline_payment_request_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeclinePaymentRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeclinePaymentRequestResponse) ProtoMessage() {}
+
+func (x *DeclinePaymentRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_decline_payment_request_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeclinePaymentRequestResponse.ProtoReflect.Descriptor instead.
+func (*DeclinePaymentRequestResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_decline_payment_request_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeclinePaymentRequestResponse) GetPaymentRequest() *PaymentRequest {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return nil
+}
+
+var File_rpc_decline_payment_request_proto protoreflect.FileDescriptor
+
+const file_rpc_decline_payment_request_proto_rawDesc = "" +
+	"\n" +
+	"!rpc_decline_payment_request.proto\x12\x02pb\x1a\x15payment_request.proto\".\n" +
+	"\x1cDeclinePaymentRequestRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\\\n" +
+	"\x1dDeclinePaymentRequestResponse\x12;\n" +
+	"\x0fpayment_request\x18\x01 \x01(\v2\x12.pb.PaymentRequestR\x0epaymentRequestB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_decline_payment_request_proto_rawDescOnce sync.Once
+	file_rpc_decline_payment_request_proto_rawDescData []byte
+)
+
+func file_rpc_decline_payment_request_proto_rawDescGZIP() []byte {
+	file_rpc_decline_payment_request_proto_rawDescOnce.Do(func() {
+		file_rpc_decline_payment_request_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_decline_payment_request_proto_rawDesc), len(file_rpc_decline_payment_request_proto_rawDesc)))
+	})
+	return file_rpc_decline_payment_request_proto_rawDescData
+}
+
+var file_rpc_decline_payment_request_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_decline_payment_request_proto_goTypes = []any{
+	(*DeclinePaymentRequestRequest)(nil),  // 0: pb.DeclinePaymentRequestRequest
+	(*DeclinePaymentRequestResponse)(nil), // 1: pb.DeclinePaymentRequestResponse
+	(*PaymentRequest)(nil),                // 2: pb.PaymentRequest
+}
+var file_rpc_decline_payment_request_proto_depIdxs = []int32{
+	2, // 0: pb.DeclinePaymentRequestResponse.payment_request:type_name -> pb.PaymentRequest
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_decline_payment_request_proto_init() }
+func file_rpc_decline_payment_request_proto_init() {
+	if File_rpc_decline_payment_request_proto != nil {
+		return
+	}
+	file_payment_request_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_decline_payment_request_proto_rawDesc), len(file_rpc_decline_payment_request_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_decline_payment_request_proto_goTypes,
+		DependencyIndexes: file_rpc_decline_payment_request_proto_depIdxs,
+		MessageInfos:      file_rpc_decline_payment_request_proto_msgTypes,
+	}.Build()
+	File_rpc_decline_payment_request_proto = out.File
+	file_rpc_decline_payment_request_proto_goTypes = nil
+	file_rpc_decline_payment_request_proto_depIdxs = nil
+}
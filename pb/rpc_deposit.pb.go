@@ -23,11 +23,15 @@ const (
 )
 
 type DepositRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents/smallest currency unit
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                            // Amount in cents/smallest currency unit
+	IdempotencyKey *string                `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"` // Falls back to the Idempotency-Key header
+	Memo           string                 `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`                                                 // Optional free text shown on statements
+	Reference      string                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`                                       // Optional external reference
+	Category       string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                                         // Optional
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DepositRequest) Reset() {
@@ -74,6 +78,34 @@ func (x *DepositRequest) GetAmount() int64 {
 	return 0
 }
 
+func (x *DepositRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *DepositRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type DepositResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -82,6 +114,7 @@ type DepositResponse struct {
 	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Review        *RiskDecision          `protobuf:"bytes,7,opt,name=review,proto3" json:"review,omitempty"` // Set alone when the deposit is held for review, it is made once approved
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -158,15 +191,28 @@ func (x *DepositResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *DepositResponse) GetReview() *RiskDecision {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
 var File_rpc_deposit_proto protoreflect.FileDescriptor
 
 const file_rpc_deposit_proto_rawDesc = "" +
 	"\n" +
-	"\x11rpc_deposit.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"G\n" +
+	"\x11rpc_deposit.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\n" +
+	"risk.proto\"\xd7\x01\n" +
 	"\x0eDepositRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x03R\x06amount\"\xe3\x01\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12,\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01\x12\x12\n" +
+	"\x04memo\x18\x04 \x01(\tR\x04memo\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategoryB\x12\n" +
+	"\x10_idempotency_key\"\x8d\x02\n" +
 	"\x0fDepositResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x18\n" +
@@ -175,7 +221,8 @@ const file_rpc_deposit_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12(\n" +
+	"\x06review\x18\a \x01(\v2\x10.pb.RiskDecisionR\x06reviewB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
 
 var (
 	file_rpc_deposit_proto_rawDescOnce sync.Once
@@ -194,15 +241,17 @@ var file_rpc_deposit_proto_goTypes = []any{
 	(*DepositRequest)(nil),        // 0: pb.DepositRequest
 	(*DepositResponse)(nil),       // 1: pb.DepositResponse
 	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*RiskDecision)(nil),          // 3: pb.RiskDecision
 }
 var file_rpc_deposit_proto_depIdxs = []int32{
 	2, // 0: pb.DepositResponse.created_at:type_name -> google.protobuf.Timestamp
 	2, // 1: pb.DepositResponse.updated_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: pb.DepositResponse.review:type_name -> pb.RiskDecision
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_rpc_deposit_proto_init() }
@@ -210,6 +259,8 @@ func file_rpc_deposit_proto_init() {
 	if File_rpc_deposit_proto != nil {
 		return
 	}
+	file_risk_proto_init()
+	file_rpc_deposit_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -66,10 +66,11 @@ func (x *GetAccountRequest) GetId() int64 {
 }
 
 type GetAccountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Account       *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Account          *Account               `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	AvailableBalance int64                  `protobuf:"varint,2,opt,name=available_balance,json=availableBalance,proto3" json:"available_balance,omitempty"` // balance minus the active holds
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *GetAccountResponse) Reset() {
@@ -109,15 +110,23 @@ func (x *GetAccountResponse) GetAccount() *Account {
 	return nil
 }
 
+func (x *GetAccountResponse) GetAvailableBalance() int64 {
+	if x != nil {
+		return x.AvailableBalance
+	}
+	return 0
+}
+
 var File_rpc_get_account_proto protoreflect.FileDescriptor
 
 const file_rpc_get_account_proto_rawDesc = "" +
 	"\n" +
 	"\x15rpc_get_account.proto\x12\x02pb\x1a\raccount.proto\"#\n" +
 	"\x11GetAccountRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\";\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"h\n" +
 	"\x12GetAccountResponse\x12%\n" +
-	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccountB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+	"\aaccount\x18\x01 \x01(\v2\v.pb.AccountR\aaccount\x12+\n" +
+	"\x11available_balance\x18\x02 \x01(\x03R\x10availableBalanceB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
 
 var (
 	file_rpc_get_account_proto_rawDescOnce sync.Once
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_get_limits.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLimitsRequest) Reset() {
+	*x = GetLimitsRequest{}
+	mi := &file_rpc_get_limits_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitsRequest) ProtoMessage() {}
+
+func (x *GetLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_limits_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_limits_proto_rawDescGZIP(), []int{0}
+}
+
+type GetLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limits        []*SpendingLimit       `protobuf:"bytes,1,rep,name=limits,proto3" json:"limits,omitempty"` // Limits of all your accounts first, then those of single accounts
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLimitsResponse) Reset() {
+	*x = GetLimitsResponse{}
+	mi := &file_rpc_get_limits_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLimitsResponse) ProtoMessage() {}
+
+func (x *GetLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_limits_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_limits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetLimitsResponse) GetLimits() []*SpendingLimit {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+var File_rpc_get_limits_proto protoreflect.FileDescriptor
+
+const file_rpc_get_limits_proto_rawDesc = "" +
+	"\n" +
+	"\x14rpc_get_limits.proto\x12\x02pb\x1a\x14spending_limit.proto\"\x12\n" +
+	"\x10GetLimitsRequest\">\n" +
+	"\x11GetLimitsResponse\x12)\n" +
+	"\x06limits\x18\x01 \x03(\v2\x11.pb.SpendingLimitR\x06limitsB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_get_limits_proto_rawDescOnce sync.Once
+	file_rpc_get_limits_proto_rawDescData []byte
+)
+
+func file_rpc_get_limits_proto_rawDescGZIP() []byte {
+	file_rpc_get_limits_proto_rawDescOnce.Do(func() {
+		file_rpc_get_limits_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_limits_proto_rawDesc), len(file_rpc_get_limits_proto_rawDesc)))
+	})
+	return file_rpc_get_limits_proto_rawDescData
+}
+
+var file_rpc_get_limits_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_limits_proto_goTypes = []any{
+	(*GetLimitsRequest)(nil),  // 0: pb.GetLimitsRequest
+	(*GetLimitsResponse)(nil), // 1: pb.GetLimitsResponse
+	(*SpendingLimit)(nil),     // 2: pb.SpendingLimit
+}
+var file_rpc_get_limits_proto_depIdxs = []int32{
+	2, // 0: pb.GetLimitsResponse.limits:type_name -> pb.SpendingLimit
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_limits_proto_init() }
+func file_rpc_get_limits_proto_init() {
+	if File_rpc_get_limits_proto != nil {
+		return
+	}
+	file_spending_limit_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_limits_proto_rawDesc), len(file_rpc_get_limits_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_limits_proto_goTypes,
+		DependencyIndexes: file_rpc_get_limits_proto_depIdxs,
+		MessageInfos:      file_rpc_get_limits_proto_msgTypes,
+	}.Build()
+	File_rpc_get_limits_proto = out.File
+	file_rpc_get_limits_proto_goTypes = nil
+	file_rpc_get_limits_proto_depIdxs = nil
+}
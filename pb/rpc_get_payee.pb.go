@@ -0,0 +1,172 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_get_payee.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetPayeeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayeeRequest) Reset() {
+	*x = GetPayeeRequest{}
+	mi := &file_rpc_get_payee_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayeeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayeeRequest) ProtoMessage() {}
+
+func (x *GetPayeeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_payee_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayeeRequest.ProtoReflect.Descriptor instead.
+func (*GetPayeeRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_payee_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GetPayeeRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetPayeeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payee         *Payee                 `protobuf:"bytes,1,opt,name=payee,proto3" json:"payee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayeeResponse) Reset() {
+	*x = GetPayeeResponse{}
+	mi := &file_rpc_get_payee_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayeeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayeeResponse) ProtoMessage() {}
+
+func (x *GetPayeeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_payee_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayeeResponse.ProtoReflect.Descriptor instead.
+func (*GetPayeeResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_payee_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetPayeeResponse) GetPayee() *Payee {
+	if x != nil {
+		return x.Payee
+	}
+	return nil
+}
+
+var File_rpc_get_payee_proto protoreflect.FileDescriptor
+
+const file_rpc_get_payee_proto_rawDesc = "" +
+	"\n" +
+	"\x13rpc_get_payee.proto\x12\x02pb\x1a\vpayee.proto\"!\n" +
+	"\x0fGetPayeeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"3\n" +
+	"\x10GetPayeeResponse\x12\x1f\n" +
+	"\x05payee\x18\x01 \x01(\v2\t.pb.PayeeR\x05payeeB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_get_payee_proto_rawDescOnce sync.Once
+	file_rpc_get_payee_proto_rawDescData []byte
+)
+
+func file_rpc_get_payee_proto_rawDescGZIP() []byte {
+	file_rpc_get_payee_proto_rawDescOnce.Do(func() {
+		file_rpc_get_payee_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_payee_proto_rawDesc), len(file_rpc_get_payee_proto_rawDesc)))
+	})
+	return file_rpc_get_payee_proto_rawDescData
+}
+
+var file_rpc_get_payee_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_get_payee_proto_goTypes = []any{
+	(*GetPayeeRequest)(nil),  // 0: pb.GetPayeeRequest
+	(*GetPayeeResponse)(nil), // 1: pb.GetPayeeResponse
+	(*Payee)(nil),            // 2: pb.Payee
+}
+var file_rpc_get_payee_proto_depIdxs = []int32{
+	2, // 0: pb.GetPayeeResponse.payee:type_name -> pb.Payee
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_payee_proto_init() }
+func file_rpc_get_payee_proto_init() {
+	if File_rpc_get_payee_proto != nil {
+		return
+	}
+	file_payee_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_payee_proto_rawDesc), len(file_rpc_get_payee_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_payee_proto_goTypes,
+		DependencyIndexes: file_rpc_get_payee_proto_depIdxs,
+		MessageInfos:      file_rpc_get_payee_proto_msgTypes,
+	}.Build()
+	File_rpc_get_payee_proto = out.File
+	file_rpc_get_payee_proto_goTypes = nil
+	file_rpc_get_payee_proto_depIdxs = nil
+}
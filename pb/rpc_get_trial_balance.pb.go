@@ -0,0 +1,329 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_get_trial_balance.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GetTrialBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrialBalanceRequest) Reset() {
+	*x = GetTrialBalanceRequest{}
+	mi := &file_rpc_get_trial_balance_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrialBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrialBalanceRequest) ProtoMessage() {}
+
+func (x *GetTrialBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_trial_balance_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrialBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetTrialBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_get_trial_balance_proto_rawDescGZIP(), []int{0}
+}
+
+// Totals of every posting in one currency; amounts are in minor units
+type TrialBalanceLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Currency      string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Postings      int64                  `protobuf:"varint,2,opt,name=postings,proto3" json:"postings,omitempty"`
+	Debits        int64                  `protobuf:"varint,3,opt,name=debits,proto3" json:"debits,omitempty"`
+	Credits       int64                  `protobuf:"varint,4,opt,name=credits,proto3" json:"credits,omitempty"`
+	Net           int64                  `protobuf:"varint,5,opt,name=net,proto3" json:"net,omitempty"` // Zero when the ledger balances
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TrialBalanceLine) Reset() {
+	*x = TrialBalanceLine{}
+	mi := &file_rpc_get_trial_balance_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TrialBalanceLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrialBalanceLine) ProtoMessage() {}
+
+func (x *TrialBalanceLine) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_trial_balance_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrialBalanceLine.ProtoReflect.Descriptor instead.
+func (*TrialBalanceLine) Descriptor() ([]byte, []int) {
+	return file_rpc_get_trial_balance_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TrialBalanceLine) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *TrialBalanceLine) GetPostings() int64 {
+	if x != nil {
+		return x.Postings
+	}
+	return 0
+}
+
+func (x *TrialBalanceLine) GetDebits() int64 {
+	if x != nil {
+		return x.Debits
+	}
+	return 0
+}
+
+func (x *TrialBalanceLine) GetCredits() int64 {
+	if x != nil {
+		return x.Credits
+	}
+	return 0
+}
+
+func (x *TrialBalanceLine) GetNet() int64 {
+	if x != nil {
+		return x.Net
+	}
+	return 0
+}
+
+type SystemAccountBalance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SystemAccountBalance) Reset() {
+	*x = SystemAccountBalance{}
+	mi := &file_rpc_get_trial_balance_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SystemAccountBalance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemAccountBalance) ProtoMessage() {}
+
+func (x *SystemAccountBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_trial_balance_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemAccountBalance.ProtoReflect.Descriptor instead.
+func (*SystemAccountBalance) Descriptor() ([]byte, []int) {
+	return file_rpc_get_trial_balance_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SystemAccountBalance) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *SystemAccountBalance) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SystemAccountBalance) GetBalance() int64 {
+	if x != nil {
+		return x.Balance
+	}
+	return 0
+}
+
+type GetTrialBalanceResponse struct {
+	state          protoimpl.MessageState  `protogen:"open.v1"`
+	Lines          []*TrialBalanceLine     `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`
+	SystemAccounts []*SystemAccountBalance `protobuf:"bytes,2,rep,name=system_accounts,json=systemAccounts,proto3" json:"system_accounts,omitempty"`
+	Balanced       bool                    `protobuf:"varint,3,opt,name=balanced,proto3" json:"balanced,omitempty"` // True when the net of every currency is zero
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetTrialBalanceResponse) Reset() {
+	*x = GetTrialBalanceResponse{}
+	mi := &file_rpc_get_trial_balance_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrialBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTrialBalanceResponse) ProtoMessage() {}
+
+func (x *GetTrialBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_get_trial_balance_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTrialBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetTrialBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_get_trial_balance_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetTrialBalanceResponse) GetLines() []*TrialBalanceLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceResponse) GetSystemAccounts() []*SystemAccountBalance {
+	if x != nil {
+		return x.SystemAccounts
+	}
+	return nil
+}
+
+func (x *GetTrialBalanceResponse) GetBalanced() bool {
+	if x != nil {
+		return x.Balanced
+	}
+	return false
+}
+
+var File_rpc_get_trial_balance_proto protoreflect.FileDescriptor
+
+const file_rpc_get_trial_balance_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_get_trial_balance.proto\x12\x02pb\"\x18\n" +
+	"\x16GetTrialBalanceRequest\"\x8e\x01\n" +
+	"\x10TrialBalanceLine\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12\x1a\n" +
+	"\bpostings\x18\x02 \x01(\x03R\bpostings\x12\x16\n" +
+	"\x06debits\x18\x03 \x01(\x03R\x06debits\x12\x18\n" +
+	"\acredits\x18\x04 \x01(\x03R\acredits\x12\x10\n" +
+	"\x03net\x18\x05 \x01(\x03R\x03net\"`\n" +
+	"\x14SystemAccountBalance\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\"\xa4\x01\n" +
+	"\x17GetTrialBalanceResponse\x12*\n" +
+	"\x05lines\x18\x01 \x03(\v2\x14.pb.TrialBalanceLineR\x05lines\x12A\n" +
+	"\x0fsystem_accounts\x18\x02 \x03(\v2\x18.pb.SystemAccountBalanceR\x0esystemAccounts\x12\x1a\n" +
+	"\bbalanced\x18\x03 \x01(\bR\bbalancedB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_get_trial_balance_proto_rawDescOnce sync.Once
+	file_rpc_get_trial_balance_proto_rawDescData []byte
+)
+
+func file_rpc_get_trial_balance_proto_rawDescGZIP() []byte {
+	file_rpc_get_trial_balance_proto_rawDescOnce.Do(func() {
+		file_rpc_get_trial_balance_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_get_trial_balance_proto_rawDesc), len(file_rpc_get_trial_balance_proto_rawDesc)))
+	})
+	return file_rpc_get_trial_balance_proto_rawDescData
+}
+
+var file_rpc_get_trial_balance_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_rpc_get_trial_balance_proto_goTypes = []any{
+	(*GetTrialBalanceRequest)(nil),  // 0: pb.GetTrialBalanceRequest
+	(*TrialBalanceLine)(nil),        // 1: pb.TrialBalanceLine
+	(*SystemAccountBalance)(nil),    // 2: pb.SystemAccountBalance
+	(*GetTrialBalanceResponse)(nil), // 3: pb.GetTrialBalanceResponse
+}
+var file_rpc_get_trial_balance_proto_depIdxs = []int32{
+	1, // 0: pb.GetTrialBalanceResponse.lines:type_name -> pb.TrialBalanceLine
+	2, // 1: pb.GetTrialBalanceResponse.system_accounts:type_name -> pb.SystemAccountBalance
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_get_trial_balance_proto_init() }
+func file_rpc_get_trial_balance_proto_init() {
+	if File_rpc_get_trial_balance_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_get_trial_balance_proto_rawDesc), len(file_rpc_get_trial_balance_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_get_trial_balance_proto_goTypes,
+		DependencyIndexes: file_rpc_get_trial_balance_proto_depIdxs,
+		MessageInfos:      file_rpc_get_trial_balance_proto_msgTypes,
+	}.Build()
+	File_rpc_get_trial_balance_proto = out.File
+	file_rpc_get_trial_balance_proto_goTypes = nil
+	file_rpc_get_trial_balance_proto_depIdxs = nil
+}
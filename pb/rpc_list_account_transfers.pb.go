@@ -0,0 +1,271 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_list_account_transfers.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListAccountTransfersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	FromTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=from_time,json=fromTime,proto3" json:"from_time,omitempty"`     // Inclusive
+	ToTime        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=to_time,json=toTime,proto3" json:"to_time,omitempty"`           // Exclusive
+	MinAmount     int64                  `protobuf:"varint,4,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"` // Compared against the absolute amount
+	MaxAmount     int64                  `protobuf:"varint,5,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` // Compared against the absolute amount
+	PageSize      int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`    // Defaults to 10, at most 50
+	PageToken     string                 `protobuf:"bytes,7,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`  // next_page_token of the previous page
+	Query         string                 `protobuf:"bytes,8,opt,name=query,proto3" json:"query,omitempty"`                           // Full-text search on the memo, in web search syntax
+	Reference     string                 `protobuf:"bytes,9,opt,name=reference,proto3" json:"reference,omitempty"`                   // Exact match
+	Category      string                 `protobuf:"bytes,10,opt,name=category,proto3" json:"category,omitempty"`                    // Exact match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountTransfersRequest) Reset() {
+	*x = ListAccountTransfersRequest{}
+	mi := &file_rpc_list_account_transfers_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountTransfersRequest) ProtoMessage() {}
+
+func (x *ListAccountTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_account_transfers_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListAccountTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_account_transfers_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListAccountTransfersRequest) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *ListAccountTransfersRequest) GetFromTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTime
+	}
+	return nil
+}
+
+func (x *ListAccountTransfersRequest) GetToTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTime
+	}
+	return nil
+}
+
+func (x *ListAccountTransfersRequest) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *ListAccountTransfersRequest) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *ListAccountTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListAccountTransfersRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListAccountTransfersRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *ListAccountTransfersRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ListAccountTransfersRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+type ListAccountTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`                                // Newest first
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty on the last page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAccountTransfersResponse) Reset() {
+	*x = ListAccountTransfersResponse{}
+	mi := &file_rpc_list_account_transfers_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAccountTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAccountTransfersResponse) ProtoMessage() {}
+
+func (x *ListAccountTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_account_transfers_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAccountTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListAccountTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_account_transfers_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListAccountTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *ListAccountTransfersResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+var File_rpc_list_account_transfers_proto protoreflect.FileDescriptor
+
+const file_rpc_list_account_transfers_proto_rawDesc = "" +
+	"\n" +
+	" rpc_list_account_transfers.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x18rpc_transfer_money.proto\"\xf4\x02\n" +
+	"\x1bListAccountTransfersRequest\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x127\n" +
+	"\tfrom_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bfromTime\x123\n" +
+	"\ato_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06toTime\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x04 \x01(\x03R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x05 \x01(\x03R\tmaxAmount\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\a \x01(\tR\tpageToken\x12\x14\n" +
+	"\x05query\x18\b \x01(\tR\x05query\x12\x1c\n" +
+	"\treference\x18\t \x01(\tR\treference\x12\x1a\n" +
+	"\bcategory\x18\n" +
+	" \x01(\tR\bcategory\"r\n" +
+	"\x1cListAccountTransfersResponse\x12*\n" +
+	"\ttransfers\x18\x01 \x03(\v2\f.pb.TransferR\ttransfers\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageTokenB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_account_transfers_proto_rawDescOnce sync.Once
+	file_rpc_list_account_transfers_proto_rawDescData []byte
+)
+
+func file_rpc_list_account_transfers_proto_rawDescGZIP() []byte {
+	file_rpc_list_account_transfers_proto_rawDescOnce.Do(func() {
+		file_rpc_list_account_transfers_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_account_transfers_proto_rawDesc), len(file_rpc_list_account_transfers_proto_rawDesc)))
+	})
+	return file_rpc_list_account_transfers_proto_rawDescData
+}
+
+var file_rpc_list_account_transfers_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_account_transfers_proto_goTypes = []any{
+	(*ListAccountTransfersRequest)(nil),  // 0: pb.ListAccountTransfersRequest
+	(*ListAccountTransfersResponse)(nil), // 1: pb.ListAccountTransfersResponse
+	(*timestamppb.Timestamp)(nil),        // 2: google.protobuf.Timestamp
+	(*Transfer)(nil),                     // 3: pb.Transfer
+}
+var file_rpc_list_account_transfers_proto_depIdxs = []int32{
+	2, // 0: pb.ListAccountTransfersRequest.from_time:type_name -> google.protobuf.Timestamp
+	2, // 1: pb.ListAccountTransfersRequest.to_time:type_name -> google.protobuf.Timestamp
+	3, // 2: pb.ListAccountTransfersResponse.transfers:type_name -> pb.Transfer
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_account_transfers_proto_init() }
+func file_rpc_list_account_transfers_proto_init() {
+	if File_rpc_list_account_transfers_proto != nil {
+		return
+	}
+	file_rpc_transfer_money_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_account_transfers_proto_rawDesc), len(file_rpc_list_account_transfers_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_account_transfers_proto_goTypes,
+		DependencyIndexes: file_rpc_list_account_transfers_proto_depIdxs,
+		MessageInfos:      file_rpc_list_account_transfers_proto_msgTypes,
+	}.Build()
+	File_rpc_list_account_transfers_proto = out.File
+	file_rpc_list_account_transfers_proto_goTypes = nil
+	file_rpc_list_account_transfers_proto_depIdxs = nil
+}
@@ -0,0 +1,163 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_list_payees.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListPayeesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayeesRequest) Reset() {
+	*x = ListPayeesRequest{}
+	mi := &file_rpc_list_payees_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayeesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayeesRequest) ProtoMessage() {}
+
+func (x *ListPayeesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_payees_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayeesRequest.ProtoReflect.Descriptor instead.
+func (*ListPayeesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_payees_proto_rawDescGZIP(), []int{0}
+}
+
+type ListPayeesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payees        []*Payee               `protobuf:"bytes,1,rep,name=payees,proto3" json:"payees,omitempty"` // Ordered by nickname
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayeesResponse) Reset() {
+	*x = ListPayeesResponse{}
+	mi := &file_rpc_list_payees_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayeesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayeesResponse) ProtoMessage() {}
+
+func (x *ListPayeesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_payees_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayeesResponse.ProtoReflect.Descriptor instead.
+func (*ListPayeesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_payees_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPayeesResponse) GetPayees() []*Payee {
+	if x != nil {
+		return x.Payees
+	}
+	return nil
+}
+
+var File_rpc_list_payees_proto protoreflect.FileDescriptor
+
+const file_rpc_list_payees_proto_rawDesc = "" +
+	"\n" +
+	"\x15rpc_list_payees.proto\x12\x02pb\x1a\vpayee.proto\"\x13\n" +
+	"\x11ListPayeesRequest\"7\n" +
+	"\x12ListPayeesResponse\x12!\n" +
+	"\x06payees\x18\x01 \x03(\v2\t.pb.PayeeR\x06payeesB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_payees_proto_rawDescOnce sync.Once
+	file_rpc_list_payees_proto_rawDescData []byte
+)
+
+func file_rpc_list_payees_proto_rawDescGZIP() []byte {
+	file_rpc_list_payees_proto_rawDescOnce.Do(func() {
+		file_rpc_list_payees_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_payees_proto_rawDesc), len(file_rpc_list_payees_proto_rawDesc)))
+	})
+	return file_rpc_list_payees_proto_rawDescData
+}
+
+var file_rpc_list_payees_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_payees_proto_goTypes = []any{
+	(*ListPayeesRequest)(nil),  // 0: pb.ListPayeesRequest
+	(*ListPayeesResponse)(nil), // 1: pb.ListPayeesResponse
+	(*Payee)(nil),              // 2: pb.Payee
+}
+var file_rpc_list_payees_proto_depIdxs = []int32{
+	2, // 0: pb.ListPayeesResponse.payees:type_name -> pb.Payee
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_payees_proto_init() }
+func file_rpc_list_payees_proto_init() {
+	if File_rpc_list_payees_proto != nil {
+		return
+	}
+	file_payee_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_payees_proto_rawDesc), len(file_rpc_list_payees_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_payees_proto_goTypes,
+		DependencyIndexes: file_rpc_list_payees_proto_depIdxs,
+		MessageInfos:      file_rpc_list_payees_proto_msgTypes,
+	}.Build()
+	File_rpc_list_payees_proto = out.File
+	file_rpc_list_payees_proto_goTypes = nil
+	file_rpc_list_payees_proto_depIdxs = nil
+}
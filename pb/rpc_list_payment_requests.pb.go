@@ -0,0 +1,199 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_list_payment_requests.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListPaymentRequestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Direction     string                 `protobuf:"bytes,1,opt,name=direction,proto3" json:"direction,omitempty"`                // incoming for requests you are asked to pay, outgoing for the ones you made; defaults to incoming
+	Status        string                 `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`                      // pending, accepted, declined or expired; all of them when left out
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 10, at most 50
+	AfterId       int64                  `protobuf:"varint,4,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`    // id of the last request of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPaymentRequestsRequest) Reset() {
+	*x = ListPaymentRequestsRequest{}
+	mi := &file_rpc_list_payment_requests_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentRequestsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentRequestsRequest) ProtoMessage() {}
+
+func (x *ListPaymentRequestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_payment_requests_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentRequestsRequest.ProtoReflect.Descriptor instead.
+func (*ListPaymentRequestsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_payment_requests_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListPaymentRequestsRequest) GetDirection() string {
+	if x != nil {
+		return x.Direction
+	}
+	return ""
+}
+
+func (x *ListPaymentRequestsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListPaymentRequestsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListPaymentRequestsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+type ListPaymentRequestsResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PaymentRequests []*PaymentRequest      `protobuf:"bytes,1,rep,name=payment_requests,json=paymentRequests,proto3" json:"payment_requests,omitempty"` // Oldest first
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListPaymentRequestsResponse) Reset() {
+	*x = ListPaymentRequestsResponse{}
+	mi := &file_rpc_list_payment_requests_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPaymentRequestsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPaymentRequestsResponse) ProtoMessage() {}
+
+func (x *ListPaymentRequestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_payment_requests_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPaymentRequestsResponse.ProtoReflect.Descriptor instead.
+func (*ListPaymentRequestsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_payment_requests_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListPaymentRequestsResponse) GetPaymentRequests() []*PaymentRequest {
+	if x != nil {
+		return x.PaymentRequests
+	}
+	return nil
+}
+
+var File_rpc_list_payment_requests_proto protoreflect.FileDescriptor
+
+const file_rpc_list_payment_requests_proto_rawDesc = "" +
+	"\n" +
+	"\x1frpc_list_payment_requests.proto\x12\x02pb\x1a\x15payment_request.proto\"\x8a\x01\n" +
+	"\x1aListPaymentRequestsRequest\x12\x1c\n" +
+	"\tdirection\x18\x01 \x01(\tR\tdirection\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bafter_id\x18\x04 \x01(\x03R\aafterId\"\\\n" +
+	"\x1bListPaymentRequestsResponse\x12=\n" +
+	"\x10payment_requests\x18\x01 \x03(\v2\x12.pb.PaymentRequestR\x0fpaymentRequestsB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_payment_requests_proto_rawDescOnce sync.Once
+	file_rpc_list_payment_requests_proto_rawDescData []byte
+)
+
+func file_rpc_list_payment_requests_proto_rawDescGZIP() []byte {
+	file_rpc_list_payment_requests_proto_rawDescOnce.Do(func() {
+		file_rpc_list_payment_requests_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_payment_requests_proto_rawDesc), len(file_rpc_list_payment_requests_proto_rawDesc)))
+	})
+	return file_rpc_list_payment_requests_proto_rawDescData
+}
+
+var file_rpc_list_payment_requests_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_payment_requests_proto_goTypes = []any{
+	(*ListPaymentRequestsRequest)(nil),  // 0: pb.ListPaymentRequestsRequest
+	(*ListPaymentRequestsResponse)(nil), // 1: pb.ListPaymentRequestsResponse
+	(*PaymentRequest)(nil),              // 2: pb.PaymentRequest
+}
+var file_rpc_list_payment_requests_proto_depIdxs = []int32{
+	2, // 0: pb.ListPaymentRequestsResponse.payment_requests:type_name -> pb.PaymentRequest
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_payment_requests_proto_init() }
+func file_rpc_list_payment_requests_proto_init() {
+	if File_rpc_list_payment_requests_proto != nil {
+		return
+	}
+	file_payment_request_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_payment_requests_proto_rawDesc), len(file_rpc_list_payment_requests_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_payment_requests_proto_goTypes,
+		DependencyIndexes: file_rpc_list_payment_requests_proto_depIdxs,
+		MessageInfos:      file_rpc_list_payment_requests_proto_msgTypes,
+	}.Build()
+	File_rpc_list_payment_requests_proto = out.File
+	file_rpc_list_payment_requests_proto_goTypes = nil
+	file_rpc_list_payment_requests_proto_depIdxs = nil
+}
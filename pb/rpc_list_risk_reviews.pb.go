@@ -0,0 +1,191 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_list_risk_reviews.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ListRiskReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`                      // pending, approved or rejected; defaults to pending
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // Defaults to 10, at most 50
+	AfterId       int64                  `protobuf:"varint,3,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"`    // id of the last review of the previous page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskReviewsRequest) Reset() {
+	*x = ListRiskReviewsRequest{}
+	mi := &file_rpc_list_risk_reviews_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskReviewsRequest) ProtoMessage() {}
+
+func (x *ListRiskReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_risk_reviews_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListRiskReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_list_risk_reviews_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ListRiskReviewsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListRiskReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListRiskReviewsRequest) GetAfterId() int64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+type ListRiskReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*RiskDecision        `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"` // Oldest first
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskReviewsResponse) Reset() {
+	*x = ListRiskReviewsResponse{}
+	mi := &file_rpc_list_risk_reviews_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskReviewsResponse) ProtoMessage() {}
+
+func (x *ListRiskReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_list_risk_reviews_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListRiskReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_list_risk_reviews_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ListRiskReviewsResponse) GetReviews() []*RiskDecision {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+var File_rpc_list_risk_reviews_proto protoreflect.FileDescriptor
+
+const file_rpc_list_risk_reviews_proto_rawDesc = "" +
+	"\n" +
+	"\x1brpc_list_risk_reviews.proto\x12\x02pb\x1a\n" +
+	"risk.proto\"h\n" +
+	"\x16ListRiskReviewsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x19\n" +
+	"\bafter_id\x18\x03 \x01(\x03R\aafterId\"E\n" +
+	"\x17ListRiskReviewsResponse\x12*\n" +
+	"\areviews\x18\x01 \x03(\v2\x10.pb.RiskDecisionR\areviewsB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_list_risk_reviews_proto_rawDescOnce sync.Once
+	file_rpc_list_risk_reviews_proto_rawDescData []byte
+)
+
+func file_rpc_list_risk_reviews_proto_rawDescGZIP() []byte {
+	file_rpc_list_risk_reviews_proto_rawDescOnce.Do(func() {
+		file_rpc_list_risk_reviews_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_list_risk_reviews_proto_rawDesc), len(file_rpc_list_risk_reviews_proto_rawDesc)))
+	})
+	return file_rpc_list_risk_reviews_proto_rawDescData
+}
+
+var file_rpc_list_risk_reviews_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_list_risk_reviews_proto_goTypes = []any{
+	(*ListRiskReviewsRequest)(nil),  // 0: pb.ListRiskReviewsRequest
+	(*ListRiskReviewsResponse)(nil), // 1: pb.ListRiskReviewsResponse
+	(*RiskDecision)(nil),            // 2: pb.RiskDecision
+}
+var file_rpc_list_risk_reviews_proto_depIdxs = []int32{
+	2, // 0: pb.ListRiskReviewsResponse.reviews:type_name -> pb.RiskDecision
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_list_risk_reviews_proto_init() }
+func file_rpc_list_risk_reviews_proto_init() {
+	if File_rpc_list_risk_reviews_proto != nil {
+		return
+	}
+	file_risk_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_list_risk_reviews_proto_rawDesc), len(file_rpc_list_risk_reviews_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_list_risk_reviews_proto_goTypes,
+		DependencyIndexes: file_rpc_list_risk_reviews_proto_depIdxs,
+		MessageInfos:      file_rpc_list_risk_reviews_proto_msgTypes,
+	}.Build()
+	File_rpc_list_risk_reviews_proto = out.File
+	file_rpc_list_risk_reviews_proto_goTypes = nil
+	file_rpc_list_risk_reviews_proto_depIdxs = nil
+}
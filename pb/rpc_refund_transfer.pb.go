@@ -0,0 +1,216 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_refund_transfer.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RefundTransferRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TransferId     int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	Amount         *int64                 `protobuf:"varint,2,opt,name=amount,proto3,oneof" json:"amount,omitempty"`                                      // In the currency of the receiving account; refunds everything not refunded yet when not set
+	IdempotencyKey *string                `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"` // Falls back to the Idempotency-Key header
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RefundTransferRequest) Reset() {
+	*x = RefundTransferRequest{}
+	mi := &file_rpc_refund_transfer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTransferRequest) ProtoMessage() {}
+
+func (x *RefundTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_refund_transfer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTransferRequest.ProtoReflect.Descriptor instead.
+func (*RefundTransferRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_refund_transfer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RefundTransferRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *RefundTransferRequest) GetAmount() int64 {
+	if x != nil && x.Amount != nil {
+		return *x.Amount
+	}
+	return 0
+}
+
+func (x *RefundTransferRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+type RefundTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refund        *Transfer              `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	Transfer      *Transfer              `protobuf:"bytes,2,opt,name=transfer,proto3" json:"transfer,omitempty"` // The refunded transfer with its updated refunded amount
+	Account       *Account               `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`   // The receiving account the refund was paid from
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundTransferResponse) Reset() {
+	*x = RefundTransferResponse{}
+	mi := &file_rpc_refund_transfer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundTransferResponse) ProtoMessage() {}
+
+func (x *RefundTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_refund_transfer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundTransferResponse.ProtoReflect.Descriptor instead.
+func (*RefundTransferResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_refund_transfer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RefundTransferResponse) GetRefund() *Transfer {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *RefundTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+func (x *RefundTransferResponse) GetAccount() *Account {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+var File_rpc_refund_transfer_proto protoreflect.FileDescriptor
+
+const file_rpc_refund_transfer_proto_rawDesc = "" +
+	"\n" +
+	"\x19rpc_refund_transfer.proto\x12\x02pb\x1a\raccount.proto\x1a\x18rpc_transfer_money.proto\"\xa2\x01\n" +
+	"\x15RefundTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\x12\x1b\n" +
+	"\x06amount\x18\x02 \x01(\x03H\x00R\x06amount\x88\x01\x01\x12,\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tH\x01R\x0eidempotencyKey\x88\x01\x01B\t\n" +
+	"\a_amountB\x12\n" +
+	"\x10_idempotency_key\"\x8f\x01\n" +
+	"\x16RefundTransferResponse\x12$\n" +
+	"\x06refund\x18\x01 \x01(\v2\f.pb.TransferR\x06refund\x12(\n" +
+	"\btransfer\x18\x02 \x01(\v2\f.pb.TransferR\btransfer\x12%\n" +
+	"\aaccount\x18\x03 \x01(\v2\v.pb.AccountR\aaccountB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_refund_transfer_proto_rawDescOnce sync.Once
+	file_rpc_refund_transfer_proto_rawDescData []byte
+)
+
+func file_rpc_refund_transfer_proto_rawDescGZIP() []byte {
+	file_rpc_refund_transfer_proto_rawDescOnce.Do(func() {
+		file_rpc_refund_transfer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_refund_transfer_proto_rawDesc), len(file_rpc_refund_transfer_proto_rawDesc)))
+	})
+	return file_rpc_refund_transfer_proto_rawDescData
+}
+
+var file_rpc_refund_transfer_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_refund_transfer_proto_goTypes = []any{
+	(*RefundTransferRequest)(nil),  // 0: pb.RefundTransferRequest
+	(*RefundTransferResponse)(nil), // 1: pb.RefundTransferResponse
+	(*Transfer)(nil),               // 2: pb.Transfer
+	(*Account)(nil),                // 3: pb.Account
+}
+var file_rpc_refund_transfer_proto_depIdxs = []int32{
+	2, // 0: pb.RefundTransferResponse.refund:type_name -> pb.Transfer
+	2, // 1: pb.RefundTransferResponse.transfer:type_name -> pb.Transfer
+	3, // 2: pb.RefundTransferResponse.account:type_name -> pb.Account
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_rpc_refund_transfer_proto_init() }
+func file_rpc_refund_transfer_proto_init() {
+	if File_rpc_refund_transfer_proto != nil {
+		return
+	}
+	file_account_proto_init()
+	file_rpc_transfer_money_proto_init()
+	file_rpc_refund_transfer_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_refund_transfer_proto_rawDesc), len(file_rpc_refund_transfer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_refund_transfer_proto_goTypes,
+		DependencyIndexes: file_rpc_refund_transfer_proto_depIdxs,
+		MessageInfos:      file_rpc_refund_transfer_proto_msgTypes,
+	}.Build()
+	File_rpc_refund_transfer_proto = out.File
+	file_rpc_refund_transfer_proto_goTypes = nil
+	file_rpc_refund_transfer_proto_depIdxs = nil
+}
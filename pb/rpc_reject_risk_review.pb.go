@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_reject_risk_review.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type RejectRiskReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"` // Recorded in the audit log
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRiskReviewRequest) Reset() {
+	*x = RejectRiskReviewRequest{}
+	mi := &file_rpc_reject_risk_review_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRiskReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRiskReviewRequest) ProtoMessage() {}
+
+func (x *RejectRiskReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reject_risk_review_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRiskReviewRequest.ProtoReflect.Descriptor instead.
+func (*RejectRiskReviewRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_reject_risk_review_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RejectRiskReviewRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RejectRiskReviewRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type RejectRiskReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *RiskDecision          `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectRiskReviewResponse) Reset() {
+	*x = RejectRiskReviewResponse{}
+	mi := &file_rpc_reject_risk_review_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectRiskReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectRiskReviewResponse) ProtoMessage() {}
+
+func (x *RejectRiskReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_reject_risk_review_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectRiskReviewResponse.ProtoReflect.Descriptor instead.
+func (*RejectRiskReviewResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_reject_risk_review_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RejectRiskReviewResponse) GetReview() *RiskDecision {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+var File_rpc_reject_risk_review_proto protoreflect.FileDescriptor
+
+const file_rpc_reject_risk_review_proto_rawDesc = "" +
+	"\n" +
+	"\x1crpc_reject_risk_review.proto\x12\x02pb\x1a\n" +
+	"risk.proto\"A\n" +
+	"\x17RejectRiskReviewRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"D\n" +
+	"\x18RejectRiskReviewResponse\x12(\n" +
+	"\x06review\x18\x01 \x01(\v2\x10.pb.RiskDecisionR\x06reviewB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_reject_risk_review_proto_rawDescOnce sync.Once
+	file_rpc_reject_risk_review_proto_rawDescData []byte
+)
+
+func file_rpc_reject_risk_review_proto_rawDescGZIP() []byte {
+	file_rpc_reject_risk_review_proto_rawDescOnce.Do(func() {
+		file_rpc_reject_risk_review_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_reject_risk_review_proto_rawDesc), len(file_rpc_reject_risk_review_proto_rawDesc)))
+	})
+	return file_rpc_reject_risk_review_proto_rawDescData
+}
+
+var file_rpc_reject_risk_review_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_reject_risk_review_proto_goTypes = []any{
+	(*RejectRiskReviewRequest)(nil),  // 0: pb.RejectRiskReviewRequest
+	(*RejectRiskReviewResponse)(nil), // 1: pb.RejectRiskReviewResponse
+	(*RiskDecision)(nil),             // 2: pb.RiskDecision
+}
+var file_rpc_reject_risk_review_proto_depIdxs = []int32{
+	2, // 0: pb.RejectRiskReviewResponse.review:type_name -> pb.RiskDecision
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_reject_risk_review_proto_init() }
+func file_rpc_reject_risk_review_proto_init() {
+	if File_rpc_reject_risk_review_proto != nil {
+		return
+	}
+	file_risk_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_reject_risk_review_proto_rawDesc), len(file_rpc_reject_risk_review_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_reject_risk_review_proto_goTypes,
+		DependencyIndexes: file_rpc_reject_risk_review_proto_depIdxs,
+		MessageInfos:      file_rpc_reject_risk_review_proto_msgTypes,
+	}.Build()
+	File_rpc_reject_risk_review_proto = out.File
+	file_rpc_reject_risk_review_proto_goTypes = nil
+	file_rpc_reject_risk_review_proto_depIdxs = nil
+}
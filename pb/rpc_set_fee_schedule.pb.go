@@ -0,0 +1,229 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_set_fee_schedule.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetFeeScheduleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Operation     string                 `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"` // transfer or withdrawal
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	FlatAmount    int64                  `protobuf:"varint,3,opt,name=flat_amount,json=flatAmount,proto3" json:"flat_amount,omitempty"`
+	Rate          int32                  `protobuf:"varint,4,opt,name=rate,proto3" json:"rate,omitempty"` // Basis points, between 0 and 10000
+	MinAmount     int64                  `protobuf:"varint,5,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount     int64                  `protobuf:"varint,6,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"` // 0 leaves the fee uncapped
+	FreePerMonth  int32                  `protobuf:"varint,7,opt,name=free_per_month,json=freePerMonth,proto3" json:"free_per_month,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeeScheduleRequest) Reset() {
+	*x = SetFeeScheduleRequest{}
+	mi := &file_rpc_set_fee_schedule_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeeScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeScheduleRequest) ProtoMessage() {}
+
+func (x *SetFeeScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_fee_schedule_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetFeeScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_fee_schedule_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetFeeScheduleRequest) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *SetFeeScheduleRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SetFeeScheduleRequest) GetFlatAmount() int64 {
+	if x != nil {
+		return x.FlatAmount
+	}
+	return 0
+}
+
+func (x *SetFeeScheduleRequest) GetRate() int32 {
+	if x != nil {
+		return x.Rate
+	}
+	return 0
+}
+
+func (x *SetFeeScheduleRequest) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *SetFeeScheduleRequest) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *SetFeeScheduleRequest) GetFreePerMonth() int32 {
+	if x != nil {
+		return x.FreePerMonth
+	}
+	return 0
+}
+
+type SetFeeScheduleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Schedule      *FeeSchedule           `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFeeScheduleResponse) Reset() {
+	*x = SetFeeScheduleResponse{}
+	mi := &file_rpc_set_fee_schedule_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFeeScheduleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFeeScheduleResponse) ProtoMessage() {}
+
+func (x *SetFeeScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_fee_schedule_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFeeScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetFeeScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_fee_schedule_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetFeeScheduleResponse) GetSchedule() *FeeSchedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+var File_rpc_set_fee_schedule_proto protoreflect.FileDescriptor
+
+const file_rpc_set_fee_schedule_proto_rawDesc = "" +
+	"\n" +
+	"\x1arpc_set_fee_schedule.proto\x12\x02pb\x1a\tfee.proto\"\xea\x01\n" +
+	"\x15SetFeeScheduleRequest\x12\x1c\n" +
+	"\toperation\x18\x01 \x01(\tR\toperation\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vflat_amount\x18\x03 \x01(\x03R\n" +
+	"flatAmount\x12\x12\n" +
+	"\x04rate\x18\x04 \x01(\x05R\x04rate\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\x05 \x01(\x03R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\x06 \x01(\x03R\tmaxAmount\x12$\n" +
+	"\x0efree_per_month\x18\a \x01(\x05R\ffreePerMonth\"E\n" +
+	"\x16SetFeeScheduleResponse\x12+\n" +
+	"\bschedule\x18\x01 \x01(\v2\x0f.pb.FeeScheduleR\bscheduleB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_set_fee_schedule_proto_rawDescOnce sync.Once
+	file_rpc_set_fee_schedule_proto_rawDescData []byte
+)
+
+func file_rpc_set_fee_schedule_proto_rawDescGZIP() []byte {
+	file_rpc_set_fee_schedule_proto_rawDescOnce.Do(func() {
+		file_rpc_set_fee_schedule_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_set_fee_schedule_proto_rawDesc), len(file_rpc_set_fee_schedule_proto_rawDesc)))
+	})
+	return file_rpc_set_fee_schedule_proto_rawDescData
+}
+
+var file_rpc_set_fee_schedule_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_set_fee_schedule_proto_goTypes = []any{
+	(*SetFeeScheduleRequest)(nil),  // 0: pb.SetFeeScheduleRequest
+	(*SetFeeScheduleResponse)(nil), // 1: pb.SetFeeScheduleResponse
+	(*FeeSchedule)(nil),            // 2: pb.FeeSchedule
+}
+var file_rpc_set_fee_schedule_proto_depIdxs = []int32{
+	2, // 0: pb.SetFeeScheduleResponse.schedule:type_name -> pb.FeeSchedule
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_fee_schedule_proto_init() }
+func file_rpc_set_fee_schedule_proto_init() {
+	if File_rpc_set_fee_schedule_proto != nil {
+		return
+	}
+	file_fee_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_set_fee_schedule_proto_rawDesc), len(file_rpc_set_fee_schedule_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_fee_schedule_proto_goTypes,
+		DependencyIndexes: file_rpc_set_fee_schedule_proto_depIdxs,
+		MessageInfos:      file_rpc_set_fee_schedule_proto_msgTypes,
+	}.Build()
+	File_rpc_set_fee_schedule_proto = out.File
+	file_rpc_set_fee_schedule_proto_goTypes = nil
+	file_rpc_set_fee_schedule_proto_depIdxs = nil
+}
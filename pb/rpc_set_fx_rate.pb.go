@@ -0,0 +1,192 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_set_fx_rate.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type SetFxRateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromCurrency  string                 `protobuf:"bytes,1,opt,name=from_currency,json=fromCurrency,proto3" json:"from_currency,omitempty"`
+	ToCurrency    string                 `protobuf:"bytes,2,opt,name=to_currency,json=toCurrency,proto3" json:"to_currency,omitempty"`
+	Rate          string                 `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"` // Decimal with up to 8 decimal places, e.g. "0.91734"
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFxRateRequest) Reset() {
+	*x = SetFxRateRequest{}
+	mi := &file_rpc_set_fx_rate_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFxRateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFxRateRequest) ProtoMessage() {}
+
+func (x *SetFxRateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_fx_rate_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFxRateRequest.ProtoReflect.Descriptor instead.
+func (*SetFxRateRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_set_fx_rate_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SetFxRateRequest) GetFromCurrency() string {
+	if x != nil {
+		return x.FromCurrency
+	}
+	return ""
+}
+
+func (x *SetFxRateRequest) GetToCurrency() string {
+	if x != nil {
+		return x.ToCurrency
+	}
+	return ""
+}
+
+func (x *SetFxRateRequest) GetRate() string {
+	if x != nil {
+		return x.Rate
+	}
+	return ""
+}
+
+type SetFxRateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rate          *FxRate                `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFxRateResponse) Reset() {
+	*x = SetFxRateResponse{}
+	mi := &file_rpc_set_fx_rate_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFxRateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFxRateResponse) ProtoMessage() {}
+
+func (x *SetFxRateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_set_fx_rate_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetFxRateResponse.ProtoReflect.Descriptor instead.
+func (*SetFxRateResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_set_fx_rate_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetFxRateResponse) GetRate() *FxRate {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+var File_rpc_set_fx_rate_proto protoreflect.FileDescriptor
+
+const file_rpc_set_fx_rate_proto_rawDesc = "" +
+	"\n" +
+	"\x15rpc_set_fx_rate.proto\x12\x02pb\x1a\bfx.proto\"l\n" +
+	"\x10SetFxRateRequest\x12#\n" +
+	"\rfrom_currency\x18\x01 \x01(\tR\ffromCurrency\x12\x1f\n" +
+	"\vto_currency\x18\x02 \x01(\tR\n" +
+	"toCurrency\x12\x12\n" +
+	"\x04rate\x18\x03 \x01(\tR\x04rate\"3\n" +
+	"\x11SetFxRateResponse\x12\x1e\n" +
+	"\x04rate\x18\x01 \x01(\v2\n" +
+	".pb.FxRateR\x04rateB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_set_fx_rate_proto_rawDescOnce sync.Once
+	file_rpc_set_fx_rate_proto_rawDescData []byte
+)
+
+func file_rpc_set_fx_rate_proto_rawDescGZIP() []byte {
+	file_rpc_set_fx_rate_proto_rawDescOnce.Do(func() {
+		file_rpc_set_fx_rate_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_set_fx_rate_proto_rawDesc), len(file_rpc_set_fx_rate_proto_rawDesc)))
+	})
+	return file_rpc_set_fx_rate_proto_rawDescData
+}
+
+var file_rpc_set_fx_rate_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_set_fx_rate_proto_goTypes = []any{
+	(*SetFxRateRequest)(nil),  // 0: pb.SetFxRateRequest
+	(*SetFxRateResponse)(nil), // 1: pb.SetFxRateResponse
+	(*FxRate)(nil),            // 2: pb.FxRate
+}
+var file_rpc_set_fx_rate_proto_depIdxs = []int32{
+	2, // 0: pb.SetFxRateResponse.rate:type_name -> pb.FxRate
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_rpc_set_fx_rate_proto_init() }
+func file_rpc_set_fx_rate_proto_init() {
+	if File_rpc_set_fx_rate_proto != nil {
+		return
+	}
+	file_fx_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_set_fx_rate_proto_rawDesc), len(file_rpc_set_fx_rate_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_set_fx_rate_proto_goTypes,
+		DependencyIndexes: file_rpc_set_fx_rate_proto_depIdxs,
+		MessageInfos:      file_rpc_set_fx_rate_proto_msgTypes,
+	}.Build()
+	File_rpc_set_fx_rate_proto = out.File
+	file_rpc_set_fx_rate_proto_goTypes = nil
+	file_rpc_set_fx_rate_proto_depIdxs = nil
+}
@@ -24,13 +24,19 @@ const (
 )
 
 type TransferMoneyRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	FromAccountId int64                  `protobuf:"varint,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
-	ToAccountId   int64                  `protobuf:"varint,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromAccountId  int64                  `protobuf:"varint,1,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId    int64                  `protobuf:"varint,2,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount         int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency       string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	IdempotencyKey *string                `protobuf:"bytes,5,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"` // Falls back to the Idempotency-Key header
+	FxQuoteId      *string                `protobuf:"bytes,6,opt,name=fx_quote_id,json=fxQuoteId,proto3,oneof" json:"fx_quote_id,omitempty"`              // Required when the accounts have different currencies; currency is then the from account's
+	Memo           string                 `protobuf:"bytes,7,opt,name=memo,proto3" json:"memo,omitempty"`                                                 // Optional free text shown to both sides
+	Reference      string                 `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"`                                       // Optional external reference such as an invoice number
+	Category       string                 `protobuf:"bytes,9,opt,name=category,proto3" json:"category,omitempty"`                                         // Optional category of the payer, only kept on the from entry
+	PayeeId        *int64                 `protobuf:"varint,10,opt,name=payee_id,json=payeeId,proto3,oneof" json:"payee_id,omitempty"`                    // Pays a saved payee, to_account_id must then be left out
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *TransferMoneyRequest) Reset() {
@@ -91,6 +97,48 @@ func (x *TransferMoneyRequest) GetCurrency() string {
 	return ""
 }
 
+func (x *TransferMoneyRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *TransferMoneyRequest) GetFxQuoteId() string {
+	if x != nil && x.FxQuoteId != nil {
+		return *x.FxQuoteId
+	}
+	return ""
+}
+
+func (x *TransferMoneyRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *TransferMoneyRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *TransferMoneyRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
+func (x *TransferMoneyRequest) GetPayeeId() int64 {
+	if x != nil && x.PayeeId != nil {
+		return *x.PayeeId
+	}
+	return 0
+}
+
 type TransferMoneyResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
@@ -98,6 +146,10 @@ type TransferMoneyResponse struct {
 	ToAccount     *Account               `protobuf:"bytes,3,opt,name=to_account,json=toAccount,proto3" json:"to_account,omitempty"`
 	FromEntry     *Entry                 `protobuf:"bytes,4,opt,name=from_entry,json=fromEntry,proto3" json:"from_entry,omitempty"`
 	ToEntry       *Entry                 `protobuf:"bytes,5,opt,name=to_entry,json=toEntry,proto3" json:"to_entry,omitempty"`
+	Fee           *FeeQuote              `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeEntry      *Entry                 `protobuf:"bytes,7,opt,name=fee_entry,json=feeEntry,proto3" json:"fee_entry,omitempty"`                // Debit of the fee, unset when nothing was charged
+	Review        *RiskDecision          `protobuf:"bytes,8,opt,name=review,proto3" json:"review,omitempty"`                                    // Set alone when the transfer is held for review, it is made once approved
+	RecipientName string                 `protobuf:"bytes,9,opt,name=recipient_name,json=recipientName,proto3" json:"recipient_name,omitempty"` // Masked full name of the to account's owner, GetPayee and ListPayees show it before paying
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -167,15 +219,51 @@ func (x *TransferMoneyResponse) GetToEntry() *Entry {
 	return nil
 }
 
+func (x *TransferMoneyResponse) GetFee() *FeeQuote {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *TransferMoneyResponse) GetFeeEntry() *Entry {
+	if x != nil {
+		return x.FeeEntry
+	}
+	return nil
+}
+
+func (x *TransferMoneyResponse) GetReview() *RiskDecision {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+func (x *TransferMoneyResponse) GetRecipientName() string {
+	if x != nil {
+		return x.RecipientName
+	}
+	return ""
+}
+
 type Transfer struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	FromAccountId int64                  `protobuf:"varint,2,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
-	ToAccountId   int64                  `protobuf:"varint,3,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromAccountId      int64                  `protobuf:"varint,2,opt,name=from_account_id,json=fromAccountId,proto3" json:"from_account_id,omitempty"`
+	ToAccountId        int64                  `protobuf:"varint,3,opt,name=to_account_id,json=toAccountId,proto3" json:"to_account_id,omitempty"`
+	Amount             int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	CreatedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ToAmount           int64                  `protobuf:"varint,6,opt,name=to_amount,json=toAmount,proto3" json:"to_amount,omitempty"` // Amount credited to the to account, differs from amount only for fx transfers
+	FxRate             string                 `protobuf:"bytes,7,opt,name=fx_rate,json=fxRate,proto3" json:"fx_rate,omitempty"`
+	FxQuoteId          string                 `protobuf:"bytes,8,opt,name=fx_quote_id,json=fxQuoteId,proto3" json:"fx_quote_id,omitempty"`
+	ReversedTransferId int64                  `protobuf:"varint,9,opt,name=reversed_transfer_id,json=reversedTransferId,proto3" json:"reversed_transfer_id,omitempty"` // Set on refunds and reversals to the transfer they send back
+	RefundedAmount     int64                  `protobuf:"varint,10,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`              // Part of to_amount sent back by refunds and reversals so far
+	Memo               string                 `protobuf:"bytes,11,opt,name=memo,proto3" json:"memo,omitempty"`
+	Reference          string                 `protobuf:"bytes,12,opt,name=reference,proto3" json:"reference,omitempty"`
+	Category           string                 `protobuf:"bytes,13,opt,name=category,proto3" json:"category,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Transfer) Reset() {
@@ -243,12 +331,71 @@ func (x *Transfer) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Transfer) GetToAmount() int64 {
+	if x != nil {
+		return x.ToAmount
+	}
+	return 0
+}
+
+func (x *Transfer) GetFxRate() string {
+	if x != nil {
+		return x.FxRate
+	}
+	return ""
+}
+
+func (x *Transfer) GetFxQuoteId() string {
+	if x != nil {
+		return x.FxQuoteId
+	}
+	return ""
+}
+
+func (x *Transfer) GetReversedTransferId() int64 {
+	if x != nil {
+		return x.ReversedTransferId
+	}
+	return 0
+}
+
+func (x *Transfer) GetRefundedAmount() int64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
+}
+
+func (x *Transfer) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Transfer) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Transfer) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type Entry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	AccountId     int64                  `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
 	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Memo          string                 `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	Reference     string                 `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Category      string                 `protobuf:"bytes,7,opt,name=category,proto3" json:"category,omitempty"` // Transfers only set it on the from entry
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -311,16 +458,48 @@ func (x *Entry) GetCreatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *Entry) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Entry) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *Entry) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 var File_rpc_transfer_money_proto protoreflect.FileDescriptor
 
 const file_rpc_transfer_money_proto_rawDesc = "" +
 	"\n" +
-	"\x18rpc_transfer_money.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\raccount.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x96\x01\n" +
+	"\x18rpc_transfer_money.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\raccount.proto\x1a\tfee.proto\x1a\n" +
+	"risk.proto\x1a.protoc-gen-openapiv2/options/annotations.proto\"\x88\x03\n" +
 	"\x14TransferMoneyRequest\x12&\n" +
 	"\x0ffrom_account_id\x18\x01 \x01(\x03R\rfromAccountId\x12\"\n" +
 	"\rto_account_id\x18\x02 \x01(\x03R\vtoAccountId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
-	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"\xed\x01\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12,\n" +
+	"\x0fidempotency_key\x18\x05 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01\x12#\n" +
+	"\vfx_quote_id\x18\x06 \x01(\tH\x01R\tfxQuoteId\x88\x01\x01\x12\x12\n" +
+	"\x04memo\x18\a \x01(\tR\x04memo\x12\x1c\n" +
+	"\treference\x18\b \x01(\tR\treference\x12\x1a\n" +
+	"\bcategory\x18\t \x01(\tR\bcategory\x12\x1e\n" +
+	"\bpayee_id\x18\n" +
+	" \x01(\x03H\x02R\apayeeId\x88\x01\x01B\x12\n" +
+	"\x10_idempotency_keyB\x0e\n" +
+	"\f_fx_quote_idB\v\n" +
+	"\t_payee_id\"\x86\x03\n" +
 	"\x15TransferMoneyResponse\x12(\n" +
 	"\btransfer\x18\x01 \x01(\v2\f.pb.TransferR\btransfer\x12.\n" +
 	"\ffrom_account\x18\x02 \x01(\v2\v.pb.AccountR\vfromAccount\x12*\n" +
@@ -328,21 +507,37 @@ const file_rpc_transfer_money_proto_rawDesc = "" +
 	"to_account\x18\x03 \x01(\v2\v.pb.AccountR\ttoAccount\x12(\n" +
 	"\n" +
 	"from_entry\x18\x04 \x01(\v2\t.pb.EntryR\tfromEntry\x12$\n" +
-	"\bto_entry\x18\x05 \x01(\v2\t.pb.EntryR\atoEntry\"\xb9\x01\n" +
+	"\bto_entry\x18\x05 \x01(\v2\t.pb.EntryR\atoEntry\x12\x1e\n" +
+	"\x03fee\x18\x06 \x01(\v2\f.pb.FeeQuoteR\x03fee\x12&\n" +
+	"\tfee_entry\x18\a \x01(\v2\t.pb.EntryR\bfeeEntry\x12(\n" +
+	"\x06review\x18\b \x01(\v2\x10.pb.RiskDecisionR\x06review\x12%\n" +
+	"\x0erecipient_name\x18\t \x01(\tR\rrecipientName\"\xb8\x03\n" +
 	"\bTransfer\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12&\n" +
 	"\x0ffrom_account_id\x18\x02 \x01(\x03R\rfromAccountId\x12\"\n" +
 	"\rto_account_id\x18\x03 \x01(\x03R\vtoAccountId\x12\x16\n" +
 	"\x06amount\x18\x04 \x01(\x03R\x06amount\x129\n" +
 	"\n" +
-	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x89\x01\n" +
+	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tto_amount\x18\x06 \x01(\x03R\btoAmount\x12\x17\n" +
+	"\afx_rate\x18\a \x01(\tR\x06fxRate\x12\x1e\n" +
+	"\vfx_quote_id\x18\b \x01(\tR\tfxQuoteId\x120\n" +
+	"\x14reversed_transfer_id\x18\t \x01(\x03R\x12reversedTransferId\x12'\n" +
+	"\x0frefunded_amount\x18\n" +
+	" \x01(\x03R\x0erefundedAmount\x12\x12\n" +
+	"\x04memo\x18\v \x01(\tR\x04memo\x12\x1c\n" +
+	"\treference\x18\f \x01(\tR\treference\x12\x1a\n" +
+	"\bcategory\x18\r \x01(\tR\bcategory\"\xd7\x01\n" +
 	"\x05Entry\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x02 \x01(\x03R\taccountId\x12\x16\n" +
 	"\x06amount\x18\x03 \x01(\x03R\x06amount\x129\n" +
 	"\n" +
-	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x12\n" +
+	"\x04memo\x18\x05 \x01(\tR\x04memo\x12\x1c\n" +
+	"\treference\x18\x06 \x01(\tR\treference\x12\x1a\n" +
+	"\bcategory\x18\a \x01(\tR\bcategoryB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
 
 var (
 	file_rpc_transfer_money_proto_rawDescOnce sync.Once
@@ -363,21 +558,26 @@ var file_rpc_transfer_money_proto_goTypes = []any{
 	(*Transfer)(nil),              // 2: pb.Transfer
 	(*Entry)(nil),                 // 3: pb.Entry
 	(*Account)(nil),               // 4: pb.Account
-	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+	(*FeeQuote)(nil),              // 5: pb.FeeQuote
+	(*RiskDecision)(nil),          // 6: pb.RiskDecision
+	(*timestamppb.Timestamp)(nil), // 7: google.protobuf.Timestamp
 }
 var file_rpc_transfer_money_proto_depIdxs = []int32{
-	2, // 0: pb.TransferMoneyResponse.transfer:type_name -> pb.Transfer
-	4, // 1: pb.TransferMoneyResponse.from_account:type_name -> pb.Account
-	4, // 2: pb.TransferMoneyResponse.to_account:type_name -> pb.Account
-	3, // 3: pb.TransferMoneyResponse.from_entry:type_name -> pb.Entry
-	3, // 4: pb.TransferMoneyResponse.to_entry:type_name -> pb.Entry
-	5, // 5: pb.Transfer.created_at:type_name -> google.protobuf.Timestamp
-	5, // 6: pb.Entry.created_at:type_name -> google.protobuf.Timestamp
-	7, // [7:7] is the sub-list for method output_type
-	7, // [7:7] is the sub-list for method input_type
-	7, // [7:7] is the sub-list for extension type_name
-	7, // [7:7] is the sub-list for extension extendee
-	0, // [0:7] is the sub-list for field type_name
+	2,  // 0: pb.TransferMoneyResponse.transfer:type_name -> pb.Transfer
+	4,  // 1: pb.TransferMoneyResponse.from_account:type_name -> pb.Account
+	4,  // 2: pb.TransferMoneyResponse.to_account:type_name -> pb.Account
+	3,  // 3: pb.TransferMoneyResponse.from_entry:type_name -> pb.Entry
+	3,  // 4: pb.TransferMoneyResponse.to_entry:type_name -> pb.Entry
+	5,  // 5: pb.TransferMoneyResponse.fee:type_name -> pb.FeeQuote
+	3,  // 6: pb.TransferMoneyResponse.fee_entry:type_name -> pb.Entry
+	6,  // 7: pb.TransferMoneyResponse.review:type_name -> pb.RiskDecision
+	7,  // 8: pb.Transfer.created_at:type_name -> google.protobuf.Timestamp
+	7,  // 9: pb.Entry.created_at:type_name -> google.protobuf.Timestamp
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_rpc_transfer_money_proto_init() }
@@ -386,6 +586,9 @@ func file_rpc_transfer_money_proto_init() {
 		return
 	}
 	file_account_proto_init()
+	file_fee_proto_init()
+	file_risk_proto_init()
+	file_rpc_transfer_money_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
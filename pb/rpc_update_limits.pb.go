@@ -0,0 +1,195 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: rpc_update_limits.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type UpdateLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     *int64                 `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3,oneof" json:"account_id,omitempty"` // Limits of one account, otherwise of all your accounts in the currency
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`                           // Required without account_id
+	Limits        *SpendingLimits        `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLimitsRequest) Reset() {
+	*x = UpdateLimitsRequest{}
+	mi := &file_rpc_update_limits_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLimitsRequest) ProtoMessage() {}
+
+func (x *UpdateLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_limits_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLimitsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_update_limits_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *UpdateLimitsRequest) GetAccountId() int64 {
+	if x != nil && x.AccountId != nil {
+		return *x.AccountId
+	}
+	return 0
+}
+
+func (x *UpdateLimitsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *UpdateLimitsRequest) GetLimits() *SpendingLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+type UpdateLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         *SpendingLimit         `protobuf:"bytes,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLimitsResponse) Reset() {
+	*x = UpdateLimitsResponse{}
+	mi := &file_rpc_update_limits_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLimitsResponse) ProtoMessage() {}
+
+func (x *UpdateLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_update_limits_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLimitsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_update_limits_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *UpdateLimitsResponse) GetLimit() *SpendingLimit {
+	if x != nil {
+		return x.Limit
+	}
+	return nil
+}
+
+var File_rpc_update_limits_proto protoreflect.FileDescriptor
+
+const file_rpc_update_limits_proto_rawDesc = "" +
+	"\n" +
+	"\x17rpc_update_limits.proto\x12\x02pb\x1a\x14spending_limit.proto\"\x90\x01\n" +
+	"\x13UpdateLimitsRequest\x12\"\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03H\x00R\taccountId\x88\x01\x01\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12*\n" +
+	"\x06limits\x18\x03 \x01(\v2\x12.pb.SpendingLimitsR\x06limitsB\r\n" +
+	"\v_account_id\"?\n" +
+	"\x14UpdateLimitsResponse\x12'\n" +
+	"\x05limit\x18\x01 \x01(\v2\x11.pb.SpendingLimitR\x05limitB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_rpc_update_limits_proto_rawDescOnce sync.Once
+	file_rpc_update_limits_proto_rawDescData []byte
+)
+
+func file_rpc_update_limits_proto_rawDescGZIP() []byte {
+	file_rpc_update_limits_proto_rawDescOnce.Do(func() {
+		file_rpc_update_limits_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_rpc_update_limits_proto_rawDesc), len(file_rpc_update_limits_proto_rawDesc)))
+	})
+	return file_rpc_update_limits_proto_rawDescData
+}
+
+var file_rpc_update_limits_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_rpc_update_limits_proto_goTypes = []any{
+	(*UpdateLimitsRequest)(nil),  // 0: pb.UpdateLimitsRequest
+	(*UpdateLimitsResponse)(nil), // 1: pb.UpdateLimitsResponse
+	(*SpendingLimits)(nil),       // 2: pb.SpendingLimits
+	(*SpendingLimit)(nil),        // 3: pb.SpendingLimit
+}
+var file_rpc_update_limits_proto_depIdxs = []int32{
+	2, // 0: pb.UpdateLimitsRequest.limits:type_name -> pb.SpendingLimits
+	3, // 1: pb.UpdateLimitsResponse.limit:type_name -> pb.SpendingLimit
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_rpc_update_limits_proto_init() }
+func file_rpc_update_limits_proto_init() {
+	if File_rpc_update_limits_proto != nil {
+		return
+	}
+	file_spending_limit_proto_init()
+	file_rpc_update_limits_proto_msgTypes[0].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_rpc_update_limits_proto_rawDesc), len(file_rpc_update_limits_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rpc_update_limits_proto_goTypes,
+		DependencyIndexes: file_rpc_update_limits_proto_depIdxs,
+		MessageInfos:      file_rpc_update_limits_proto_msgTypes,
+	}.Build()
+	File_rpc_update_limits_proto = out.File
+	file_rpc_update_limits_proto_goTypes = nil
+	file_rpc_update_limits_proto_depIdxs = nil
+}
@@ -23,11 +23,15 @@ const (
 )
 
 type WithdrawRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	AccountId     int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"` // Amount in cents/smallest currency unit
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	AccountId      int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount         int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                                            // Amount in cents/smallest currency unit
+	IdempotencyKey *string                `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3,oneof" json:"idempotency_key,omitempty"` // Falls back to the Idempotency-Key header
+	Memo           string                 `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`                                                 // Optional free text shown on statements
+	Reference      string                 `protobuf:"bytes,5,opt,name=reference,proto3" json:"reference,omitempty"`                                       // Optional external reference
+	Category       string                 `protobuf:"bytes,6,opt,name=category,proto3" json:"category,omitempty"`                                         // Optional
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *WithdrawRequest) Reset() {
@@ -74,6 +78,34 @@ func (x *WithdrawRequest) GetAmount() int64 {
 	return 0
 }
 
+func (x *WithdrawRequest) GetIdempotencyKey() string {
+	if x != nil && x.IdempotencyKey != nil {
+		return *x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *WithdrawRequest) GetCategory() string {
+	if x != nil {
+		return x.Category
+	}
+	return ""
+}
+
 type WithdrawResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -82,6 +114,8 @@ type WithdrawResponse struct {
 	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
 	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Fee           *FeeQuote              `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
+	Review        *RiskDecision          `protobuf:"bytes,8,opt,name=review,proto3" json:"review,omitempty"` // Set alone when the withdrawal is held for review, it is made once approved
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -158,15 +192,35 @@ func (x *WithdrawResponse) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *WithdrawResponse) GetFee() *FeeQuote {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *WithdrawResponse) GetReview() *RiskDecision {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
 var File_rpc_withdraw_proto protoreflect.FileDescriptor
 
 const file_rpc_withdraw_proto_rawDesc = "" +
 	"\n" +
-	"\x12rpc_withdraw.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"H\n" +
+	"\x12rpc_withdraw.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\tfee.proto\x1a\n" +
+	"risk.proto\"\xd8\x01\n" +
 	"\x0fWithdrawRequest\x12\x1d\n" +
 	"\n" +
 	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x03R\x06amount\"\xe4\x01\n" +
+	"\x06amount\x18\x02 \x01(\x03R\x06amount\x12,\n" +
+	"\x0fidempotency_key\x18\x03 \x01(\tH\x00R\x0eidempotencyKey\x88\x01\x01\x12\x12\n" +
+	"\x04memo\x18\x04 \x01(\tR\x04memo\x12\x1c\n" +
+	"\treference\x18\x05 \x01(\tR\treference\x12\x1a\n" +
+	"\bcategory\x18\x06 \x01(\tR\bcategoryB\x12\n" +
+	"\x10_idempotency_key\"\xae\x02\n" +
 	"\x10WithdrawResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05owner\x18\x02 \x01(\tR\x05owner\x12\x18\n" +
@@ -175,7 +229,9 @@ const file_rpc_withdraw_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1e\n" +
+	"\x03fee\x18\a \x01(\v2\f.pb.FeeQuoteR\x03fee\x12(\n" +
+	"\x06review\x18\b \x01(\v2\x10.pb.RiskDecisionR\x06reviewB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
 
 var (
 	file_rpc_withdraw_proto_rawDescOnce sync.Once
@@ -194,15 +250,19 @@ var file_rpc_withdraw_proto_goTypes = []any{
 	(*WithdrawRequest)(nil),       // 0: pb.WithdrawRequest
 	(*WithdrawResponse)(nil),      // 1: pb.WithdrawResponse
 	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+	(*FeeQuote)(nil),              // 3: pb.FeeQuote
+	(*RiskDecision)(nil),          // 4: pb.RiskDecision
 }
 var file_rpc_withdraw_proto_depIdxs = []int32{
 	2, // 0: pb.WithdrawResponse.created_at:type_name -> google.protobuf.Timestamp
 	2, // 1: pb.WithdrawResponse.updated_at:type_name -> google.protobuf.Timestamp
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	3, // 2: pb.WithdrawResponse.fee:type_name -> pb.FeeQuote
+	4, // 3: pb.WithdrawResponse.review:type_name -> pb.RiskDecision
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_rpc_withdraw_proto_init() }
@@ -210,6 +270,9 @@ func file_rpc_withdraw_proto_init() {
 	if File_rpc_withdraw_proto != nil {
 		return
 	}
+	file_fee_proto_init()
+	file_risk_proto_init()
+	file_rpc_withdraw_proto_msgTypes[0].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
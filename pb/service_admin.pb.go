@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: service_admin.proto
+
+package pb
+
+import (
+	_ "github.com/grpc-ecosystem/grpc-gateway/v2/protoc-gen-openapiv2/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+var File_service_admin_proto protoreflect.FileDescriptor
+
+const file_service_admin_proto_rawDesc = "" +
+	"\n" +
+	"\x13service_admin.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a\x16rpc_search_users.proto\x1a\x15rpc_get_account.proto\x1a\x1erpc_list_account_entries.proto\x1a!rpc_freeze_customer_account.proto\x1a!rpc_reopen_customer_account.proto\x1a\x1drpc_block_user_sessions.proto\x1a\x15rpc_set_fx_rate.proto\x1a\x1brpc_get_trial_balance.proto\x1a\x1arpc_reverse_transfer.proto\x1a\x1arpc_set_fee_schedule.proto\x1a\x1brpc_list_risk_reviews.proto\x1a\x1drpc_approve_risk_review.proto\x1a\x1crpc_reject_risk_review.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\xe8\x16\n" +
+	"\fAdminService\x12\xa4\x01\n" +
+	"\vSearchUsers\x12\x16.pb.SearchUsersRequest\x1a\x17.pb.SearchUsersResponse\"d\x92AJ\x12\fSearch users\x1a:Use this API to find users by username, email or full name\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/admin/users\x12\xb6\x01\n" +
+	"\x12GetCustomerAccount\x12\x15.pb.GetAccountRequest\x1a\x16.pb.GetAccountResponse\"q\x92AO\x12\x14Get customer account\x1a7Use this API to get any account regardless of its owner\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/admin/accounts/{id}\x12\xe8\x01\n" +
+	"\x1aListCustomerAccountEntries\x12\x1d.pb.ListAccountEntriesRequest\x1a\x1e.pb.ListAccountEntriesResponse\"\x8a\x01\x92AX\x12\x1dList customer account entries\x1a7Use this API to list the balance changes of any account\x82\xd3\xe4\x93\x02)\x12'/v1/admin/accounts/{account_id}/entries\x12\xce\x01\n" +
+	"\x15FreezeCustomerAccount\x12 .pb.FreezeCustomerAccountRequest\x1a!.pb.FreezeCustomerAccountResponse\"p\x92A<\x12\x17Freeze customer account\x1a!Use this API to freeze an account\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/admin/accounts/{account_id}/freeze\x12\xe5\x01\n" +
+	"\x17UnfreezeCustomerAccount\x12\".pb.UnfreezeCustomerAccountRequest\x1a#.pb.UnfreezeCustomerAccountResponse\"\x80\x01\x92AJ\x12\x19Unfreeze customer account\x1a-Use this API to lift the freeze of an account\x82\xd3\xe4\x93\x02-:\x01*\"(/v1/admin/accounts/{account_id}/unfreeze\x12\xd4\x01\n" +
+	"\x15ReopenCustomerAccount\x12 .pb.ReopenCustomerAccountRequest\x1a!.pb.ReopenCustomerAccountResponse\"v\x92AB\x12\x17Reopen customer account\x1a'Use this API to reopen a closed account\x82\xd3\xe4\x93\x02+:\x01*\"&/v1/admin/accounts/{account_id}/reopen\x12\xd4\x01\n" +
+	"\x11BlockUserSessions\x12\x1c.pb.BlockUserSessionsRequest\x1a\x1d.pb.BlockUserSessionsResponse\"\x81\x01\x92AJ\x12\x13Block user sessions\x1a3Use this API to block one or all sessions of a user\x82\xd3\xe4\x93\x02.:\x01*\")/v1/admin/users/{username}/block_sessions\x12\xa5\x01\n" +
+	"\tSetFxRate\x12\x14.pb.SetFxRateRequest\x1a\x15.pb.SetFxRateResponse\"k\x92AK\x12\vSet fx rate\x1a<Use this API to set the exchange rate between two currencies\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/admin/fx_rates\x12\xd7\x01\n" +
+	"\x0fGetTrialBalance\x12\x1a.pb.GetTrialBalanceRequest\x1a\x1b.pb.GetTrialBalanceResponse\"\x8a\x01\x92Ah\x12\x11Get trial balance\x1aSUse this API to check that the postings of the ledger sum to zero in every currency\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/admin/trial_balance\x12\xe2\x01\n" +
+	"\x0fReverseTransfer\x12\x1a.pb.ReverseTransferRequest\x1a\x1b.pb.ReverseTransferResponse\"\x95\x01\x92A^\x12\x10Reverse transfer\x1aJUse this API to send all or part of a mistaken transfer back to its sender\x82\xd3\xe4\x93\x02.:\x01*\")/v1/admin/transfers/{transfer_id}/reverse\x12\xbc\x01\n" +
+	"\x0eSetFeeSchedule\x12\x19.pb.SetFeeScheduleRequest\x1a\x1a.pb.SetFeeScheduleResponse\"s\x92AN\x12\x10Set fee schedule\x1a:Use this API to set the fees of an operation in a currency\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/admin/fee_schedules\x12\xcd\x01\n" +
+	"\x0fListRiskReviews\x12\x1a.pb.ListRiskReviewsRequest\x1a\x1b.pb.ListRiskReviewsResponse\"\x80\x01\x92A_\x12\x11List risk reviews\x1aJUse this API to list the money movements held for review by the risk rules\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/admin/risk_reviews\x12\xcf\x01\n" +
+	"\x11ApproveRiskReview\x12\x1c.pb.ApproveRiskReviewRequest\x1a\x1d.pb.ApproveRiskReviewResponse\"}\x92AL\x12\x13Approve risk review\x1a5Use this API to make a money movement held for review\x82\xd3\xe4\x93\x02(:\x01*\"#/v1/admin/risk_reviews/{id}/approve\x12\xdd\x01\n" +
+	"\x10RejectRiskReview\x12\x1b.pb.RejectRiskReviewRequest\x1a\x1c.pb.RejectRiskReviewResponse\"\x8d\x01\x92A]\x12\x12Reject risk review\x1aGUse this API to drop a money movement held for review without making it\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/admin/risk_reviews/{id}/rejectB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var file_service_admin_proto_goTypes = []any{
+	(*SearchUsersRequest)(nil),              // 0: pb.SearchUsersRequest
+	(*GetAccountRequest)(nil),               // 1: pb.GetAccountRequest
+	(*ListAccountEntriesRequest)(nil),       // 2: pb.ListAccountEntriesRequest
+	(*FreezeCustomerAccountRequest)(nil),    // 3: pb.FreezeCustomerAccountRequest
+	(*UnfreezeCustomerAccountRequest)(nil),  // 4: pb.UnfreezeCustomerAccountRequest
+	(*ReopenCustomerAccountRequest)(nil),    // 5: pb.ReopenCustomerAccountRequest
+	(*BlockUserSessionsRequest)(nil),        // 6: pb.BlockUserSessionsRequest
+	(*SetFxRateRequest)(nil),                // 7: pb.SetFxRateRequest
+	(*GetTrialBalanceRequest)(nil),          // 8: pb.GetTrialBalanceRequest
+	(*ReverseTransferRequest)(nil),          // 9: pb.ReverseTransferRequest
+	(*SetFeeScheduleRequest)(nil),           // 10: pb.SetFeeScheduleRequest
+	(*ListRiskReviewsRequest)(nil),          // 11: pb.ListRiskReviewsRequest
+	(*ApproveRiskReviewRequest)(nil),        // 12: pb.ApproveRiskReviewRequest
+	(*RejectRiskReviewRequest)(nil),         // 13: pb.RejectRiskReviewRequest
+	(*SearchUsersResponse)(nil),             // 14: pb.SearchUsersResponse
+	(*GetAccountResponse)(nil),              // 15: pb.GetAccountResponse
+	(*ListAccountEntriesResponse)(nil),      // 16: pb.ListAccountEntriesResponse
+	(*FreezeCustomerAccountResponse)(nil),   // 17: pb.FreezeCustomerAccountResponse
+	(*UnfreezeCustomerAccountResponse)(nil), // 18: pb.UnfreezeCustomerAccountResponse
+	(*ReopenCustomerAccountResponse)(nil),   // 19: pb.ReopenCustomerAccountResponse
+	(*BlockUserSessionsResponse)(nil),       // 20: pb.BlockUserSessionsResponse
+	(*SetFxRateResponse)(nil),               // 21: pb.SetFxRateResponse
+	(*GetTrialBalanceResponse)(nil),         // 22: pb.GetTrialBalanceResponse
+	(*ReverseTransferResponse)(nil),         // 23: pb.ReverseTransferResponse
+	(*SetFeeScheduleResponse)(nil),          // 24: pb.SetFeeScheduleResponse
+	(*ListRiskReviewsResponse)(nil),         // 25: pb.ListRiskReviewsResponse
+	(*ApproveRiskReviewResponse)(nil),       // 26: pb.ApproveRiskReviewResponse
+	(*RejectRiskReviewResponse)(nil),        // 27: pb.RejectRiskReviewResponse
+}
+var file_service_admin_proto_depIdxs = []int32{
+	0,  // 0: pb.AdminService.SearchUsers:input_type -> pb.SearchUsersRequest
+	1,  // 1: pb.AdminService.GetCustomerAccount:input_type -> pb.GetAccountRequest
+	2,  // 2: pb.AdminService.ListCustomerAccountEntries:input_type -> pb.ListAccountEntriesRequest
+	3,  // 3: pb.AdminService.FreezeCustomerAccount:input_type -> pb.FreezeCustomerAccountRequest
+	4,  // 4: pb.AdminService.UnfreezeCustomerAccount:input_type -> pb.UnfreezeCustomerAccountRequest
+	5,  // 5: pb.AdminService.ReopenCustomerAccount:input_type -> pb.ReopenCustomerAccountRequest
+	6,  // 6: pb.AdminService.BlockUserSessions:input_type -> pb.BlockUserSessionsRequest
+	7,  // 7: pb.AdminService.SetFxRate:input_type -> pb.SetFxRateRequest
+	8,  // 8: pb.AdminService.GetTrialBalance:input_type -> pb.GetTrialBalanceRequest
+	9,  // 9: pb.AdminService.ReverseTransfer:input_type -> pb.ReverseTransferRequest
+	10, // 10: pb.AdminService.SetFeeSchedule:input_type -> pb.SetFeeScheduleRequest
+	11, // 11: pb.AdminService.ListRiskReviews:input_type -> pb.ListRiskReviewsRequest
+	12, // 12: pb.AdminService.ApproveRiskReview:input_type -> pb.ApproveRiskReviewRequest
+	13, // 13: pb.AdminService.RejectRiskReview:input_type -> pb.RejectRiskReviewRequest
+	14, // 14: pb.AdminService.SearchUsers:output_type -> pb.SearchUsersResponse
+	15, // 15: pb.AdminService.GetCustomerAccount:output_type -> pb.GetAccountResponse
+	16, // 16: pb.AdminService.ListCustomerAccountEntries:output_type -> pb.ListAccountEntriesResponse
+	17, // 17: pb.AdminService.FreezeCustomerAccount:output_type -> pb.FreezeCustomerAccountResponse
+	18, // 18: pb.AdminService.UnfreezeCustomerAccount:output_type -> pb.UnfreezeCustomerAccountResponse
+	19, // 19: pb.AdminService.ReopenCustomerAccount:output_type -> pb.ReopenCustomerAccountResponse
+	20, // 20: pb.AdminService.BlockUserSessions:output_type -> pb.BlockUserSessionsResponse
+	21, // 21: pb.AdminService.SetFxRate:output_type -> pb.SetFxRateResponse
+	22, // 22: pb.AdminService.GetTrialBalance:output_type -> pb.GetTrialBalanceResponse
+	23, // 23: pb.AdminService.ReverseTransfer:output_type -> pb.ReverseTransferResponse
+	24, // 24: pb.AdminService.SetFeeSchedule:output_type -> pb.SetFeeScheduleResponse
+	25, // 25: pb.AdminService.ListRiskReviews:output_type -> pb.ListRiskReviewsResponse
+	26, // 26: pb.AdminService.ApproveRiskReview:output_type -> pb.ApproveRiskReviewResponse
+	27, // 27: pb.AdminService.RejectRiskReview:output_type -> pb.RejectRiskReviewResponse
+	14, // [14:28] is the sub-list for method output_type
+	0,  // [0:14] is the sub-list for method input_type
+	0,  // [0:0] is the sub-list for extension type_name
+	0,  // [0:0] is the sub-list for extension extendee
+	0,  // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_service_admin_proto_init() }
+func file_service_admin_proto_init() {
+	if File_service_admin_proto != nil {
+		return
+	}
+	file_rpc_search_users_proto_init()
+	file_rpc_get_account_proto_init()
+	file_rpc_list_account_entries_proto_init()
+	file_rpc_freeze_customer_account_proto_init()
+	file_rpc_reopen_customer_account_proto_init()
+	file_rpc_block_user_sessions_proto_init()
+	file_rpc_set_fx_rate_proto_init()
+	file_rpc_get_trial_balance_proto_init()
+	file_rpc_reverse_transfer_proto_init()
+	file_rpc_set_fee_schedule_proto_init()
+	file_rpc_list_risk_reviews_proto_init()
+	file_rpc_approve_risk_review_proto_init()
+	file_rpc_reject_risk_review_proto_init()
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_service_admin_proto_rawDesc), len(file_service_admin_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   0,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_service_admin_proto_goTypes,
+		DependencyIndexes: file_service_admin_proto_depIdxs,
+	}.Build()
+	File_service_admin_proto = out.File
+	file_service_admin_proto_goTypes = nil
+	file_service_admin_proto_depIdxs = nil
+}
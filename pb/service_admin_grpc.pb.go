@@ -0,0 +1,621 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: service_admin.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	AdminService_SearchUsers_FullMethodName                = "/pb.AdminService/SearchUsers"
+	AdminService_GetCustomerAccount_FullMethodName         = "/pb.AdminService/GetCustomerAccount"
+	AdminService_ListCustomerAccountEntries_FullMethodName = "/pb.AdminService/ListCustomerAccountEntries"
+	AdminService_FreezeCustomerAccount_FullMethodName      = "/pb.AdminService/FreezeCustomerAccount"
+	AdminService_UnfreezeCustomerAccount_FullMethodName    = "/pb.AdminService/UnfreezeCustomerAccount"
+	AdminService_ReopenCustomerAccount_FullMethodName      = "/pb.AdminService/ReopenCustomerAccount"
+	AdminService_BlockUserSessions_FullMethodName          = "/pb.AdminService/BlockUserSessions"
+	AdminService_SetFxRate_FullMethodName                  = "/pb.AdminService/SetFxRate"
+	AdminService_GetTrialBalance_FullMethodName            = "/pb.AdminService/GetTrialBalance"
+	AdminService_ReverseTransfer_FullMethodName            = "/pb.AdminService/ReverseTransfer"
+	AdminService_SetFeeSchedule_FullMethodName             = "/pb.AdminService/SetFeeSchedule"
+	AdminService_ListRiskReviews_FullMethodName            = "/pb.AdminService/ListRiskReviews"
+	AdminService_ApproveRiskReview_FullMethodName          = "/pb.AdminService/ApproveRiskReview"
+	AdminService_RejectRiskReview_FullMethodName           = "/pb.AdminService/RejectRiskReview"
+)
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AdminService is only available to support and admin users.
+// Every call is recorded in the audit log.
+type AdminServiceClient interface {
+	SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error)
+	GetCustomerAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	ListCustomerAccountEntries(ctx context.Context, in *ListAccountEntriesRequest, opts ...grpc.CallOption) (*ListAccountEntriesResponse, error)
+	FreezeCustomerAccount(ctx context.Context, in *FreezeCustomerAccountRequest, opts ...grpc.CallOption) (*FreezeCustomerAccountResponse, error)
+	UnfreezeCustomerAccount(ctx context.Context, in *UnfreezeCustomerAccountRequest, opts ...grpc.CallOption) (*UnfreezeCustomerAccountResponse, error)
+	ReopenCustomerAccount(ctx context.Context, in *ReopenCustomerAccountRequest, opts ...grpc.CallOption) (*ReopenCustomerAccountResponse, error)
+	BlockUserSessions(ctx context.Context, in *BlockUserSessionsRequest, opts ...grpc.CallOption) (*BlockUserSessionsResponse, error)
+	SetFxRate(ctx context.Context, in *SetFxRateRequest, opts ...grpc.CallOption) (*SetFxRateResponse, error)
+	GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error)
+	ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error)
+	SetFeeSchedule(ctx context.Context, in *SetFeeScheduleRequest, opts ...grpc.CallOption) (*SetFeeScheduleResponse, error)
+	ListRiskReviews(ctx context.Context, in *ListRiskReviewsRequest, opts ...grpc.CallOption) (*ListRiskReviewsResponse, error)
+	ApproveRiskReview(ctx context.Context, in *ApproveRiskReviewRequest, opts ...grpc.CallOption) (*ApproveRiskReviewResponse, error)
+	RejectRiskReview(ctx context.Context, in *RejectRiskReviewRequest, opts ...grpc.CallOption) (*RejectRiskReviewResponse, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) SearchUsers(ctx context.Context, in *SearchUsersRequest, opts ...grpc.CallOption) (*SearchUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchUsersResponse)
+	err := c.cc.Invoke(ctx, AdminService_SearchUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetCustomerAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetCustomerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListCustomerAccountEntries(ctx context.Context, in *ListAccountEntriesRequest, opts ...grpc.CallOption) (*ListAccountEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAccountEntriesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListCustomerAccountEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) FreezeCustomerAccount(ctx context.Context, in *FreezeCustomerAccountRequest, opts ...grpc.CallOption) (*FreezeCustomerAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FreezeCustomerAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_FreezeCustomerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UnfreezeCustomerAccount(ctx context.Context, in *UnfreezeCustomerAccountRequest, opts ...grpc.CallOption) (*UnfreezeCustomerAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnfreezeCustomerAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_UnfreezeCustomerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReopenCustomerAccount(ctx context.Context, in *ReopenCustomerAccountRequest, opts ...grpc.CallOption) (*ReopenCustomerAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReopenCustomerAccountResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReopenCustomerAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BlockUserSessions(ctx context.Context, in *BlockUserSessionsRequest, opts ...grpc.CallOption) (*BlockUserSessionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserSessionsResponse)
+	err := c.cc.Invoke(ctx, AdminService_BlockUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetFxRate(ctx context.Context, in *SetFxRateRequest, opts ...grpc.CallOption) (*SetFxRateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFxRateResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetFxRate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetTrialBalance(ctx context.Context, in *GetTrialBalanceRequest, opts ...grpc.CallOption) (*GetTrialBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTrialBalanceResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetTrialBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ReverseTransfer(ctx context.Context, in *ReverseTransferRequest, opts ...grpc.CallOption) (*ReverseTransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReverseTransferResponse)
+	err := c.cc.Invoke(ctx, AdminService_ReverseTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetFeeSchedule(ctx context.Context, in *SetFeeScheduleRequest, opts ...grpc.CallOption) (*SetFeeScheduleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetFeeSchedule_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListRiskReviews(ctx context.Context, in *ListRiskReviewsRequest, opts ...grpc.CallOption) (*ListRiskReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRiskReviewsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListRiskReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ApproveRiskReview(ctx context.Context, in *ApproveRiskReviewRequest, opts ...grpc.CallOption) (*ApproveRiskReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ApproveRiskReviewResponse)
+	err := c.cc.Invoke(ctx, AdminService_ApproveRiskReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) RejectRiskReview(ctx context.Context, in *RejectRiskReviewRequest, opts ...grpc.CallOption) (*RejectRiskReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RejectRiskReviewResponse)
+	err := c.cc.Invoke(ctx, AdminService_RejectRiskReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility.
+//
+// AdminService is only available to support and admin users.
+// Every call is recorded in the audit log.
+type AdminServiceServer interface {
+	SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error)
+	GetCustomerAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	ListCustomerAccountEntries(context.Context, *ListAccountEntriesRequest) (*ListAccountEntriesResponse, error)
+	FreezeCustomerAccount(context.Context, *FreezeCustomerAccountRequest) (*FreezeCustomerAccountResponse, error)
+	UnfreezeCustomerAccount(context.Context, *UnfreezeCustomerAccountRequest) (*UnfreezeCustomerAccountResponse, error)
+	ReopenCustomerAccount(context.Context, *ReopenCustomerAccountRequest) (*ReopenCustomerAccountResponse, error)
+	BlockUserSessions(context.Context, *BlockUserSessionsRequest) (*BlockUserSessionsResponse, error)
+	SetFxRate(context.Context, *SetFxRateRequest) (*SetFxRateResponse, error)
+	GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error)
+	ReverseTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error)
+	SetFeeSchedule(context.Context, *SetFeeScheduleRequest) (*SetFeeScheduleResponse, error)
+	ListRiskReviews(context.Context, *ListRiskReviewsRequest) (*ListRiskReviewsResponse, error)
+	ApproveRiskReview(context.Context, *ApproveRiskReviewRequest) (*ApproveRiskReviewResponse, error)
+	RejectRiskReview(context.Context, *RejectRiskReviewRequest) (*RejectRiskReviewResponse, error)
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAdminServiceServer struct{}
+
+func (UnimplementedAdminServiceServer) SearchUsers(context.Context, *SearchUsersRequest) (*SearchUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchUsers not implemented")
+}
+func (UnimplementedAdminServiceServer) GetCustomerAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCustomerAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) ListCustomerAccountEntries(context.Context, *ListAccountEntriesRequest) (*ListAccountEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCustomerAccountEntries not implemented")
+}
+func (UnimplementedAdminServiceServer) FreezeCustomerAccount(context.Context, *FreezeCustomerAccountRequest) (*FreezeCustomerAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeCustomerAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) UnfreezeCustomerAccount(context.Context, *UnfreezeCustomerAccountRequest) (*UnfreezeCustomerAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeCustomerAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) ReopenCustomerAccount(context.Context, *ReopenCustomerAccountRequest) (*ReopenCustomerAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReopenCustomerAccount not implemented")
+}
+func (UnimplementedAdminServiceServer) BlockUserSessions(context.Context, *BlockUserSessionsRequest) (*BlockUserSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockUserSessions not implemented")
+}
+func (UnimplementedAdminServiceServer) SetFxRate(context.Context, *SetFxRateRequest) (*SetFxRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFxRate not implemented")
+}
+func (UnimplementedAdminServiceServer) GetTrialBalance(context.Context, *GetTrialBalanceRequest) (*GetTrialBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrialBalance not implemented")
+}
+func (UnimplementedAdminServiceServer) ReverseTransfer(context.Context, *ReverseTransferRequest) (*ReverseTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReverseTransfer not implemented")
+}
+func (UnimplementedAdminServiceServer) SetFeeSchedule(context.Context, *SetFeeScheduleRequest) (*SetFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFeeSchedule not implemented")
+}
+func (UnimplementedAdminServiceServer) ListRiskReviews(context.Context, *ListRiskReviewsRequest) (*ListRiskReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRiskReviews not implemented")
+}
+func (UnimplementedAdminServiceServer) ApproveRiskReview(context.Context, *ApproveRiskReviewRequest) (*ApproveRiskReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveRiskReview not implemented")
+}
+func (UnimplementedAdminServiceServer) RejectRiskReview(context.Context, *RejectRiskReviewRequest) (*RejectRiskReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectRiskReview not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+func (UnimplementedAdminServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAdminServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_SearchUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SearchUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SearchUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SearchUsers(ctx, req.(*SearchUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetCustomerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetCustomerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetCustomerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetCustomerAccount(ctx, req.(*GetAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListCustomerAccountEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAccountEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListCustomerAccountEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListCustomerAccountEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListCustomerAccountEntries(ctx, req.(*ListAccountEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_FreezeCustomerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FreezeCustomerAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).FreezeCustomerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_FreezeCustomerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).FreezeCustomerAccount(ctx, req.(*FreezeCustomerAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UnfreezeCustomerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnfreezeCustomerAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UnfreezeCustomerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UnfreezeCustomerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UnfreezeCustomerAccount(ctx, req.(*UnfreezeCustomerAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReopenCustomerAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReopenCustomerAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReopenCustomerAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReopenCustomerAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReopenCustomerAccount(ctx, req.(*ReopenCustomerAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BlockUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).BlockUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_BlockUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).BlockUserSessions(ctx, req.(*BlockUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetFxRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFxRateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetFxRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetFxRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetFxRate(ctx, req.(*SetFxRateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetTrialBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrialBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetTrialBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetTrialBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetTrialBalance(ctx, req.(*GetTrialBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ReverseTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReverseTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ReverseTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ReverseTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ReverseTransfer(ctx, req.(*ReverseTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFeeScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetFeeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetFeeSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetFeeSchedule(ctx, req.(*SetFeeScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListRiskReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRiskReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListRiskReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListRiskReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListRiskReviews(ctx, req.(*ListRiskReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ApproveRiskReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveRiskReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ApproveRiskReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ApproveRiskReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ApproveRiskReview(ctx, req.(*ApproveRiskReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_RejectRiskReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectRiskReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).RejectRiskReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_RejectRiskReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).RejectRiskReview(ctx, req.(*RejectRiskReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SearchUsers",
+			Handler:    _AdminService_SearchUsers_Handler,
+		},
+		{
+			MethodName: "GetCustomerAccount",
+			Handler:    _AdminService_GetCustomerAccount_Handler,
+		},
+		{
+			MethodName: "ListCustomerAccountEntries",
+			Handler:    _AdminService_ListCustomerAccountEntries_Handler,
+		},
+		{
+			MethodName: "FreezeCustomerAccount",
+			Handler:    _AdminService_FreezeCustomerAccount_Handler,
+		},
+		{
+			MethodName: "UnfreezeCustomerAccount",
+			Handler:    _AdminService_UnfreezeCustomerAccount_Handler,
+		},
+		{
+			MethodName: "ReopenCustomerAccount",
+			Handler:    _AdminService_ReopenCustomerAccount_Handler,
+		},
+		{
+			MethodName: "BlockUserSessions",
+			Handler:    _AdminService_BlockUserSessions_Handler,
+		},
+		{
+			MethodName: "SetFxRate",
+			Handler:    _AdminService_SetFxRate_Handler,
+		},
+		{
+			MethodName: "GetTrialBalance",
+			Handler:    _AdminService_GetTrialBalance_Handler,
+		},
+		{
+			MethodName: "ReverseTransfer",
+			Handler:    _AdminService_ReverseTransfer_Handler,
+		},
+		{
+			MethodName: "SetFeeSchedule",
+			Handler:    _AdminService_SetFeeSchedule_Handler,
+		},
+		{
+			MethodName: "ListRiskReviews",
+			Handler:    _AdminService_ListRiskReviews_Handler,
+		},
+		{
+			MethodName: "ApproveRiskReview",
+			Handler:    _AdminService_ApproveRiskReview_Handler,
+		},
+		{
+			MethodName: "RejectRiskReview",
+			Handler:    _AdminService_RejectRiskReview_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "service_admin.proto",
+}
@@ -26,71 +26,244 @@ var File_service_simple_bank_proto protoreflect.FileDescriptor
 
 const file_service_simple_bank_proto_rawDesc = "" +
 	"\n" +
-	"\x19service_simple_bank.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a\x15rpc_create_user.proto\x1a\x14rpc_login_user.proto\x1a\x15rpc_update_user.proto\x1a\x16rpc_verify_email.proto\x1a\x18rpc_create_account.proto\x1a\x15rpc_get_account.proto\x1a\x17rpc_list_accounts.proto\x1a\x11rpc_deposit.proto\x1a\x12rpc_withdraw.proto\x1a\x18rpc_transfer_money.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x84\f\n" +
+	"\x19service_simple_bank.proto\x12\x02pb\x1a\x1cgoogle/api/annotations.proto\x1a\x15rpc_create_user.proto\x1a\x14rpc_login_user.proto\x1a\x15rpc_update_user.proto\x1a\x16rpc_verify_email.proto\x1a\x18rpc_create_account.proto\x1a\x15rpc_get_account.proto\x1a\x17rpc_list_accounts.proto\x1a\x18rpc_freeze_account.proto\x1a\x17rpc_close_account.proto\x1a\x11rpc_deposit.proto\x1a\x12rpc_withdraw.proto\x1a\x18rpc_transfer_money.proto\x1a\x1frpc_create_transfer_batch.proto\x1a\x19rpc_create_fx_quote.proto\x1a\x15rpc_create_hold.proto\x1a\x16rpc_capture_hold.proto\x1a\x16rpc_release_hold.proto\x1a#rpc_create_scheduled_transfer.proto\x1a rpc_get_scheduled_transfer.proto\x1a\"rpc_list_scheduled_transfers.proto\x1a#rpc_update_scheduled_transfer.proto\x1a#rpc_delete_scheduled_transfer.proto\x1a\x1erpc_list_account_entries.proto\x1a rpc_list_account_transfers.proto\x1a\x16rpc_get_transfer.proto\x1a\x19rpc_refund_transfer.proto\x1a\x1crpc_renew_access_token.proto\x1a\x15rpc_logout_user.proto\x1a\x17rpc_list_sessions.proto\x1a\x18rpc_revoke_session.proto\x1a\x17rpc_get_statement.proto\x1a\x14rpc_get_limits.proto\x1a\x17rpc_update_limits.proto\x1a\x16rpc_create_payee.proto\x1a\x13rpc_get_payee.proto\x1a\x15rpc_list_payees.proto\x1a\x16rpc_update_payee.proto\x1a\x16rpc_delete_payee.proto\x1a rpc_create_payment_request.proto\x1a\x1frpc_list_payment_requests.proto\x1a rpc_accept_payment_request.proto\x1a!rpc_decline_payment_request.proto\x1a.protoc-gen-openapiv2/options/annotations.proto2\x8bB\n" +
 	"\n" +
 	"SimpleBank\x12\x8e\x01\n" +
 	"\n" +
 	"CreateUser\x12\x15.pb.CreateUserRequest\x1a\x16.pb.CreateUserResponse\"Q\x92A4\x12\x0fCreate new user\x1a!Use this API to create a new user\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/create_user\x12\xa3\x01\n" +
 	"\tLoginUser\x12\x14.pb.LoginUserRequest\x1a\x15.pb.LoginUserResponse\"i\x92AM\x12\n" +
-	"Login user\x1a?Use this API to login user and get access token & refresh token\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/login_user\x12\x84\x01\n" +
+	"Login user\x1a?Use this API to login user and get access token & refresh token\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/login_user\x12\xc4\x01\n" +
+	"\x10RenewAccessToken\x12\x1b.pb.RenewAccessTokenRequest\x1a\x1c.pb.RenewAccessTokenResponse\"u\x92AQ\x12\x12Renew access token\x1a;Use this API to get a new access token with a refresh token\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/renew_access_token\x12\x9b\x01\n" +
+	"\n" +
+	"LogoutUser\x12\x15.pb.LogoutUserRequest\x1a\x16.pb.LogoutUserResponse\"^\x92AA\x12\vLogout user\x1a2Use this API to end the session of a refresh token\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/logout_user\x12\xa4\x01\n" +
+	"\fListSessions\x12\x17.pb.ListSessionsRequest\x1a\x18.pb.ListSessionsResponse\"a\x92AJ\x12\rList sessions\x1a9Use this API to list the devices the user is logged in on\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/sessions\x12\xa3\x01\n" +
+	"\rRevokeSession\x12\x18.pb.RevokeSessionRequest\x1a\x19.pb.RevokeSessionResponse\"]\x92A9\x12\x0eRevoke session\x1a'Use this API to log out a single device\x82\xd3\xe4\x93\x02\x1b*\x19/v1/sessions/{session_id}\x12\xa4\x01\n" +
+	"\x11RevokeAllSessions\x12\x1c.pb.RevokeAllSessionsRequest\x1a\x1d.pb.RevokeAllSessionsResponse\"R\x92A;\x12\x13Revoke all sessions\x1a$Use this API to log out every device\x82\xd3\xe4\x93\x02\x0e*\f/v1/sessions\x12\x84\x01\n" +
 	"\n" +
 	"UpdateUser\x12\x15.pb.UpdateUserRequest\x1a\x16.pb.UpdateUserResponse\"G\x92A*\x12\vUpdate user\x1a\x1bUse this API to update user\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/update_user\x12\x96\x01\n" +
 	"\vVerifyEmail\x12\x16.pb.VerifyEmailRequest\x1a\x17.pb.VerifyEmailResponse\"V\x92A;\x12\fVerify email\x1a+Use this API to verify user's email address\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/verify_email\x12\x96\x01\n" +
 	"\rCreateAccount\x12\x18.pb.CreateAccountRequest\x1a\x19.pb.CreateAccountResponse\"P\x92A6\x12\x0eCreate account\x1a$Use this API to create a new account\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/accounts\x12\x89\x01\n" +
 	"\n" +
 	"GetAccount\x12\x15.pb.GetAccountRequest\x1a\x16.pb.GetAccountResponse\"L\x92A0\x12\vGet account\x1a!Use this API to get account by ID\x82\xd3\xe4\x93\x02\x13\x12\x11/v1/accounts/{id}\x12\x88\x01\n" +
-	"\fListAccounts\x12\x17.pb.ListAccountsRequest\x1a\x18.pb.ListAccountsResponse\"E\x92A.\x12\rList accounts\x1a\x1dUse this API to list accounts\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/accounts\x12\x9f\x01\n" +
+	"\fListAccounts\x12\x17.pb.ListAccountsRequest\x1a\x18.pb.ListAccountsResponse\"E\x92A.\x12\rList accounts\x1a\x1dUse this API to list accounts\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/accounts\x12\xdf\x01\n" +
+	"\rFreezeAccount\x12\x18.pb.FreezeAccountRequest\x1a\x19.pb.FreezeAccountResponse\"\x98\x01\x92Aj\x12\x0eFreeze account\x1aXUse this API to stop all money movements on an account, only support can lift the freeze\x82\xd3\xe4\x93\x02%:\x01*\" /v1/accounts/{account_id}/freeze\x12\xbc\x01\n" +
+	"\fCloseAccount\x12\x17.pb.CloseAccountRequest\x1a\x18.pb.CloseAccountResponse\"y\x92AL\x12\rClose account\x1a;Use this API to close an active account with a zero balance\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/accounts/{account_id}/close\x12\x9f\x01\n" +
 	"\aDeposit\x12\x12.pb.DepositRequest\x1a\x13.pb.DepositResponse\"k\x92A<\x12\rDeposit money\x1a+Use this API to deposit money to an account\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/accounts/{account_id}/deposit\x12\xa7\x01\n" +
 	"\bWithdraw\x12\x13.pb.WithdrawRequest\x1a\x14.pb.WithdrawResponse\"p\x92A@\x12\x0eWithdraw money\x1a.Use this API to withdraw money from an account\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/accounts/{account_id}/withdraw\x12\xa2\x01\n" +
-	"\rTransferMoney\x12\x18.pb.TransferMoneyRequest\x1a\x19.pb.TransferMoneyResponse\"\\\x92AA\x12\x0eTransfer money\x1a/Use this API to transfer money between accounts\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/transfersB\x8c\x01\x92Ac\x12a\n" +
+	"\rTransferMoney\x12\x18.pb.TransferMoneyRequest\x1a\x19.pb.TransferMoneyResponse\"\\\x92AA\x12\x0eTransfer money\x1a/Use this API to transfer money between accounts\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/transfers\x12\xdc\x01\n" +
+	"\x13CreateTransferBatch\x12\x1e.pb.CreateTransferBatchRequest\x1a\x1f.pb.CreateTransferBatchResponse\"\x83\x01\x92Aa\x12\x15Create transfer batch\x1aHUse this API to make many transfers from one account in a single request\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/transfer_batches\x12\xbb\x01\n" +
+	"\rCreateFxQuote\x12\x18.pb.CreateFxQuoteRequest\x1a\x19.pb.CreateFxQuoteResponse\"u\x92AZ\x12\x0fCreate fx quote\x1aGUse this API to lock an exchange rate for a transfer between currencies\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/fx_quotes\x12\xc9\x01\n" +
+	"\n" +
+	"CreateHold\x12\x15.pb.CreateHoldRequest\x1a\x16.pb.CreateHoldResponse\"\x8b\x01\x92A^\x12\vCreate hold\x1aOUse this API to reserve funds on an account until they are captured or released\x82\xd3\xe4\x93\x02$:\x01*\"\x1f/v1/accounts/{account_id}/holds\x12\xb5\x01\n" +
+	"\vCaptureHold\x12\x16.pb.CaptureHoldRequest\x1a\x17.pb.CaptureHoldResponse\"u\x92AL\x12\fCapture hold\x1a<Use this API to debit all or part of a hold from its account\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/holds/{hold_id}/capture\x12\xbf\x01\n" +
+	"\vReleaseHold\x12\x16.pb.ReleaseHoldRequest\x1a\x17.pb.ReleaseHoldResponse\"\x7f\x92AV\x12\fRelease hold\x1aFUse this API to give the funds of a hold back to the available balance\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/v1/holds/{hold_id}/release\x12\xdc\x01\n" +
+	"\x17CreateScheduledTransfer\x12\".pb.CreateScheduledTransferRequest\x1a#.pb.CreateScheduledTransferResponse\"x\x92AS\x12\x19Create scheduled transfer\x1a6Use this API to set up a one-off or recurring transfer\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/scheduled_transfers\x12\xd8\x01\n" +
+	"\x14GetScheduledTransfer\x12\x1f.pb.GetScheduledTransferRequest\x1a .pb.GetScheduledTransferResponse\"}\x92AV\x12\x16Get scheduled transfer\x1a<Use this API to get a scheduled transfer and its latest runs\x82\xd3\xe4\x93\x02\x1e\x12\x1c/v1/scheduled_transfers/{id}\x12\xe6\x01\n" +
+	"\x16ListScheduledTransfers\x12!.pb.ListScheduledTransfersRequest\x1a\".pb.ListScheduledTransfersResponse\"\x84\x01\x92Ab\x12\x18List scheduled transfers\x1aFUse this API to list the scheduled transfers of the authenticated user\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/scheduled_transfers\x12\x98\x02\n" +
+	"\x17UpdateScheduledTransfer\x12\".pb.UpdateScheduledTransferRequest\x1a#.pb.UpdateScheduledTransferResponse\"\xb3\x01\x92A\x88\x01\x12\x19Update scheduled transfer\x1akUse this API to change the amount, end or failure policy of a scheduled transfer, or to pause and resume it\x82\xd3\xe4\x93\x02!:\x01*2\x1c/v1/scheduled_transfers/{id}\x12\xd3\x01\n" +
+	"\x17DeleteScheduledTransfer\x12\".pb.DeleteScheduledTransferRequest\x1a#.pb.DeleteScheduledTransferResponse\"o\x92AH\x12\x19Delete scheduled transfer\x1a+Use this API to cancel a scheduled transfer\x82\xd3\xe4\x93\x02\x1e*\x1c/v1/scheduled_transfers/{id}\x12\xcf\x01\n" +
+	"\x12ListAccountEntries\x12\x1d.pb.ListAccountEntriesRequest\x1a\x1e.pb.ListAccountEntriesResponse\"z\x92AN\x12\x14List account entries\x1a6Use this API to list the balance changes of an account\x82\xd3\xe4\x93\x02#\x12!/v1/accounts/{account_id}/entries\x12\xe6\x02\n" +
+	"\fGetStatement\x12\x17.pb.GetStatementRequest\x1a\x18.pb.GetStatementResponse\"\xa2\x02\x92A\xf3\x01\x12\x15Get account statement\x1a\xd9\x01Use this API to get the entries of an account over a period with its opening and closing balances. Download it as a file from /v1/accounts/{account_id}/statement/download with the same query and format=csv, ofx or pdf\x82\xd3\xe4\x93\x02%\x12#/v1/accounts/{account_id}/statement\x12\xe4\x01\n" +
+	"\x14ListAccountTransfers\x12\x1f.pb.ListAccountTransfersRequest\x1a .pb.ListAccountTransfersResponse\"\x88\x01\x92AZ\x12\x16List account transfers\x1a@Use this API to list the transfers going in or out of an account\x82\xd3\xe4\x93\x02%\x12#/v1/accounts/{account_id}/transfers\x12\x8f\x01\n" +
+	"\vGetTransfer\x12\x16.pb.GetTransferRequest\x1a\x17.pb.GetTransferResponse\"O\x92A2\x12\fGet transfer\x1a\"Use this API to get transfer by ID\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/transfers/{id}\x12\xdb\x01\n" +
+	"\x0eRefundTransfer\x12\x19.pb.RefundTransferRequest\x1a\x1a.pb.RefundTransferResponse\"\x91\x01\x92Aa\x12\x0fRefund transfer\x1aNUse this API to send all or part of a transfer you received back to its sender\x82\xd3\xe4\x93\x02':\x01*\"\"/v1/transfers/{transfer_id}/refund\x12\x95\x01\n" +
+	"\tGetLimits\x12\x14.pb.GetLimitsRequest\x1a\x15.pb.GetLimitsResponse\"[\x92AF\x12\n" +
+	"Get limits\x1a8Use this API to get the spending limits of your accounts\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/limits\x12\x91\x02\n" +
+	"\fUpdateLimits\x12\x17.pb.UpdateLimitsRequest\x1a\x18.pb.UpdateLimitsResponse\"\xcd\x01\x92A\xb4\x01\x12\rUpdate limits\x1a\xa2\x01Use this API to set the spending limits of one account or of all your accounts in a currency. Lower limits apply at once, higher limits after a cooling-off period\x82\xd3\xe4\x93\x02\x0f:\x01*2\n" +
+	"/v1/limits\x12\xfc\x01\n" +
+	"\vCreatePayee\x12\x16.pb.CreatePayeeRequest\x1a\x17.pb.CreatePayeeResponse\"\xbb\x01\x92A\xa2\x01\x12\fCreate payee\x1a\x91\x01Use this API to save an account you pay under a nickname. The response shows the masked name of its owner so you can check it is the right person\x82\xd3\xe4\x93\x02\x0f:\x01*\"\n" +
+	"/v1/payees\x12\x7f\n" +
+	"\bGetPayee\x12\x13.pb.GetPayeeRequest\x1a\x14.pb.GetPayeeResponse\"H\x92A.\x12\tGet payee\x1a!Use this API to get a saved payee\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/payees/{id}\x12\x9a\x01\n" +
+	"\n" +
+	"ListPayees\x12\x15.pb.ListPayeesRequest\x1a\x16.pb.ListPayeesResponse\"]\x92AH\x12\vList payees\x1a9Use this API to list the payees of the authenticated user\x82\xd3\xe4\x93\x02\f\x12\n" +
+	"/v1/payees\x12\x8b\x01\n" +
+	"\vUpdatePayee\x12\x16.pb.UpdatePayeeRequest\x1a\x17.pb.UpdatePayeeResponse\"K\x92A.\x12\fUpdate payee\x1a\x1eUse this API to rename a payee\x82\xd3\xe4\x93\x02\x14:\x01*2\x0f/v1/payees/{id}\x12\x8e\x01\n" +
+	"\vDeletePayee\x12\x16.pb.DeletePayeeRequest\x1a\x17.pb.DeletePayeeResponse\"N\x92A4\x12\fDelete payee\x1a$Use this API to remove a saved payee\x82\xd3\xe4\x93\x02\x11*\x0f/v1/payees/{id}\x12\xa1\x02\n" +
+	"\x14CreatePaymentRequest\x12\x1f.pb.CreatePaymentRequestRequest\x1a .pb.CreatePaymentRequestResponse\"\xc5\x01\x92A\xa2\x01\x12\x16Create payment request\x1a\x87\x01Use this API to ask another user, by username or saved payee, to pay money into one of your accounts. Both of you are notified by email\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/payment_requests\x12\xe4\x01\n" +
+	"\x13ListPaymentRequests\x12\x1e.pb.ListPaymentRequestsRequest\x1a\x1f.pb.ListPaymentRequestsResponse\"\x8b\x01\x92Al\x12\x15List payment requests\x1aSUse this API to list the payment requests you are asked to pay or the ones you made\x82\xd3\xe4\x93\x02\x16\x12\x14/v1/payment_requests\x12\x9c\x02\n" +
+	"\x14AcceptPaymentRequest\x12\x1f.pb.AcceptPaymentRequestRequest\x1a .pb.AcceptPaymentRequestResponse\"\xc0\x01\x92A\x91\x01\x12\x16Accept payment request\x1awUse this API to pay a pending payment request you were asked to pay. The money is transferred from one of your accounts\x82\xd3\xe4\x93\x02%:\x01*\" /v1/payment_requests/{id}/accept\x12\xef\x01\n" +
+	"\x15DeclinePaymentRequest\x12 .pb.DeclinePaymentRequestRequest\x1a!.pb.DeclinePaymentRequestResponse\"\x90\x01\x92Aa\x12\x17Decline payment request\x1aFUse this API to refuse a pending payment request you were asked to pay\x82\xd3\xe4\x93\x02&:\x01*\"!/v1/payment_requests/{id}/declineB\x8c\x01\x92Ac\x12a\n" +
 	"\x0fSimple Bank API\"I\n" +
 	"\fJaiden Shall\x12\x1ehttps://github.com/JaidenShall\x1a\x19shalljaiden0110@gmail.com2\x031.2Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
 
 var file_service_simple_bank_proto_goTypes = []any{
-	(*CreateUserRequest)(nil),     // 0: pb.CreateUserRequest
-	(*LoginUserRequest)(nil),      // 1: pb.LoginUserRequest
-	(*UpdateUserRequest)(nil),     // 2: pb.UpdateUserRequest
-	(*VerifyEmailRequest)(nil),    // 3: pb.VerifyEmailRequest
-	(*CreateAccountRequest)(nil),  // 4: pb.CreateAccountRequest
-	(*GetAccountRequest)(nil),     // 5: pb.GetAccountRequest
-	(*ListAccountsRequest)(nil),   // 6: pb.ListAccountsRequest
-	(*DepositRequest)(nil),        // 7: pb.DepositRequest
-	(*WithdrawRequest)(nil),       // 8: pb.WithdrawRequest
-	(*TransferMoneyRequest)(nil),  // 9: pb.TransferMoneyRequest
-	(*CreateUserResponse)(nil),    // 10: pb.CreateUserResponse
-	(*LoginUserResponse)(nil),     // 11: pb.LoginUserResponse
-	(*UpdateUserResponse)(nil),    // 12: pb.UpdateUserResponse
-	(*VerifyEmailResponse)(nil),   // 13: pb.VerifyEmailResponse
-	(*CreateAccountResponse)(nil), // 14: pb.CreateAccountResponse
-	(*GetAccountResponse)(nil),    // 15: pb.GetAccountResponse
-	(*ListAccountsResponse)(nil),  // 16: pb.ListAccountsResponse
-	(*DepositResponse)(nil),       // 17: pb.DepositResponse
-	(*WithdrawResponse)(nil),      // 18: pb.WithdrawResponse
-	(*TransferMoneyResponse)(nil), // 19: pb.TransferMoneyResponse
+	(*CreateUserRequest)(nil),               // 0: pb.CreateUserRequest
+	(*LoginUserRequest)(nil),                // 1: pb.LoginUserRequest
+	(*RenewAccessTokenRequest)(nil),         // 2: pb.RenewAccessTokenRequest
+	(*LogoutUserRequest)(nil),               // 3: pb.LogoutUserRequest
+	(*ListSessionsRequest)(nil),             // 4: pb.ListSessionsRequest
+	(*RevokeSessionRequest)(nil),            // 5: pb.RevokeSessionRequest
+	(*RevokeAllSessionsRequest)(nil),        // 6: pb.RevokeAllSessionsRequest
+	(*UpdateUserRequest)(nil),               // 7: pb.UpdateUserRequest
+	(*VerifyEmailRequest)(nil),              // 8: pb.VerifyEmailRequest
+	(*CreateAccountRequest)(nil),            // 9: pb.CreateAccountRequest
+	(*GetAccountRequest)(nil),               // 10: pb.GetAccountRequest
+	(*ListAccountsRequest)(nil),             // 11: pb.ListAccountsRequest
+	(*FreezeAccountRequest)(nil),            // 12: pb.FreezeAccountRequest
+	(*CloseAccountRequest)(nil),             // 13: pb.CloseAccountRequest
+	(*DepositRequest)(nil),                  // 14: pb.DepositRequest
+	(*WithdrawRequest)(nil),                 // 15: pb.WithdrawRequest
+	(*TransferMoneyRequest)(nil),            // 16: pb.TransferMoneyRequest
+	(*CreateTransferBatchRequest)(nil),      // 17: pb.CreateTransferBatchRequest
+	(*CreateFxQuoteRequest)(nil),            // 18: pb.CreateFxQuoteRequest
+	(*CreateHoldRequest)(nil),               // 19: pb.CreateHoldRequest
+	(*CaptureHoldRequest)(nil),              // 20: pb.CaptureHoldRequest
+	(*ReleaseHoldRequest)(nil),              // 21: pb.ReleaseHoldRequest
+	(*CreateScheduledTransferRequest)(nil),  // 22: pb.CreateScheduledTransferRequest
+	(*GetScheduledTransferRequest)(nil),     // 23: pb.GetScheduledTransferRequest
+	(*ListScheduledTransfersRequest)(nil),   // 24: pb.ListScheduledTransfersRequest
+	(*UpdateScheduledTransferRequest)(nil),  // 25: pb.UpdateScheduledTransferRequest
+	(*DeleteScheduledTransferRequest)(nil),  // 26: pb.DeleteScheduledTransferRequest
+	(*ListAccountEntriesRequest)(nil),       // 27: pb.ListAccountEntriesRequest
+	(*GetStatementRequest)(nil),             // 28: pb.GetStatementRequest
+	(*ListAccountTransfersRequest)(nil),     // 29: pb.ListAccountTransfersRequest
+	(*GetTransferRequest)(nil),              // 30: pb.GetTransferRequest
+	(*RefundTransferRequest)(nil),           // 31: pb.RefundTransferRequest
+	(*GetLimitsRequest)(nil),                // 32: pb.GetLimitsRequest
+	(*UpdateLimitsRequest)(nil),             // 33: pb.UpdateLimitsRequest
+	(*CreatePayeeRequest)(nil),              // 34: pb.CreatePayeeRequest
+	(*GetPayeeRequest)(nil),                 // 35: pb.GetPayeeRequest
+	(*ListPayeesRequest)(nil),               // 36: pb.ListPayeesRequest
+	(*UpdatePayeeRequest)(nil),              // 37: pb.UpdatePayeeRequest
+	(*DeletePayeeRequest)(nil),              // 38: pb.DeletePayeeRequest
+	(*CreatePaymentRequestRequest)(nil),     // 39: pb.CreatePaymentRequestRequest
+	(*ListPaymentRequestsRequest)(nil),      // 40: pb.ListPaymentRequestsRequest
+	(*AcceptPaymentRequestRequest)(nil),     // 41: pb.AcceptPaymentRequestRequest
+	(*DeclinePaymentRequestRequest)(nil),    // 42: pb.DeclinePaymentRequestRequest
+	(*CreateUserResponse)(nil),              // 43: pb.CreateUserResponse
+	(*LoginUserResponse)(nil),               // 44: pb.LoginUserResponse
+	(*RenewAccessTokenResponse)(nil),        // 45: pb.RenewAccessTokenResponse
+	(*LogoutUserResponse)(nil),              // 46: pb.LogoutUserResponse
+	(*ListSessionsResponse)(nil),            // 47: pb.ListSessionsResponse
+	(*RevokeSessionResponse)(nil),           // 48: pb.RevokeSessionResponse
+	(*RevokeAllSessionsResponse)(nil),       // 49: pb.RevokeAllSessionsResponse
+	(*UpdateUserResponse)(nil),              // 50: pb.UpdateUserResponse
+	(*VerifyEmailResponse)(nil),             // 51: pb.VerifyEmailResponse
+	(*CreateAccountResponse)(nil),           // 52: pb.CreateAccountResponse
+	(*GetAccountResponse)(nil),              // 53: pb.GetAccountResponse
+	(*ListAccountsResponse)(nil),            // 54: pb.ListAccountsResponse
+	(*FreezeAccountResponse)(nil),           // 55: pb.FreezeAccountResponse
+	(*CloseAccountResponse)(nil),            // 56: pb.CloseAccountResponse
+	(*DepositResponse)(nil),                 // 57: pb.DepositResponse
+	(*WithdrawResponse)(nil),                // 58: pb.WithdrawResponse
+	(*TransferMoneyResponse)(nil),           // 59: pb.TransferMoneyResponse
+	(*CreateTransferBatchResponse)(nil),     // 60: pb.CreateTransferBatchResponse
+	(*CreateFxQuoteResponse)(nil),           // 61: pb.CreateFxQuoteResponse
+	(*CreateHoldResponse)(nil),              // 62: pb.CreateHoldResponse
+	(*CaptureHoldResponse)(nil),             // 63: pb.CaptureHoldResponse
+	(*ReleaseHoldResponse)(nil),             // 64: pb.ReleaseHoldResponse
+	(*CreateScheduledTransferResponse)(nil), // 65: pb.CreateScheduledTransferResponse
+	(*GetScheduledTransferResponse)(nil),    // 66: pb.GetScheduledTransferResponse
+	(*ListScheduledTransfersResponse)(nil),  // 67: pb.ListScheduledTransfersResponse
+	(*UpdateScheduledTransferResponse)(nil), // 68: pb.UpdateScheduledTransferResponse
+	(*DeleteScheduledTransferResponse)(nil), // 69: pb.DeleteScheduledTransferResponse
+	(*ListAccountEntriesResponse)(nil),      // 70: pb.ListAccountEntriesResponse
+	(*GetStatementResponse)(nil),            // 71: pb.GetStatementResponse
+	(*ListAccountTransfersResponse)(nil),    // 72: pb.ListAccountTransfersResponse
+	(*GetTransferResponse)(nil),             // 73: pb.GetTransferResponse
+	(*RefundTransferResponse)(nil),          // 74: pb.RefundTransferResponse
+	(*GetLimitsResponse)(nil),               // 75: pb.GetLimitsResponse
+	(*UpdateLimitsResponse)(nil),            // 76: pb.UpdateLimitsResponse
+	(*CreatePayeeResponse)(nil),             // 77: pb.CreatePayeeResponse
+	(*GetPayeeResponse)(nil),                // 78: pb.GetPayeeResponse
+	(*ListPayeesResponse)(nil),              // 79: pb.ListPayeesResponse
+	(*UpdatePayeeResponse)(nil),             // 80: pb.UpdatePayeeResponse
+	(*DeletePayeeResponse)(nil),             // 81: pb.DeletePayeeResponse
+	(*CreatePaymentRequestResponse)(nil),    // 82: pb.CreatePaymentRequestResponse
+	(*ListPaymentRequestsResponse)(nil),     // 83: pb.ListPaymentRequestsResponse
+	(*AcceptPaymentRequestResponse)(nil),    // 84: pb.AcceptPaymentRequestResponse
+	(*DeclinePaymentRequestResponse)(nil),   // 85: pb.DeclinePaymentRequestResponse
 }
 var file_service_simple_bank_proto_depIdxs = []int32{
 	0,  // 0: pb.SimpleBank.CreateUser:input_type -> pb.CreateUserRequest
 	1,  // 1: pb.SimpleBank.LoginUser:input_type -> pb.LoginUserRequest
-	2,  // 2: pb.SimpleBank.UpdateUser:input_type -> pb.UpdateUserRequest
-	3,  // 3: pb.SimpleBank.VerifyEmail:input_type -> pb.VerifyEmailRequest
-	4,  // 4: pb.SimpleBank.CreateAccount:input_type -> pb.CreateAccountRequest
-	5,  // 5: pb.SimpleBank.GetAccount:input_type -> pb.GetAccountRequest
-	6,  // 6: pb.SimpleBank.ListAccounts:input_type -> pb.ListAccountsRequest
-	7,  // 7: pb.SimpleBank.Deposit:input_type -> pb.DepositRequest
-	8,  // 8: pb.SimpleBank.Withdraw:input_type -> pb.WithdrawRequest
-	9,  // 9: pb.SimpleBank.TransferMoney:input_type -> pb.TransferMoneyRequest
-	10, // 10: pb.SimpleBank.CreateUser:output_type -> pb.CreateUserResponse
-	11, // 11: pb.SimpleBank.LoginUser:output_type -> pb.LoginUserResponse
-	12, // 12: pb.SimpleBank.UpdateUser:output_type -> pb.UpdateUserResponse
-	13, // 13: pb.SimpleBank.VerifyEmail:output_type -> pb.VerifyEmailResponse
-	14, // 14: pb.SimpleBank.CreateAccount:output_type -> pb.CreateAccountResponse
-	15, // 15: pb.SimpleBank.GetAccount:output_type -> pb.GetAccountResponse
-	16, // 16: pb.SimpleBank.ListAccounts:output_type -> pb.ListAccountsResponse
-	17, // 17: pb.SimpleBank.Deposit:output_type -> pb.DepositResponse
-	18, // 18: pb.SimpleBank.Withdraw:output_type -> pb.WithdrawResponse
-	19, // 19: pb.SimpleBank.TransferMoney:output_type -> pb.TransferMoneyResponse
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
+	2,  // 2: pb.SimpleBank.RenewAccessToken:input_type -> pb.RenewAccessTokenRequest
+	3,  // 3: pb.SimpleBank.LogoutUser:input_type -> pb.LogoutUserRequest
+	4,  // 4: pb.SimpleBank.ListSessions:input_type -> pb.ListSessionsRequest
+	5,  // 5: pb.SimpleBank.RevokeSession:input_type -> pb.RevokeSessionRequest
+	6,  // 6: pb.SimpleBank.RevokeAllSessions:input_type -> pb.RevokeAllSessionsRequest
+	7,  // 7: pb.SimpleBank.UpdateUser:input_type -> pb.UpdateUserRequest
+	8,  // 8: pb.SimpleBank.VerifyEmail:input_type -> pb.VerifyEmailRequest
+	9,  // 9: pb.SimpleBank.CreateAccount:input_type -> pb.CreateAccountRequest
+	10, // 10: pb.SimpleBank.GetAccount:input_type -> pb.GetAccountRequest
+	11, // 11: pb.SimpleBank.ListAccounts:input_type -> pb.ListAccountsRequest
+	12, // 12: pb.SimpleBank.FreezeAccount:input_type -> pb.FreezeAccountRequest
+	13, // 13: pb.SimpleBank.CloseAccount:input_type -> pb.CloseAccountRequest
+	14, // 14: pb.SimpleBank.Deposit:input_type -> pb.DepositRequest
+	15, // 15: pb.SimpleBank.Withdraw:input_type -> pb.WithdrawRequest
+	16, // 16: pb.SimpleBank.TransferMoney:input_type -> pb.TransferMoneyRequest
+	17, // 17: pb.SimpleBank.CreateTransferBatch:input_type -> pb.CreateTransferBatchRequest
+	18, // 18: pb.SimpleBank.CreateFxQuote:input_type -> pb.CreateFxQuoteRequest
+	19, // 19: pb.SimpleBank.CreateHold:input_type -> pb.CreateHoldRequest
+	20, // 20: pb.SimpleBank.CaptureHold:input_type -> pb.CaptureHoldRequest
+	21, // 21: pb.SimpleBank.ReleaseHold:input_type -> pb.ReleaseHoldRequest
+	22, // 22: pb.SimpleBank.CreateScheduledTransfer:input_type -> pb.CreateScheduledTransferRequest
+	23, // 23: pb.SimpleBank.GetScheduledTransfer:input_type -> pb.GetScheduledTransferRequest
+	24, // 24: pb.SimpleBank.ListScheduledTransfers:input_type -> pb.ListScheduledTransfersRequest
+	25, // 25: pb.SimpleBank.UpdateScheduledTransfer:input_type -> pb.UpdateScheduledTransferRequest
+	26, // 26: pb.SimpleBank.DeleteScheduledTransfer:input_type -> pb.DeleteScheduledTransferRequest
+	27, // 27: pb.SimpleBank.ListAccountEntries:input_type -> pb.ListAccountEntriesRequest
+	28, // 28: pb.SimpleBank.GetStatement:input_type -> pb.GetStatementRequest
+	29, // 29: pb.SimpleBank.ListAccountTransfers:input_type -> pb.ListAccountTransfersRequest
+	30, // 30: pb.SimpleBank.GetTransfer:input_type -> pb.GetTransferRequest
+	31, // 31: pb.SimpleBank.RefundTransfer:input_type -> pb.RefundTransferRequest
+	32, // 32: pb.SimpleBank.GetLimits:input_type -> pb.GetLimitsRequest
+	33, // 33: pb.SimpleBank.UpdateLimits:input_type -> pb.UpdateLimitsRequest
+	34, // 34: pb.SimpleBank.CreatePayee:input_type -> pb.CreatePayeeRequest
+	35, // 35: pb.SimpleBank.GetPayee:input_type -> pb.GetPayeeRequest
+	36, // 36: pb.SimpleBank.ListPayees:input_type -> pb.ListPayeesRequest
+	37, // 37: pb.SimpleBank.UpdatePayee:input_type -> pb.UpdatePayeeRequest
+	38, // 38: pb.SimpleBank.DeletePayee:input_type -> pb.DeletePayeeRequest
+	39, // 39: pb.SimpleBank.CreatePaymentRequest:input_type -> pb.CreatePaymentRequestRequest
+	40, // 40: pb.SimpleBank.ListPaymentRequests:input_type -> pb.ListPaymentRequestsRequest
+	41, // 41: pb.SimpleBank.AcceptPaymentRequest:input_type -> pb.AcceptPaymentRequestRequest
+	42, // 42: pb.SimpleBank.DeclinePaymentRequest:input_type -> pb.DeclinePaymentRequestRequest
+	43, // 43: pb.SimpleBank.CreateUser:output_type -> pb.CreateUserResponse
+	44, // 44: pb.SimpleBank.LoginUser:output_type -> pb.LoginUserResponse
+	45, // 45: pb.SimpleBank.RenewAccessToken:output_type -> pb.RenewAccessTokenResponse
+	46, // 46: pb.SimpleBank.LogoutUser:output_type -> pb.LogoutUserResponse
+	47, // 47: pb.SimpleBank.ListSessions:output_type -> pb.ListSessionsResponse
+	48, // 48: pb.SimpleBank.RevokeSession:output_type -> pb.RevokeSessionResponse
+	49, // 49: pb.SimpleBank.RevokeAllSessions:output_type -> pb.RevokeAllSessionsResponse
+	50, // 50: pb.SimpleBank.UpdateUser:output_type -> pb.UpdateUserResponse
+	51, // 51: pb.SimpleBank.VerifyEmail:output_type -> pb.VerifyEmailResponse
+	52, // 52: pb.SimpleBank.CreateAccount:output_type -> pb.CreateAccountResponse
+	53, // 53: pb.SimpleBank.GetAccount:output_type -> pb.GetAccountResponse
+	54, // 54: pb.SimpleBank.ListAccounts:output_type -> pb.ListAccountsResponse
+	55, // 55: pb.SimpleBank.FreezeAccount:output_type -> pb.FreezeAccountResponse
+	56, // 56: pb.SimpleBank.CloseAccount:output_type -> pb.CloseAccountResponse
+	57, // 57: pb.SimpleBank.Deposit:output_type -> pb.DepositResponse
+	58, // 58: pb.SimpleBank.Withdraw:output_type -> pb.WithdrawResponse
+	59, // 59: pb.SimpleBank.TransferMoney:output_type -> pb.TransferMoneyResponse
+	60, // 60: pb.SimpleBank.CreateTransferBatch:output_type -> pb.CreateTransferBatchResponse
+	61, // 61: pb.SimpleBank.CreateFxQuote:output_type -> pb.CreateFxQuoteResponse
+	62, // 62: pb.SimpleBank.CreateHold:output_type -> pb.CreateHoldResponse
+	63, // 63: pb.SimpleBank.CaptureHold:output_type -> pb.CaptureHoldResponse
+	64, // 64: pb.SimpleBank.ReleaseHold:output_type -> pb.ReleaseHoldResponse
+	65, // 65: pb.SimpleBank.CreateScheduledTransfer:output_type -> pb.CreateScheduledTransferResponse
+	66, // 66: pb.SimpleBank.GetScheduledTransfer:output_type -> pb.GetScheduledTransferResponse
+	67, // 67: pb.SimpleBank.ListScheduledTransfers:output_type -> pb.ListScheduledTransfersResponse
+	68, // 68: pb.SimpleBank.UpdateScheduledTransfer:output_type -> pb.UpdateScheduledTransferResponse
+	69, // 69: pb.SimpleBank.DeleteScheduledTransfer:output_type -> pb.DeleteScheduledTransferResponse
+	70, // 70: pb.SimpleBank.ListAccountEntries:output_type -> pb.ListAccountEntriesResponse
+	71, // 71: pb.SimpleBank.GetStatement:output_type -> pb.GetStatementResponse
+	72, // 72: pb.SimpleBank.ListAccountTransfers:output_type -> pb.ListAccountTransfersResponse
+	73, // 73: pb.SimpleBank.GetTransfer:output_type -> pb.GetTransferResponse
+	74, // 74: pb.SimpleBank.RefundTransfer:output_type -> pb.RefundTransferResponse
+	75, // 75: pb.SimpleBank.GetLimits:output_type -> pb.GetLimitsResponse
+	76, // 76: pb.SimpleBank.UpdateLimits:output_type -> pb.UpdateLimitsResponse
+	77, // 77: pb.SimpleBank.CreatePayee:output_type -> pb.CreatePayeeResponse
+	78, // 78: pb.SimpleBank.GetPayee:output_type -> pb.GetPayeeResponse
+	79, // 79: pb.SimpleBank.ListPayees:output_type -> pb.ListPayeesResponse
+	80, // 80: pb.SimpleBank.UpdatePayee:output_type -> pb.UpdatePayeeResponse
+	81, // 81: pb.SimpleBank.DeletePayee:output_type -> pb.DeletePayeeResponse
+	82, // 82: pb.SimpleBank.CreatePaymentRequest:output_type -> pb.CreatePaymentRequestResponse
+	83, // 83: pb.SimpleBank.ListPaymentRequests:output_type -> pb.ListPaymentRequestsResponse
+	84, // 84: pb.SimpleBank.AcceptPaymentRequest:output_type -> pb.AcceptPaymentRequestResponse
+	85, // 85: pb.SimpleBank.DeclinePaymentRequest:output_type -> pb.DeclinePaymentRequestResponse
+	43, // [43:86] is the sub-list for method output_type
+	0,  // [0:43] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -108,9 +281,41 @@ func file_service_simple_bank_proto_init() {
 	file_rpc_create_account_proto_init()
 	file_rpc_get_account_proto_init()
 	file_rpc_list_accounts_proto_init()
+	file_rpc_freeze_account_proto_init()
+	file_rpc_close_account_proto_init()
 	file_rpc_deposit_proto_init()
 	file_rpc_withdraw_proto_init()
 	file_rpc_transfer_money_proto_init()
+	file_rpc_create_transfer_batch_proto_init()
+	file_rpc_create_fx_quote_proto_init()
+	file_rpc_create_hold_proto_init()
+	file_rpc_capture_hold_proto_init()
+	file_rpc_release_hold_proto_init()
+	file_rpc_create_scheduled_transfer_proto_init()
+	file_rpc_get_scheduled_transfer_proto_init()
+	file_rpc_list_scheduled_transfers_proto_init()
+	file_rpc_update_scheduled_transfer_proto_init()
+	file_rpc_delete_scheduled_transfer_proto_init()
+	file_rpc_list_account_entries_proto_init()
+	file_rpc_list_account_transfers_proto_init()
+	file_rpc_get_transfer_proto_init()
+	file_rpc_refund_transfer_proto_init()
+	file_rpc_renew_access_token_proto_init()
+	file_rpc_logout_user_proto_init()
+	file_rpc_list_sessions_proto_init()
+	file_rpc_revoke_session_proto_init()
+	file_rpc_get_statement_proto_init()
+	file_rpc_get_limits_proto_init()
+	file_rpc_update_limits_proto_init()
+	file_rpc_create_payee_proto_init()
+	file_rpc_get_payee_proto_init()
+	file_rpc_list_payees_proto_init()
+	file_rpc_update_payee_proto_init()
+	file_rpc_delete_payee_proto_init()
+	file_rpc_create_payment_request_proto_init()
+	file_rpc_list_payment_requests_proto_init()
+	file_rpc_accept_payment_request_proto_init()
+	file_rpc_decline_payment_request_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
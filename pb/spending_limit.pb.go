@@ -0,0 +1,241 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        v5.29.3
+// source: spending_limit.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Limits are in the smallest unit of currency, 0 is no limit
+type SpendingLimits struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PerTransaction int64                  `protobuf:"varint,1,opt,name=per_transaction,json=perTransaction,proto3" json:"per_transaction,omitempty"`
+	Daily          int64                  `protobuf:"varint,2,opt,name=daily,proto3" json:"daily,omitempty"`     // Over the last 24 hours
+	Monthly        int64                  `protobuf:"varint,3,opt,name=monthly,proto3" json:"monthly,omitempty"` // Over the last 30 days
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SpendingLimits) Reset() {
+	*x = SpendingLimits{}
+	mi := &file_spending_limit_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingLimits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingLimits) ProtoMessage() {}
+
+func (x *SpendingLimits) ProtoReflect() protoreflect.Message {
+	mi := &file_spending_limit_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingLimits.ProtoReflect.Descriptor instead.
+func (*SpendingLimits) Descriptor() ([]byte, []int) {
+	return file_spending_limit_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *SpendingLimits) GetPerTransaction() int64 {
+	if x != nil {
+		return x.PerTransaction
+	}
+	return 0
+}
+
+func (x *SpendingLimits) GetDaily() int64 {
+	if x != nil {
+		return x.Daily
+	}
+	return 0
+}
+
+func (x *SpendingLimits) GetMonthly() int64 {
+	if x != nil {
+		return x.Monthly
+	}
+	return 0
+}
+
+type SpendingLimit struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	AccountId          int64                  `protobuf:"varint,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"` // 0 for the limits of all your accounts in the currency
+	Currency           string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Limits             *SpendingLimits        `protobuf:"bytes,3,opt,name=limits,proto3" json:"limits,omitempty"`                                    // In force now
+	PendingLimits      *SpendingLimits        `protobuf:"bytes,4,opt,name=pending_limits,json=pendingLimits,proto3" json:"pending_limits,omitempty"` // Increases waiting for the cooling-off period, unset when there are none
+	PendingEffectiveAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=pending_effective_at,json=pendingEffectiveAt,proto3" json:"pending_effective_at,omitempty"`
+	UpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *SpendingLimit) Reset() {
+	*x = SpendingLimit{}
+	mi := &file_spending_limit_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SpendingLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SpendingLimit) ProtoMessage() {}
+
+func (x *SpendingLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_spending_limit_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SpendingLimit.ProtoReflect.Descriptor instead.
+func (*SpendingLimit) Descriptor() ([]byte, []int) {
+	return file_spending_limit_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SpendingLimit) GetAccountId() int64 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *SpendingLimit) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *SpendingLimit) GetLimits() *SpendingLimits {
+	if x != nil {
+		return x.Limits
+	}
+	return nil
+}
+
+func (x *SpendingLimit) GetPendingLimits() *SpendingLimits {
+	if x != nil {
+		return x.PendingLimits
+	}
+	return nil
+}
+
+func (x *SpendingLimit) GetPendingEffectiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.PendingEffectiveAt
+	}
+	return nil
+}
+
+func (x *SpendingLimit) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+var File_spending_limit_proto protoreflect.FileDescriptor
+
+const file_spending_limit_proto_rawDesc = "" +
+	"\n" +
+	"\x14spending_limit.proto\x12\x02pb\x1a\x1fgoogle/protobuf/timestamp.proto\"i\n" +
+	"\x0eSpendingLimits\x12'\n" +
+	"\x0fper_transaction\x18\x01 \x01(\x03R\x0eperTransaction\x12\x14\n" +
+	"\x05daily\x18\x02 \x01(\x03R\x05daily\x12\x18\n" +
+	"\amonthly\x18\x03 \x01(\x03R\amonthly\"\xba\x02\n" +
+	"\rSpendingLimit\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x01 \x01(\x03R\taccountId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12*\n" +
+	"\x06limits\x18\x03 \x01(\v2\x12.pb.SpendingLimitsR\x06limits\x129\n" +
+	"\x0epending_limits\x18\x04 \x01(\v2\x12.pb.SpendingLimitsR\rpendingLimits\x12L\n" +
+	"\x14pending_effective_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x12pendingEffectiveAt\x129\n" +
+	"\n" +
+	"updated_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtB&Z$github.com/JaidenShall/simplebank/pbb\x06proto3"
+
+var (
+	file_spending_limit_proto_rawDescOnce sync.Once
+	file_spending_limit_proto_rawDescData []byte
+)
+
+func file_spending_limit_proto_rawDescGZIP() []byte {
+	file_spending_limit_proto_rawDescOnce.Do(func() {
+		file_spending_limit_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_spending_limit_proto_rawDesc), len(file_spending_limit_proto_rawDesc)))
+	})
+	return file_spending_limit_proto_rawDescData
+}
+
+var file_spending_limit_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_spending_limit_proto_goTypes = []any{
+	(*SpendingLimits)(nil),        // 0: pb.SpendingLimits
+	(*SpendingLimit)(nil),         // 1: pb.SpendingLimit
+	(*timestamppb.Timestamp)(nil), // 2: google.protobuf.Timestamp
+}
+var file_spending_limit_proto_depIdxs = []int32{
+	0, // 0: pb.SpendingLimit.limits:type_name -> pb.SpendingLimits
+	0, // 1: pb.SpendingLimit.pending_limits:type_name -> pb.SpendingLimits
+	2, // 2: pb.SpendingLimit.pending_effective_at:type_name -> google.protobuf.Timestamp
+	2, // 3: pb.SpendingLimit.updated_at:type_name -> google.protobuf.Timestamp
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_spending_limit_proto_init() }
+func file_spending_limit_proto_init() {
+	if File_spending_limit_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_spending_limit_proto_rawDesc), len(file_spending_limit_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_spending_limit_proto_goTypes,
+		DependencyIndexes: file_spending_limit_proto_depIdxs,
+		MessageInfos:      file_spending_limit_proto_msgTypes,
+	}.Build()
+	File_spending_limit_proto = out.File
+	file_spending_limit_proto_goTypes = nil
+	file_spending_limit_proto_depIdxs = nil
+}
@@ -0,0 +1,39 @@
+package risk
+
+import "github.com/JaidenShall/simplebank/util"
+
+// NewEngineFromConfig builds the rules enabled by the config. It returns nil when none is,
+// money movements then aren't screened at all.
+func NewEngineFromConfig(config util.Config) *Engine {
+	var rules []Rule
+
+	if config.RiskDenyAmount > 0 {
+		rules = append(rules, Rule{Name: "deny_amount", Action: util.RiskDeny,
+			Match: AmountAtLeast(config.RiskDenyAmount)})
+	}
+	if config.RiskReviewAmount > 0 {
+		rules = append(rules, Rule{Name: "review_amount", Action: util.RiskReview,
+			Match: AmountAtLeast(config.RiskReviewAmount)})
+	}
+	if config.RiskNewPayeeAmount > 0 {
+		rules = append(rules, Rule{Name: "new_payee", Action: util.RiskReview,
+			Match: NewPayee(config.RiskNewPayeeAmount)})
+	}
+	if config.RiskNewDeviceAmount > 0 && config.RiskNewDeviceAge > 0 {
+		rules = append(rules, Rule{Name: "new_device", Action: util.RiskReview,
+			Match: NewDevice(config.RiskNewDeviceAge, config.RiskNewDeviceAmount)})
+	}
+	if config.RiskVelocityMax > 0 && config.RiskVelocityWindow > 0 {
+		rules = append(rules, Rule{Name: "velocity", Action: util.RiskReview,
+			Match: Velocity(config.RiskVelocityMax, config.RiskVelocityWindow)})
+	}
+	if config.RiskOddHoursAmount > 0 && config.RiskOddHoursStart != config.RiskOddHoursEnd {
+		rules = append(rules, Rule{Name: "odd_hours", Action: util.RiskReview,
+			Match: OddHours(config.RiskOddHoursStart, config.RiskOddHoursEnd, config.RiskOddHoursAmount)})
+	}
+
+	if len(rules) == 0 {
+		return nil
+	}
+	return NewEngine(rules...)
+}
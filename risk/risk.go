@@ -0,0 +1,178 @@
+// Package risk screens money movements before they are made. An engine evaluates a set of rules
+// against an operation and the history of its user, and the strictest action of the rules that
+// match decides whether the operation goes through, is held for review, or is denied.
+package risk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// Operation is a money movement to screen. Amounts are in minor units of the currency.
+type Operation struct {
+	Kind     string
+	Username string
+	// AccountID is the account debited, or credited for a deposit
+	AccountID int64
+	// ToAccountID is the account credited by a transfer, 0 for other operations
+	ToAccountID int64
+	Amount      int64
+	Currency    string
+	UserAgent   string
+	ClientIP    string
+	Time        time.Time
+	// Parts are the single movements an operation such as a transfer batch is made of.
+	// The operation carries their total, and only needs their ToAccountID and Amount.
+	Parts []Operation
+}
+
+// History answers what the rules need to know about the past operations of a user
+type History interface {
+	// IsNewPayee reports whether the user never sent money to the account
+	IsNewPayee(ctx context.Context, username string, accountID int64) (bool, error)
+	// DeviceFirstSeen returns when the user first logged in with the user agent,
+	// or now if they never did
+	DeviceFirstSeen(ctx context.Context, username string, userAgent string) (time.Time, error)
+	// CountOperations returns how many operations of the user were screened since the time
+	CountOperations(ctx context.Context, username string, since time.Time) (int64, error)
+}
+
+// Matcher reports whether a rule applies to the operation
+type Matcher func(ctx context.Context, op Operation, history History) (bool, error)
+
+// Rule takes its action on the operations it matches
+type Rule struct {
+	Name string
+	// Action is util.RiskReview or util.RiskDeny
+	Action string
+	Match  Matcher
+}
+
+// Decision is the outcome of screening an operation
+type Decision struct {
+	Action string
+	// Rules are the names of the rules that matched, in the order of the engine
+	Rules []string
+}
+
+// Engine evaluates rules against operations
+type Engine struct {
+	rules []Rule
+}
+
+// NewEngine creates an engine evaluating the rules in order
+func NewEngine(rules ...Rule) *Engine {
+	return &Engine{rules: rules}
+}
+
+// Evaluate runs every rule against the operation. An operation no rule matches is allowed,
+// otherwise the strictest action of the matching rules wins. A rule matches an operation made
+// of parts if it matches the whole or any one of the parts, so the operation is screened once.
+func (engine *Engine) Evaluate(ctx context.Context, op Operation, history History) (Decision, error) {
+	decision := Decision{Action: util.RiskAllow}
+
+	for _, rule := range engine.rules {
+		matched, err := rule.Match(ctx, op, history)
+		for _, part := range op.Parts {
+			if matched || err != nil {
+				break
+			}
+			matched, err = rule.Match(ctx, op.withPart(part), history)
+		}
+		if err != nil {
+			return decision, fmt.Errorf("failed to evaluate rule %s: %w", rule.Name, err)
+		}
+		if !matched {
+			continue
+		}
+
+		decision.Rules = append(decision.Rules, rule.Name)
+		if severity(rule.Action) > severity(decision.Action) {
+			decision.Action = rule.Action
+		}
+	}
+
+	return decision, nil
+}
+
+// withPart returns the part of the operation filled in with what the parts share
+func (op Operation) withPart(part Operation) Operation {
+	whole := op
+	whole.ToAccountID = part.ToAccountID
+	whole.Amount = part.Amount
+	whole.Parts = nil
+	return whole
+}
+
+func severity(action string) int {
+	switch action {
+	case util.RiskReview:
+		return 1
+	case util.RiskDeny:
+		return 2
+	}
+	return 0
+}
+
+// AmountAtLeast matches operations moving at least the amount
+func AmountAtLeast(amount int64) Matcher {
+	return func(ctx context.Context, op Operation, history History) (bool, error) {
+		return op.Amount >= amount, nil
+	}
+}
+
+// NewPayee matches transfers of at least minAmount to an account the user never paid before
+func NewPayee(minAmount int64) Matcher {
+	return func(ctx context.Context, op Operation, history History) (bool, error) {
+		if op.ToAccountID == 0 || op.Amount < minAmount {
+			return false, nil
+		}
+		return history.IsNewPayee(ctx, op.Username, op.ToAccountID)
+	}
+}
+
+// NewDevice matches operations of at least minAmount made from a user agent
+// the user first logged in with less than age ago
+func NewDevice(age time.Duration, minAmount int64) Matcher {
+	return func(ctx context.Context, op Operation, history History) (bool, error) {
+		if op.Amount < minAmount {
+			return false, nil
+		}
+
+		firstSeen, err := history.DeviceFirstSeen(ctx, op.Username, op.UserAgent)
+		if err != nil {
+			return false, err
+		}
+		return op.Time.Sub(firstSeen) < age, nil
+	}
+}
+
+// Velocity matches operations of a user who already made max operations within the window
+func Velocity(max int64, window time.Duration) Matcher {
+	return func(ctx context.Context, op Operation, history History) (bool, error) {
+		count, err := history.CountOperations(ctx, op.Username, op.Time.Add(-window))
+		if err != nil {
+			return false, err
+		}
+		return count >= max, nil
+	}
+}
+
+// OddHours matches operations of at least minAmount made from the start hour
+// until the end hour of the day, in UTC. The hours can wrap around midnight.
+func OddHours(start int, end int, minAmount int64) Matcher {
+	return func(ctx context.Context, op Operation, history History) (bool, error) {
+		if op.Amount < minAmount {
+			return false, nil
+		}
+
+		hour := op.Time.UTC().Hour()
+		if start <= end {
+			return hour >= start && hour < end, nil
+		}
+		return hour >= start || hour < end, nil
+	}
+}
@@ -0,0 +1,164 @@
+package risk
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHistory struct {
+	paid      map[int64]bool
+	firstSeen time.Time
+	count     int64
+	err       error
+}
+
+func (history fakeHistory) IsNewPayee(ctx context.Context, username string, accountID int64) (bool, error) {
+	return !history.paid[accountID], history.err
+}
+
+func (history fakeHistory) DeviceFirstSeen(ctx context.Context, username string, userAgent string) (time.Time, error) {
+	return history.firstSeen, history.err
+}
+
+func (history fakeHistory) CountOperations(ctx context.Context, username string, since time.Time) (int64, error) {
+	return history.count, history.err
+}
+
+func TestEvaluate(t *testing.T) {
+	now := time.Date(2024, 3, 1, 14, 0, 0, 0, time.UTC)
+	engine := NewEngine(
+		Rule{Name: "large_amount", Action: util.RiskReview, Match: AmountAtLeast(10_000)},
+		Rule{Name: "huge_amount", Action: util.RiskDeny, Match: AmountAtLeast(100_000)},
+		Rule{Name: "new_payee", Action: util.RiskReview, Match: NewPayee(5_000)},
+		Rule{Name: "new_device", Action: util.RiskReview, Match: NewDevice(time.Hour, 5_000)},
+		Rule{Name: "velocity", Action: util.RiskDeny, Match: Velocity(10, time.Hour)},
+	)
+	known := fakeHistory{paid: map[int64]bool{2: true}, firstSeen: now.Add(-24 * time.Hour)}
+
+	testCases := []struct {
+		name     string
+		op       Operation
+		history  fakeHistory
+		decision Decision
+	}{
+		{
+			name:     "Allow",
+			op:       Operation{AccountID: 1, ToAccountID: 2, Amount: 9_999, Time: now},
+			history:  known,
+			decision: Decision{Action: util.RiskAllow},
+		},
+		{
+			name:     "Review",
+			op:       Operation{AccountID: 1, ToAccountID: 2, Amount: 10_000, Time: now},
+			history:  known,
+			decision: Decision{Action: util.RiskReview, Rules: []string{"large_amount"}},
+		},
+		{
+			name:     "DenyWins",
+			op:       Operation{AccountID: 1, ToAccountID: 2, Amount: 100_000, Time: now},
+			history:  known,
+			decision: Decision{Action: util.RiskDeny, Rules: []string{"large_amount", "huge_amount"}},
+		},
+		{
+			name:     "NewPayee",
+			op:       Operation{AccountID: 1, ToAccountID: 3, Amount: 5_000, Time: now},
+			history:  known,
+			decision: Decision{Action: util.RiskReview, Rules: []string{"new_payee"}},
+		},
+		{
+			name:     "NewPayeeSmallAmount",
+			op:       Operation{AccountID: 1, ToAccountID: 3, Amount: 4_999, Time: now},
+			history:  known,
+			decision: Decision{Action: util.RiskAllow},
+		},
+		{
+			name:     "NoPayee",
+			op:       Operation{AccountID: 1, Amount: 5_000, Time: now},
+			history:  known,
+			decision: Decision{Action: util.RiskAllow},
+		},
+		{
+			name:     "NewDevice",
+			op:       Operation{AccountID: 1, Amount: 5_000, Time: now},
+			history:  fakeHistory{firstSeen: now.Add(-time.Minute)},
+			decision: Decision{Action: util.RiskReview, Rules: []string{"new_device"}},
+		},
+		{
+			name: "PartToNewPayee",
+			op: Operation{AccountID: 1, Amount: 9_000, Time: now, Parts: []Operation{
+				{ToAccountID: 2, Amount: 4_000},
+				{ToAccountID: 3, Amount: 5_000},
+			}},
+			history:  known,
+			decision: Decision{Action: util.RiskReview, Rules: []string{"new_payee"}},
+		},
+		{
+			name: "PartsOverAmount",
+			op: Operation{AccountID: 1, Amount: 12_000, Time: now, Parts: []Operation{
+				{ToAccountID: 2, Amount: 6_000},
+				{ToAccountID: 2, Amount: 6_000},
+			}},
+			history:  known,
+			decision: Decision{Action: util.RiskReview, Rules: []string{"large_amount"}},
+		},
+		{
+			name:     "Velocity",
+			op:       Operation{AccountID: 1, Amount: 1, Time: now},
+			history:  fakeHistory{firstSeen: known.firstSeen, count: 10},
+			decision: Decision{Action: util.RiskDeny, Rules: []string{"velocity"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			decision, err := engine.Evaluate(context.Background(), tc.op, tc.history)
+			require.NoError(t, err)
+			require.Equal(t, tc.decision, decision)
+		})
+	}
+}
+
+func TestEvaluateError(t *testing.T) {
+	engine := NewEngine(Rule{Name: "velocity", Action: util.RiskDeny, Match: Velocity(10, time.Hour)})
+
+	_, err := engine.Evaluate(context.Background(), Operation{}, fakeHistory{err: errors.New("db down")})
+	require.ErrorContains(t, err, "velocity")
+}
+
+func TestEvaluateNoRules(t *testing.T) {
+	decision, err := NewEngine().Evaluate(context.Background(), Operation{Amount: 1}, fakeHistory{})
+	require.NoError(t, err)
+	require.Equal(t, Decision{Action: util.RiskAllow}, decision)
+}
+
+func TestOddHours(t *testing.T) {
+	testCases := []struct {
+		name    string
+		start   int
+		end     int
+		hour    int
+		matched bool
+	}{
+		{name: "Inside", start: 1, end: 5, hour: 3, matched: true},
+		{name: "Start", start: 1, end: 5, hour: 1, matched: true},
+		{name: "End", start: 1, end: 5, hour: 5},
+		{name: "Outside", start: 1, end: 5, hour: 12},
+		{name: "WrapLate", start: 23, end: 5, hour: 23, matched: true},
+		{name: "WrapEarly", start: 23, end: 5, hour: 2, matched: true},
+		{name: "WrapOutside", start: 23, end: 5, hour: 12},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			op := Operation{Amount: 100, Time: time.Date(2024, 3, 1, tc.hour, 30, 0, 0, time.UTC)}
+			matched, err := OddHours(tc.start, tc.end, 100)(context.Background(), op, fakeHistory{})
+			require.NoError(t, err)
+			require.Equal(t, tc.matched, matched)
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package statement
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/JaidenShall/simplebank/currency"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+)
+
+var csvHeader = []string{"date", "entry_id", "memo", "reference", "category", "amount", "balance"}
+
+// writeCSV writes one row per entry between an opening and a closing balance row
+func writeCSV(w io.Writer, cur currency.Currency, statement db.Statement) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write(csvHeader)
+	if err != nil {
+		return err
+	}
+
+	err = writer.Write([]string{
+		statement.FromTime.UTC().Format(time.RFC3339), "", "Opening balance", "", "", "", cur.Format(statement.OpeningBalance),
+	})
+	if err != nil {
+		return err
+	}
+
+	balances := runningBalances(statement)
+	for i, entry := range statement.Entries {
+		err = writer.Write([]string{
+			entry.CreatedAt.UTC().Format(time.RFC3339),
+			strconv.FormatInt(entry.ID, 10),
+			entry.Memo,
+			entry.Reference,
+			entry.Category,
+			cur.Format(entry.Amount),
+			cur.Format(balances[i]),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = writer.Write([]string{
+		statement.ToTime.UTC().Format(time.RFC3339), "", "Closing balance", "", "", "", cur.Format(statement.ClosingBalance),
+	})
+	if err != nil {
+		return err
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
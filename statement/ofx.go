@@ -0,0 +1,148 @@
+package statement
+
+import (
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/JaidenShall/simplebank/currency"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+)
+
+// ofxHeader is the processing instructions starting every OFX 2.2 file
+const ofxHeader = `<?xml version="1.0" encoding="UTF-8" standalone="no"?>
+<?OFX OFXHEADER="200" VERSION="220" SECURITY="NONE" OLDFILEUID="NONE" NEWFILEUID="NONE"?>
+`
+
+// ofxBankID identifies the bank in BANKACCTFROM
+const ofxBankID = "SIMPLEBANK"
+
+// ofxTimeLayout is the OFX datetime format, always written in UTC
+const ofxTimeLayout = "20060102150405.000[0:GMT]"
+
+type ofxDocument struct {
+	XMLName xml.Name       `xml:"OFX"`
+	SignOn  ofxSignOn      `xml:"SIGNONMSGSRSV1>SONRS"`
+	Bank    ofxTransaction `xml:"BANKMSGSRSV1>STMTTRNRS"`
+}
+
+type ofxStatus struct {
+	Code     int    `xml:"CODE"`
+	Severity string `xml:"SEVERITY"`
+}
+
+type ofxSignOn struct {
+	Status   ofxStatus `xml:"STATUS"`
+	DTServer string    `xml:"DTSERVER"`
+	Language string    `xml:"LANGUAGE"`
+}
+
+type ofxTransaction struct {
+	TrnUID    string       `xml:"TRNUID"`
+	Status    ofxStatus    `xml:"STATUS"`
+	Statement ofxStatement `xml:"STMTRS"`
+}
+
+type ofxStatement struct {
+	CurDef      string         `xml:"CURDEF"`
+	BankAccount ofxBankAccount `xml:"BANKACCTFROM"`
+	List        ofxList        `xml:"BANKTRANLIST"`
+	LedgerBal   ofxBalance     `xml:"LEDGERBAL"`
+}
+
+type ofxBankAccount struct {
+	BankID   string `xml:"BANKID"`
+	AcctID   string `xml:"ACCTID"`
+	AcctType string `xml:"ACCTTYPE"`
+}
+
+type ofxList struct {
+	DTStart      string           `xml:"DTSTART"`
+	DTEnd        string           `xml:"DTEND"`
+	Transactions []ofxStatementTx `xml:"STMTTRN"`
+}
+
+type ofxStatementTx struct {
+	TrnType  string `xml:"TRNTYPE"`
+	DTPosted string `xml:"DTPOSTED"`
+	TrnAmt   string `xml:"TRNAMT"`
+	FitID    string `xml:"FITID"`
+	RefNum   string `xml:"REFNUM,omitempty"`
+	Memo     string `xml:"MEMO,omitempty"`
+}
+
+type ofxBalance struct {
+	BalAmt string `xml:"BALAMT"`
+	DTAsOf string `xml:"DTASOF"`
+}
+
+// writeOFX writes the statement as an OFX 2.2 bank statement response generated at now.
+// The entry ids are the FITIDs, so importing the same period twice doesn't duplicate anything.
+func writeOFX(w io.Writer, cur currency.Currency, statement db.Statement, now time.Time) error {
+	ok := ofxStatus{Code: 0, Severity: "INFO"}
+
+	transactions := make([]ofxStatementTx, len(statement.Entries))
+	for i, entry := range statement.Entries {
+		trnType := "CREDIT"
+		if entry.Amount < 0 {
+			trnType = "DEBIT"
+		}
+		transactions[i] = ofxStatementTx{
+			TrnType:  trnType,
+			DTPosted: ofxTime(entry.CreatedAt),
+			TrnAmt:   cur.Format(entry.Amount),
+			FitID:    strconv.FormatInt(entry.ID, 10),
+			RefNum:   entry.Reference,
+			Memo:     entry.Memo,
+		}
+	}
+
+	doc := ofxDocument{
+		SignOn: ofxSignOn{
+			Status:   ok,
+			DTServer: ofxTime(now),
+			Language: "ENG",
+		},
+		Bank: ofxTransaction{
+			TrnUID: "0",
+			Status: ok,
+			Statement: ofxStatement{
+				CurDef: cur.Code,
+				BankAccount: ofxBankAccount{
+					BankID:   ofxBankID,
+					AcctID:   strconv.FormatInt(statement.Account.ID, 10),
+					AcctType: "CHECKING",
+				},
+				List: ofxList{
+					DTStart:      ofxTime(statement.FromTime),
+					DTEnd:        ofxTime(statement.ToTime),
+					Transactions: transactions,
+				},
+				LedgerBal: ofxBalance{
+					BalAmt: cur.Format(statement.ClosingBalance),
+					DTAsOf: ofxTime(statement.ToTime),
+				},
+			},
+		},
+	}
+
+	_, err := io.WriteString(w, ofxHeader)
+	if err != nil {
+		return err
+	}
+
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+	err = encoder.Encode(doc)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+func ofxTime(t time.Time) string {
+	return t.UTC().Format(ofxTimeLayout)
+}
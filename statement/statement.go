@@ -0,0 +1,63 @@
+// Package statement renders account statements as files that customers and their
+// accountants can import or print: CSV, OFX 2.x and PDF.
+package statement
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/JaidenShall/simplebank/currency"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/util"
+)
+
+// Write renders the statement in the format to w. It writes as it goes,
+// so w can be sent to the client while the statement is being rendered.
+func Write(w io.Writer, format string, statement db.Statement) error {
+	cur, ok := currency.Lookup(statement.Account.Currency)
+	if !ok {
+		return fmt.Errorf("unknown currency %q", statement.Account.Currency)
+	}
+
+	switch format {
+	case util.StatementCSV:
+		return writeCSV(w, cur, statement)
+	case util.StatementOFX:
+		return writeOFX(w, cur, statement, time.Now())
+	case util.StatementPDF:
+		return writePDF(w, cur, statement)
+	}
+	return fmt.Errorf("unsupported statement format %q", format)
+}
+
+// ContentType returns the media type of statements in the format
+func ContentType(format string) string {
+	switch format {
+	case util.StatementCSV:
+		return "text/csv; charset=utf-8"
+	case util.StatementOFX:
+		return "application/x-ofx"
+	case util.StatementPDF:
+		return "application/pdf"
+	}
+	return "application/octet-stream"
+}
+
+// FileName returns the name the statement is downloaded or attached as,
+// e.g. statement-42-2024-01-01-2024-02-01.pdf
+func FileName(statement db.Statement, format string) string {
+	return fmt.Sprintf("statement-%d-%s-%s.%s", statement.Account.ID,
+		statement.FromTime.UTC().Format(time.DateOnly), statement.ToTime.UTC().Format(time.DateOnly), format)
+}
+
+// runningBalances returns the balance of the account after each entry of the statement
+func runningBalances(statement db.Statement) []int64 {
+	balances := make([]int64, len(statement.Entries))
+	balance := statement.OpeningBalance
+	for i, entry := range statement.Entries {
+		balance += entry.Amount
+		balances[i] = balance
+	}
+	return balances
+}
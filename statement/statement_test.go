@@ -0,0 +1,152 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JaidenShall/simplebank/currency"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/stretchr/testify/require"
+)
+
+func testStatement(entries int) db.Statement {
+	fromTime := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	statement := db.Statement{
+		Account: db.Account{
+			ID:       42,
+			Owner:    "alice",
+			Balance:  20000,
+			Currency: util.USD,
+		},
+		FromTime:       fromTime,
+		ToTime:         fromTime.AddDate(0, 1, 0),
+		OpeningBalance: 10000,
+	}
+
+	balance := statement.OpeningBalance
+	for i := 0; i < entries; i++ {
+		amount := int64(1250)
+		if i%2 == 1 {
+			amount = -250
+		}
+		balance += amount
+		statement.Entries = append(statement.Entries, db.Entry{
+			ID:        int64(i + 1),
+			AccountID: statement.Account.ID,
+			Amount:    amount,
+			CreatedAt: fromTime.Add(time.Duration(i+1) * time.Hour),
+			Memo:      "Rent, (flat) \"Main St\"",
+			Reference: "INV-" + strconv.Itoa(i+1),
+			Category:  "housing",
+		})
+	}
+	statement.ClosingBalance = balance
+	return statement
+}
+
+func TestWriteCSV(t *testing.T) {
+	statement := testStatement(2)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, util.StatementCSV, statement))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 5)
+	require.Equal(t, csvHeader, records[0])
+	require.Equal(t, []string{"2024-01-01T00:00:00Z", "", "Opening balance", "", "", "", "100.00"}, records[1])
+	require.Equal(t, []string{"2024-01-01T01:00:00Z", "1", "Rent, (flat) \"Main St\"", "INV-1", "housing", "12.50", "112.50"}, records[2])
+	require.Equal(t, []string{"2024-01-01T02:00:00Z", "2", "Rent, (flat) \"Main St\"", "INV-2", "housing", "-2.50", "110.00"}, records[3])
+	require.Equal(t, []string{"2024-02-01T00:00:00Z", "", "Closing balance", "", "", "", "110.00"}, records[4])
+}
+
+func TestWriteOFX(t *testing.T) {
+	statement := testStatement(2)
+
+	var buf bytes.Buffer
+	now := time.Date(2024, time.February, 1, 6, 0, 0, 0, time.UTC)
+	require.NoError(t, writeOFX(&buf, mustLookup(t, util.USD), statement, now))
+
+	out := buf.String()
+	require.True(t, strings.HasPrefix(out, `<?xml version="1.0"`))
+	require.Contains(t, out, `<?OFX OFXHEADER="200" VERSION="220"`)
+
+	var doc ofxDocument
+	require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+	require.Equal(t, "20240201060000.000[0:GMT]", doc.SignOn.DTServer)
+
+	stmt := doc.Bank.Statement
+	require.Equal(t, util.USD, stmt.CurDef)
+	require.Equal(t, "42", stmt.BankAccount.AcctID)
+	require.Equal(t, "20240101000000.000[0:GMT]", stmt.List.DTStart)
+	require.Equal(t, "20240201000000.000[0:GMT]", stmt.List.DTEnd)
+	require.Equal(t, "110.00", stmt.LedgerBal.BalAmt)
+
+	require.Len(t, stmt.List.Transactions, 2)
+	require.Equal(t, ofxStatementTx{
+		TrnType:  "CREDIT",
+		DTPosted: "20240101010000.000[0:GMT]",
+		TrnAmt:   "12.50",
+		FitID:    "1",
+		RefNum:   "INV-1",
+		Memo:     "Rent, (flat) \"Main St\"",
+	}, stmt.List.Transactions[0])
+	require.Equal(t, "DEBIT", stmt.List.Transactions[1].TrnType)
+	require.Equal(t, "-2.50", stmt.List.Transactions[1].TrnAmt)
+}
+
+func TestWritePDF(t *testing.T) {
+	// enough entries for several pages
+	statement := testStatement(150)
+
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, util.StatementPDF, statement))
+
+	out := buf.Bytes()
+	require.True(t, bytes.HasPrefix(out, []byte("%PDF-1.4\n")))
+	require.True(t, bytes.HasSuffix(out, []byte("%%EOF\n")))
+	require.Contains(t, string(out), `Rent, \(flat\) "Main St"`)
+	require.Contains(t, string(out), "/Count 3")
+
+	// every cross-reference entry points at the object it lists
+	xref := regexp.MustCompile(`(?m)^(\d{10}) 00000 n $`).FindAllSubmatch(out, -1)
+	require.Len(t, xref, 3+2*3)
+	for i, match := range xref {
+		offset, err := strconv.Atoi(string(match[1]))
+		require.NoError(t, err)
+		require.True(t, bytes.HasPrefix(out[offset:], []byte(strconv.Itoa(i+1)+" 0 obj\n")))
+	}
+
+	startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(out)
+	require.NotNil(t, startxref)
+	offset, err := strconv.Atoi(string(startxref[1]))
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(out[offset:], []byte("xref\n")))
+}
+
+func TestPDFString(t *testing.T) {
+	require.Equal(t, `a\(b\)c\\`, pdfString(`a(b)c\`))
+	require.Equal(t, `caf\351 ?`, pdfString("café €"))
+}
+
+func TestWriteUnsupportedFormat(t *testing.T) {
+	var buf bytes.Buffer
+	require.Error(t, Write(&buf, "xls", testStatement(1)))
+}
+
+func TestFileName(t *testing.T) {
+	require.Equal(t, "statement-42-2024-01-01-2024-02-01.pdf", FileName(testStatement(0), util.StatementPDF))
+}
+
+func mustLookup(t *testing.T, code string) currency.Currency {
+	cur, ok := currency.Lookup(code)
+	require.True(t, ok)
+	return cur
+}
@@ -0,0 +1,8 @@
+package util
+
+// Constants for all account statuses
+const (
+	AccountActive = "active"
+	AccountFrozen = "frozen"
+	AccountClosed = "closed"
+)
@@ -0,0 +1,7 @@
+package util
+
+// Constants for all account types
+const (
+	AccountChecking = "checking"
+	AccountSavings  = "savings"
+)
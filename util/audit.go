@@ -0,0 +1,8 @@
+package util
+
+// Constants for all audit log outcomes
+const (
+	AuditSucceeded = "succeeded"
+	AuditFailed    = "failed"
+	AuditDenied    = "denied"
+)
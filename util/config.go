@@ -9,19 +9,54 @@ import (
 // Config stores all configuration of the application.
 // The values are read by viper from a config file or environment variable.
 type Config struct {
-	Environment          string        `mapstructure:"ENVIRONMENT"`
-	DBDriver             string        `mapstructure:"DB_DRIVER"`
-	DBSource             string        `mapstructure:"DB_SOURCE"`
-	MigrationURL         string        `mapstructure:"MIGRATION_URL"`
-	RedisAddress         string        `mapstructure:"REDIS_ADDRESS"`
-	HTTPServerAddress    string        `mapstructure:"HTTP_SERVER_ADDRESS"`
-	GRPCServerAddress    string        `mapstructure:"GRPC_SERVER_ADDRESS"`
-	TokenSymmetricKey    string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
-	EmailSenderName      string        `mapstructure:"EMAIL_SENDER_NAME"`
-	EmailSenderAddress   string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
-	EmailSenderPassword  string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
-	AccessTokenDuration  time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
-	RefreshTokenDuration time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	Environment                 string        `mapstructure:"ENVIRONMENT"`
+	DBDriver                    string        `mapstructure:"DB_DRIVER"`
+	DBSource                    string        `mapstructure:"DB_SOURCE"`
+	MigrationURL                string        `mapstructure:"MIGRATION_URL"`
+	RedisAddress                string        `mapstructure:"REDIS_ADDRESS"`
+	HTTPServerAddress           string        `mapstructure:"HTTP_SERVER_ADDRESS"`
+	GRPCServerAddress           string        `mapstructure:"GRPC_SERVER_ADDRESS"`
+	TokenSymmetricKey           string        `mapstructure:"TOKEN_SYMMETRIC_KEY"`
+	EmailSenderName             string        `mapstructure:"EMAIL_SENDER_NAME"`
+	EmailSenderAddress          string        `mapstructure:"EMAIL_SENDER_ADDRESS"`
+	EmailSenderPassword         string        `mapstructure:"EMAIL_SENDER_PASSWORD"`
+	AccessTokenDuration         time.Duration `mapstructure:"ACCESS_TOKEN_DURATION"`
+	RefreshTokenDuration        time.Duration `mapstructure:"REFRESH_TOKEN_DURATION"`
+	FxQuoteDuration             time.Duration `mapstructure:"FX_QUOTE_DURATION"`
+	EnabledCurrencies           []string      `mapstructure:"ENABLED_CURRENCIES"`
+	HoldDuration                time.Duration `mapstructure:"HOLD_DURATION"`
+	HoldReleaseInterval         time.Duration `mapstructure:"HOLD_RELEASE_INTERVAL"`
+	ScheduledTransferInterval   time.Duration `mapstructure:"SCHEDULED_TRANSFER_INTERVAL"`
+	ScheduledTransferMaxRetries int32         `mapstructure:"SCHEDULED_TRANSFER_MAX_RETRIES"`
+	ScheduledTransferRetryDelay time.Duration `mapstructure:"SCHEDULED_TRANSFER_RETRY_DELAY"`
+	TransferBatchMaxLegs        int           `mapstructure:"TRANSFER_BATCH_MAX_LEGS"`
+	StatementEmailSchedule      string        `mapstructure:"STATEMENT_EMAIL_SCHEDULE"`
+	ReconciliationSchedule      string        `mapstructure:"RECONCILIATION_SCHEDULE"`
+	ReconciliationAlertEmails   []string      `mapstructure:"RECONCILIATION_ALERT_EMAILS"`
+	SavingsInterestRateBPS      int32         `mapstructure:"SAVINGS_INTEREST_RATE_BPS"`
+	InterestCapitalizeSchedule  string        `mapstructure:"INTEREST_CAPITALIZE_SCHEDULE"`
+	SpendingLimitCoolingOff     time.Duration `mapstructure:"SPENDING_LIMIT_COOLING_OFF"`
+	// NewPayeeTransferLimit caps what the user sends to someone else's account during the
+	// NewPayeeTransferPeriod after first paying it, in minor units of any currency. 0 is no limit.
+	NewPayeeTransferLimit  int64         `mapstructure:"NEW_PAYEE_TRANSFER_LIMIT"`
+	NewPayeeTransferPeriod time.Duration `mapstructure:"NEW_PAYEE_TRANSFER_PERIOD"`
+	// PaymentRequestDuration is how long a payment request can be answered by default
+	// and at most
+	PaymentRequestDuration       time.Duration `mapstructure:"PAYMENT_REQUEST_DURATION"`
+	PaymentRequestExpiryInterval time.Duration `mapstructure:"PAYMENT_REQUEST_EXPIRY_INTERVAL"`
+	// Risk rules screening money movements, amounts are in minor units of any currency.
+	// A rule whose amount or count is 0 is disabled.
+	RiskReviewAmount    int64         `mapstructure:"RISK_REVIEW_AMOUNT"`
+	RiskDenyAmount      int64         `mapstructure:"RISK_DENY_AMOUNT"`
+	RiskNewPayeeAmount  int64         `mapstructure:"RISK_NEW_PAYEE_AMOUNT"`
+	RiskNewDeviceAmount int64         `mapstructure:"RISK_NEW_DEVICE_AMOUNT"`
+	RiskNewDeviceAge    time.Duration `mapstructure:"RISK_NEW_DEVICE_AGE"`
+	RiskVelocityMax     int64         `mapstructure:"RISK_VELOCITY_MAX"`
+	RiskVelocityWindow  time.Duration `mapstructure:"RISK_VELOCITY_WINDOW"`
+	// Odd hours go from the start hour until the end hour in UTC
+	RiskOddHoursStart  int   `mapstructure:"RISK_ODD_HOURS_START"`
+	RiskOddHoursEnd    int   `mapstructure:"RISK_ODD_HOURS_END"`
+	RiskOddHoursAmount int64 `mapstructure:"RISK_ODD_HOURS_AMOUNT"`
 }
 
 // LoadConfig reads configuration from environment file or variables
@@ -1,17 +1,8 @@
 package util
 
-// Constants for all supported currencies
+// Codes of the currencies enabled by default, see the currency package for the full registry
 const (
 	USD = "USD"
 	EUR = "EUR"
 	CAD = "CAD"
 )
-
-// IsSupportedCurrency returns true if the currency is supported
-func IsSupportedCurrency(currency string) bool {
-	switch currency {
-	case USD, EUR, CAD:
-		return true
-	}
-	return false
-}
@@ -0,0 +1,7 @@
+package util
+
+// Operations a fee schedule can charge
+const (
+	FeeTransfer   = "transfer"
+	FeeWithdrawal = "withdrawal"
+)
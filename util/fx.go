@@ -0,0 +1,76 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+// FXRateScale is the fixed-point scale of exchange rates: a rate of 1.5 is stored as 150000000
+const FXRateScale = 100_000_000
+
+// fxRateDecimals is the number of decimal places FXRateScale can represent
+const fxRateDecimals = 8
+
+// ErrAmountOverflow is returned when a converted amount does not fit in an int64
+var ErrAmountOverflow = errors.New("converted amount is too large")
+
+// ConvertAmount converts an amount in minor units of a currency with fromExponent
+// minor-unit digits into minor units of a currency with toExponent digits. The rate is
+// in major units, scaled by FXRateScale. The result is rounded down to the nearest
+// minor unit, so a conversion never credits more than the rate allows.
+func ConvertAmount(amount int64, rate int64, fromExponent int, toExponent int) (int64, error) {
+	if amount < 0 || rate <= 0 || fromExponent < 0 || toExponent < 0 {
+		return 0, fmt.Errorf("cannot convert amount %d at rate %d", amount, rate)
+	}
+
+	ten := big.NewInt(10)
+	converted := new(big.Int).Mul(big.NewInt(amount), big.NewInt(rate))
+	converted.Mul(converted, new(big.Int).Exp(ten, big.NewInt(int64(toExponent)), nil))
+
+	divisor := new(big.Int).Exp(ten, big.NewInt(int64(fromExponent)), nil)
+	divisor.Mul(divisor, big.NewInt(FXRateScale))
+	converted.Quo(converted, divisor)
+	if !converted.IsInt64() {
+		return 0, ErrAmountOverflow
+	}
+	return converted.Int64(), nil
+}
+
+// ParseFXRate parses a positive decimal rate such as "0.91734" into its scaled value.
+// At most 8 decimal places are accepted, so no precision is silently lost.
+func ParseFXRate(value string) (int64, error) {
+	whole, fraction, _ := strings.Cut(value, ".")
+	if whole == "" || len(fraction) > fxRateDecimals || !isDigits(whole) || !isDigits(fraction) {
+		return 0, fmt.Errorf("invalid rate %q: must be a decimal with at most %d decimal places", value, fxRateDecimals)
+	}
+
+	scaled, ok := new(big.Int).SetString(whole+fraction+strings.Repeat("0", fxRateDecimals-len(fraction)), 10)
+	if !ok || !scaled.IsInt64() {
+		return 0, fmt.Errorf("invalid rate %q: out of range", value)
+	}
+	if scaled.Sign() <= 0 {
+		return 0, fmt.Errorf("invalid rate %q: must be positive", value)
+	}
+	return scaled.Int64(), nil
+}
+
+// FormatFXRate formats a scaled rate as a decimal without trailing zeros
+func FormatFXRate(rate int64) string {
+	whole := rate / FXRateScale
+	fraction := rate % FXRateScale
+	if fraction == 0 {
+		return fmt.Sprintf("%d", whole)
+	}
+	return strings.TrimRight(fmt.Sprintf("%d.%08d", whole, fraction), "0")
+}
+
+func isDigits(value string) bool {
+	for _, c := range value {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,79 @@
+package util
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertAmount(t *testing.T) {
+	testCases := []struct {
+		name         string
+		amount       int64
+		rate         int64
+		fromExponent int
+		toExponent   int
+		want         int64
+	}{
+		{name: "Identity", amount: 12345, rate: FXRateScale, fromExponent: 2, toExponent: 2, want: 12345},
+		{name: "Exact", amount: 1000, rate: 150_000_000, fromExponent: 2, toExponent: 2, want: 1500},
+		{name: "RoundsDown", amount: 999, rate: 91_734_000, fromExponent: 2, toExponent: 2, want: 916},
+		{name: "BelowOneMinorUnit", amount: 1, rate: 50_000_000, fromExponent: 2, toExponent: 2, want: 0},
+		// 12.34 USD at 151.2 JPY per USD is 1865.808 JPY
+		{name: "ToFewerDigits", amount: 1234, rate: 15_120_000_000, fromExponent: 2, toExponent: 0, want: 1865},
+		// 1000 JPY at 0.0021 KWD per JPY is 2.1 KWD
+		{name: "ToMoreDigits", amount: 1000, rate: 210_000, fromExponent: 0, toExponent: 3, want: 2100},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			converted, err := ConvertAmount(tc.amount, tc.rate, tc.fromExponent, tc.toExponent)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, converted)
+		})
+	}
+}
+
+func TestConvertAmountErrors(t *testing.T) {
+	_, err := ConvertAmount(math.MaxInt64, 2*FXRateScale, 2, 2)
+	require.ErrorIs(t, err, ErrAmountOverflow)
+
+	_, err = ConvertAmount(-1, FXRateScale, 2, 2)
+	require.Error(t, err)
+
+	_, err = ConvertAmount(100, 0, 2, 2)
+	require.Error(t, err)
+}
+
+func TestParseFXRate(t *testing.T) {
+	testCases := []struct {
+		value string
+		rate  int64
+		ok    bool
+	}{
+		{value: "1", rate: FXRateScale, ok: true},
+		{value: "0.91734", rate: 91_734_000, ok: true},
+		{value: "1.5", rate: 150_000_000, ok: true},
+		{value: "0.00000001", rate: 1, ok: true},
+		{value: "0.000000001"},
+		{value: "0"},
+		{value: "-1.2"},
+		{value: ".5"},
+		{value: "1e3"},
+		{value: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.value, func(t *testing.T) {
+			rate, err := ParseFXRate(tc.value)
+			if !tc.ok {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.rate, rate)
+			require.Equal(t, tc.value, FormatFXRate(rate))
+		})
+	}
+}
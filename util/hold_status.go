@@ -0,0 +1,9 @@
+package util
+
+// Constants for all hold statuses
+const (
+	HoldActive   = "active"
+	HoldCaptured = "captured"
+	HoldReleased = "released"
+	HoldExpired  = "expired"
+)
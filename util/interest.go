@@ -0,0 +1,28 @@
+package util
+
+import "math/big"
+
+// InterestDaysPerYear is the day count of the annual interest rate, every day earns 1/365 of it
+const InterestDaysPerYear = 365
+
+// InterestRemainderScale is the denominator of the fraction of a unit of interest carried from
+// one day to the next: at an annual rate in basis points, a balance earns
+// balance * rate / InterestRemainderScale units a day
+const InterestRemainderScale = 10_000 * InterestDaysPerYear
+
+// DailyInterest returns the whole units of interest a balance earns in a day at an annual rate
+// in basis points, and the fraction of a unit left over, out of InterestRemainderScale.
+// remainder is the fraction carried from the previous day, so no interest is lost to rounding:
+// a constant balance earns exactly balance * rate / 10000 over a year.
+// A balance that isn't positive earns nothing and carries the remainder as is.
+func DailyInterest(balance int64, rate int32, remainder int64) (amount int64, carry int64) {
+	if balance <= 0 || rate <= 0 {
+		return 0, remainder
+	}
+
+	total := new(big.Int).Mul(big.NewInt(balance), big.NewInt(int64(rate)))
+	total.Add(total, big.NewInt(remainder))
+
+	quotient, modulus := new(big.Int).QuoRem(total, big.NewInt(InterestRemainderScale), new(big.Int))
+	return quotient.Int64(), modulus.Int64()
+}
@@ -0,0 +1,45 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDailyInterest(t *testing.T) {
+	testCases := []struct {
+		name      string
+		balance   int64
+		rate      int32
+		remainder int64
+		amount    int64
+		carry     int64
+	}{
+		{name: "Exact", balance: 1_000_000, rate: 365, amount: 100},
+		{name: "Fraction", balance: 1000, rate: 500, carry: 500_000},
+		{name: "CarryCompletesUnit", balance: 1000, rate: 500, remainder: 3_500_000, amount: 1, carry: 350_000},
+		{name: "Negative", balance: -1000, rate: 500, remainder: 42, carry: 42},
+		{name: "ZeroRate", balance: 1000, remainder: 42, carry: 42},
+		{name: "NoOverflow", balance: 9_000_000_000_000_000_000, rate: 10_000, amount: 24_657_534_246_575_342, carry: 1_700_000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			amount, carry := DailyInterest(tc.balance, tc.rate, tc.remainder)
+			require.Equal(t, tc.amount, amount)
+			require.Equal(t, tc.carry, carry)
+		})
+	}
+}
+
+func TestDailyInterestYear(t *testing.T) {
+	// the fractions carried over a year add up to the annual interest
+	var total, remainder int64
+	for day := 0; day < InterestDaysPerYear; day++ {
+		var amount int64
+		amount, remainder = DailyInterest(1000, 500, remainder)
+		total += amount
+	}
+	require.Equal(t, int64(50), total)
+	require.Zero(t, remainder)
+}
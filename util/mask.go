@@ -0,0 +1,18 @@
+package util
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// MaskName hides a full name behind the first letter of each word, so the person paying
+// can recognize who they are paying without the name being given away to anyone who
+// knows an account number
+func MaskName(fullName string) string {
+	words := strings.Fields(fullName)
+	for i, word := range words {
+		first, _ := utf8.DecodeRuneInString(word)
+		words[i] = string(first) + "***"
+	}
+	return strings.Join(words, " ")
+}
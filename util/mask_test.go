@@ -0,0 +1,27 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaskName(t *testing.T) {
+	testCases := []struct {
+		name     string
+		fullName string
+		masked   string
+	}{
+		{name: "TwoWords", fullName: "Jaiden Shall", masked: "J*** S***"},
+		{name: "OneWord", fullName: "Jaiden", masked: "J***"},
+		{name: "ExtraSpaces", fullName: "  Ana   de  Souza ", masked: "A*** d*** S***"},
+		{name: "Unicode", fullName: "Émile Zoë", masked: "É*** Z***"},
+		{name: "Empty", fullName: "", masked: ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.masked, MaskName(tc.fullName))
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package util
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+)
+
+// Page sizes shared by every paginated list endpoint
+const (
+	DefaultPageSize = 10
+	MaxPageSize     = 50
+)
+
+// EncodePageToken serializes a cursor into an opaque token that is safe to use in URLs
+func EncodePageToken(cursor interface{}) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("cannot encode page token: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// DecodePageToken parses a token produced by EncodePageToken back into the cursor
+func DecodePageToken(token string, cursor interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return fmt.Errorf("cannot decode page token: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(cursor); err != nil {
+		return fmt.Errorf("cannot decode page token: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testCursor struct {
+	Name string `json:"n"`
+	ID   int64  `json:"i"`
+}
+
+func TestPageToken(t *testing.T) {
+	cursor := testCursor{
+		Name: RandomOwner(),
+		ID:   RandomInt(1, 1000),
+	}
+
+	token, err := EncodePageToken(cursor)
+	require.NoError(t, err)
+	require.NotEmpty(t, token)
+
+	var decoded testCursor
+	err = DecodePageToken(token, &decoded)
+	require.NoError(t, err)
+	require.Equal(t, cursor, decoded)
+}
+
+func TestInvalidPageToken(t *testing.T) {
+	var cursor testCursor
+
+	err := DecodePageToken("not a token!", &cursor)
+	require.Error(t, err)
+
+	token, err := EncodePageToken(map[string]string{"unknown": "field"})
+	require.NoError(t, err)
+
+	err = DecodePageToken(token, &cursor)
+	require.Error(t, err)
+}
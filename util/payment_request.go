@@ -0,0 +1,17 @@
+package util
+
+// Constants for all payment request statuses
+const (
+	PaymentRequestPending  = "pending"
+	PaymentRequestAccepted = "accepted"
+	PaymentRequestDeclined = "declined"
+	PaymentRequestExpired  = "expired"
+)
+
+// Which side of a payment request a user lists
+const (
+	// PaymentRequestIncoming are the requests the user is asked to pay
+	PaymentRequestIncoming = "incoming"
+	// PaymentRequestOutgoing are the requests the user sent
+	PaymentRequestOutgoing = "outgoing"
+)
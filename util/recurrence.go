@@ -0,0 +1,90 @@
+package util
+
+import (
+	"fmt"
+	"time"
+)
+
+// Frequencies a recurrence can repeat at
+const (
+	FrequencyOnce    = "once"
+	FrequencyDaily   = "daily"
+	FrequencyWeekly  = "weekly"
+	FrequencyMonthly = "monthly"
+)
+
+// LastDayOfMonth as the DayOfMonth of a monthly recurrence makes it run at the end of every month
+const LastDayOfMonth = -1
+
+// Recurrence describes when something repeats, modelled on a small subset of RRULE:
+// every Interval days, weeks or months counted from the first occurrence.
+// Monthly recurrences fall on DayOfMonth, moved back to the last day of shorter months,
+// or on the last day of every month with LastDayOfMonth. Every occurrence keeps the
+// time of day of the first one.
+type Recurrence struct {
+	Frequency  string
+	Interval   int32
+	DayOfMonth int32
+}
+
+// Validate reports whether the recurrence is well formed
+func (recurrence Recurrence) Validate() error {
+	switch recurrence.Frequency {
+	case FrequencyOnce, FrequencyDaily, FrequencyWeekly:
+		if recurrence.DayOfMonth != 0 {
+			return fmt.Errorf("day of month only applies to a monthly frequency")
+		}
+	case FrequencyMonthly:
+		day := recurrence.DayOfMonth
+		if day != LastDayOfMonth && (day < 1 || day > 31) {
+			return fmt.Errorf("day of month must be between 1 and 31, or %d for the last day", LastDayOfMonth)
+		}
+	default:
+		return fmt.Errorf("unsupported frequency: %s", recurrence.Frequency)
+	}
+
+	if recurrence.Interval < 1 {
+		return fmt.Errorf("interval must be positive")
+	}
+	return nil
+}
+
+// First returns the first occurrence at or after start
+func (recurrence Recurrence) First(start time.Time) time.Time {
+	if recurrence.Frequency != FrequencyMonthly {
+		return start
+	}
+
+	first := recurrence.monthly(start, 0)
+	if first.Before(start) {
+		first = recurrence.monthly(start, 1)
+	}
+	return first
+}
+
+// Next returns the occurrence that follows prev, or false if the recurrence doesn't repeat
+func (recurrence Recurrence) Next(prev time.Time) (time.Time, bool) {
+	switch recurrence.Frequency {
+	case FrequencyDaily:
+		return prev.AddDate(0, 0, int(recurrence.Interval)), true
+	case FrequencyWeekly:
+		return prev.AddDate(0, 0, 7*int(recurrence.Interval)), true
+	case FrequencyMonthly:
+		return recurrence.monthly(prev, recurrence.Interval), true
+	}
+	return time.Time{}, false
+}
+
+// monthly returns the occurrence the given number of months after the month of t,
+// at the time of day of t
+func (recurrence Recurrence) monthly(t time.Time, months int32) time.Time {
+	year, month, _ := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, t.Hour(), t.Minute(), t.Second(), 0, t.Location())
+
+	day := int(recurrence.DayOfMonth)
+	lastDay := first.AddDate(0, 1, -1).Day()
+	if day == LastDayOfMonth || day > lastDay {
+		day = lastDay
+	}
+	return first.AddDate(0, 0, day-1)
+}
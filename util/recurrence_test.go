@@ -0,0 +1,104 @@
+package util
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 9, 30, 0, 0, time.UTC)
+}
+
+func TestRecurrenceValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		recurrence Recurrence
+		valid      bool
+	}{
+		{name: "Once", recurrence: Recurrence{Frequency: FrequencyOnce, Interval: 1}, valid: true},
+		{name: "EveryTwoWeeks", recurrence: Recurrence{Frequency: FrequencyWeekly, Interval: 2}, valid: true},
+		{name: "MonthlyOnDay", recurrence: Recurrence{Frequency: FrequencyMonthly, Interval: 1, DayOfMonth: 31}, valid: true},
+		{name: "EndOfMonth", recurrence: Recurrence{Frequency: FrequencyMonthly, Interval: 1, DayOfMonth: LastDayOfMonth}, valid: true},
+		{name: "UnknownFrequency", recurrence: Recurrence{Frequency: "yearly", Interval: 1}},
+		{name: "ZeroInterval", recurrence: Recurrence{Frequency: FrequencyDaily}},
+		{name: "DayOfMonthTooLarge", recurrence: Recurrence{Frequency: FrequencyMonthly, Interval: 1, DayOfMonth: 32}},
+		{name: "DayOfMonthMissing", recurrence: Recurrence{Frequency: FrequencyMonthly, Interval: 1}},
+		{name: "DayOfMonthNotMonthly", recurrence: Recurrence{Frequency: FrequencyDaily, Interval: 1, DayOfMonth: 5}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.recurrence.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestRecurrenceNext(t *testing.T) {
+	testCases := []struct {
+		name       string
+		recurrence Recurrence
+		start      time.Time
+		want       []time.Time
+	}{
+		{
+			name:       "Daily",
+			recurrence: Recurrence{Frequency: FrequencyDaily, Interval: 1},
+			start:      date(2024, time.February, 28),
+			want:       []time.Time{date(2024, time.February, 28), date(2024, time.February, 29), date(2024, time.March, 1)},
+		},
+		{
+			name:       "EveryTwoWeeks",
+			recurrence: Recurrence{Frequency: FrequencyWeekly, Interval: 2},
+			start:      date(2024, time.December, 20),
+			want:       []time.Time{date(2024, time.December, 20), date(2025, time.January, 3), date(2025, time.January, 17)},
+		},
+		{
+			name:       "MonthlyOnShortMonths",
+			recurrence: Recurrence{Frequency: FrequencyMonthly, Interval: 1, DayOfMonth: 31},
+			start:      date(2025, time.January, 10),
+			want:       []time.Time{date(2025, time.January, 31), date(2025, time.February, 28), date(2025, time.March, 31), date(2025, time.April, 30)},
+		},
+		{
+			name:       "MonthlyDayAlreadyPassed",
+			recurrence: Recurrence{Frequency: FrequencyMonthly, Interval: 3, DayOfMonth: 5},
+			start:      date(2025, time.November, 10),
+			want:       []time.Time{date(2025, time.December, 5), date(2026, time.March, 5), date(2026, time.June, 5)},
+		},
+		{
+			name:       "EndOfMonth",
+			recurrence: Recurrence{Frequency: FrequencyMonthly, Interval: 1, DayOfMonth: LastDayOfMonth},
+			start:      date(2024, time.January, 31),
+			want:       []time.Time{date(2024, time.January, 31), date(2024, time.February, 29), date(2024, time.March, 31)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			occurrence := tc.recurrence.First(tc.start)
+			require.Equal(t, tc.want[0], occurrence)
+
+			for _, want := range tc.want[1:] {
+				var ok bool
+				occurrence, ok = tc.recurrence.Next(occurrence)
+				require.True(t, ok)
+				require.Equal(t, want, occurrence)
+			}
+		})
+	}
+}
+
+func TestRecurrenceOnce(t *testing.T) {
+	recurrence := Recurrence{Frequency: FrequencyOnce, Interval: 1}
+	start := date(2025, time.June, 1)
+
+	require.Equal(t, start, recurrence.First(start))
+	_, ok := recurrence.Next(start)
+	require.False(t, ok)
+}
@@ -0,0 +1,22 @@
+package util
+
+// Actions a risk decision can take, from the most to the least permissive
+const (
+	RiskAllow  = "allow"
+	RiskReview = "review"
+	RiskDeny   = "deny"
+)
+
+// Statuses of a money movement held for review
+const (
+	RiskReviewPending  = "pending"
+	RiskReviewApproved = "approved"
+	RiskReviewRejected = "rejected"
+)
+
+// Money movements screened by the risk rules
+const (
+	RiskTransfer   = "transfer"
+	RiskWithdrawal = "withdrawal"
+	RiskDeposit    = "deposit"
+)
@@ -0,0 +1,17 @@
+package util
+
+// Constants for all user roles
+const (
+	CustomerRole = "customer"
+	SupportRole  = "support"
+	AdminRole    = "admin"
+)
+
+// IsSupportedRole returns true if the role is supported
+func IsSupportedRole(role string) bool {
+	switch role {
+	case CustomerRole, SupportRole, AdminRole:
+		return true
+	}
+	return false
+}
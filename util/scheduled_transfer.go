@@ -0,0 +1,24 @@
+package util
+
+// Constants for all scheduled transfer statuses
+const (
+	ScheduleActive    = "active"
+	SchedulePaused    = "paused"
+	ScheduleCompleted = "completed"
+	ScheduleCancelled = "cancelled"
+)
+
+// What a scheduled transfer does when a run can't be made,
+// such as when the source account has insufficient funds
+const (
+	FailurePolicySkip  = "skip"
+	FailurePolicyRetry = "retry"
+)
+
+// Outcomes of a scheduled transfer run
+const (
+	RunSucceeded = "succeeded"
+	RunRetrying  = "retrying"
+	RunSkipped   = "skipped"
+	RunFailed    = "failed"
+)
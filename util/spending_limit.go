@@ -0,0 +1,57 @@
+package util
+
+import "time"
+
+// Scopes of spending limits
+const (
+	LimitScopeAccount = "account"
+	LimitScopeUser    = "user"
+)
+
+// Constants for all spending limits
+const (
+	LimitPerTransaction = "per_transaction"
+	LimitDaily          = "daily"
+	LimitMonthly        = "monthly"
+)
+
+// Rolling windows the daily and monthly limits are checked over
+const (
+	DailyLimitWindow   = 24 * time.Hour
+	MonthlyLimitWindow = 30 * 24 * time.Hour
+)
+
+// SpendingLimits caps the money leaving an account, or all the accounts of a user in one currency.
+// Amounts are in minor units of the currency, a limit of 0 is no limit.
+type SpendingLimits struct {
+	PerTransaction int64 `json:"per_transaction"`
+	Daily          int64 `json:"daily"`
+	Monthly        int64 `json:"monthly"`
+}
+
+// Raises reports whether any limit of next lets more money out than the same limit of limits
+func (limits SpendingLimits) Raises(next SpendingLimits) bool {
+	return looserLimit(next.PerTransaction, limits.PerTransaction) ||
+		looserLimit(next.Daily, limits.Daily) ||
+		looserLimit(next.Monthly, limits.Monthly)
+}
+
+// Tightest returns the lower of each limit of limits and other
+func (limits SpendingLimits) Tightest(other SpendingLimits) SpendingLimits {
+	return SpendingLimits{
+		PerTransaction: tighterLimit(limits.PerTransaction, other.PerTransaction),
+		Daily:          tighterLimit(limits.Daily, other.Daily),
+		Monthly:        tighterLimit(limits.Monthly, other.Monthly),
+	}
+}
+
+func looserLimit(limit int64, than int64) bool {
+	return than != 0 && (limit == 0 || limit > than)
+}
+
+func tighterLimit(limit1 int64, limit2 int64) int64 {
+	if looserLimit(limit1, limit2) {
+		return limit2
+	}
+	return limit1
+}
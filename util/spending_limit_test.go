@@ -0,0 +1,56 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpendingLimits(t *testing.T) {
+	current := SpendingLimits{PerTransaction: 1000, Daily: 5000}
+
+	testCases := []struct {
+		name     string
+		next     SpendingLimits
+		raises   bool
+		tightest SpendingLimits
+	}{
+		{
+			name:     "Same",
+			next:     current,
+			tightest: current,
+		},
+		{
+			name:     "Lower",
+			next:     SpendingLimits{PerTransaction: 500, Daily: 5000, Monthly: 20000},
+			tightest: SpendingLimits{PerTransaction: 500, Daily: 5000, Monthly: 20000},
+		},
+		{
+			name:     "Higher",
+			next:     SpendingLimits{PerTransaction: 2000, Daily: 5000},
+			raises:   true,
+			tightest: current,
+		},
+		{
+			name:     "Removed",
+			next:     SpendingLimits{PerTransaction: 1000},
+			raises:   true,
+			tightest: current,
+		},
+		{
+			name:     "Mixed",
+			next:     SpendingLimits{PerTransaction: 200, Daily: 8000, Monthly: 20000},
+			raises:   true,
+			tightest: SpendingLimits{PerTransaction: 200, Daily: 5000, Monthly: 20000},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.raises, current.Raises(tc.next))
+			require.Equal(t, tc.tightest, current.Tightest(tc.next))
+		})
+	}
+
+	require.False(t, SpendingLimits{}.Raises(SpendingLimits{Daily: 100}))
+}
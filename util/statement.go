@@ -0,0 +1,13 @@
+package util
+
+import "time"
+
+// Constants for all statement file formats
+const (
+	StatementCSV = "csv"
+	StatementOFX = "ofx"
+	StatementPDF = "pdf"
+)
+
+// MaxStatementPeriod is the longest period a single statement can cover
+const MaxStatementPeriod = 366 * 24 * time.Hour
@@ -0,0 +1,10 @@
+package util
+
+// Codes of the internal accounts the ledger posts against
+const (
+	SystemCashVault       = "cash_vault"
+	SystemFeesIncome      = "fees_income"
+	SystemFXPosition      = "fx_position"
+	SystemInterestExpense = "interest_expense"
+	SystemSuspense        = "suspense"
+)
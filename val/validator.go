@@ -4,13 +4,22 @@ import (
 	"fmt"
 	"net/mail"
 	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/JaidenShall/simplebank/currency"
 	"github.com/JaidenShall/simplebank/util"
+	"github.com/google/uuid"
 )
 
 var (
-	isValidUsername = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
-	isValidFullName = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidUsername       = regexp.MustCompile(`^[a-z0-9_]+$`).MatchString
+	isValidFullName       = regexp.MustCompile(`^[a-zA-Z\s]+$`).MatchString
+	isValidIdempotencyKey = regexp.MustCompile(`^[a-zA-Z0-9_\-:.]+$`).MatchString
+	isValidReference      = regexp.MustCompile(`^[a-zA-Z0-9_\-:./#]+$`).MatchString
+	isValidCategory       = regexp.MustCompile(`^[a-z0-9_\-]+$`).MatchString
 )
 
 func ValidateString(value string, minLength int, maxLength int) error {
@@ -70,12 +79,20 @@ func ValidateCurrency(value string) error {
 	if err := ValidateString(value, 3, 3); err != nil {
 		return fmt.Errorf("must be exactly 3 characters")
 	}
-	if !util.IsSupportedCurrency(value) {
+	if !currency.IsEnabled(value) {
 		return fmt.Errorf("currency not supported")
 	}
 	return nil
 }
 
+func ValidateAccountType(value string) error {
+	switch value {
+	case util.AccountChecking, util.AccountSavings:
+		return nil
+	}
+	return fmt.Errorf("must be either checking or savings")
+}
+
 func ValidateAccountID(value int64) error {
 	if value <= 0 {
 		return fmt.Errorf("must be a positive integer")
@@ -89,3 +106,238 @@ func ValidateAmount(value int64) error {
 	}
 	return nil
 }
+
+func ValidateIdempotencyKey(value string) error {
+	if err := ValidateString(value, 1, 255); err != nil {
+		return err
+	}
+	if !isValidIdempotencyKey(value) {
+		return fmt.Errorf("must contain only letters, digits, dashes, underscores, colons or dots")
+	}
+	return nil
+}
+
+func ValidatePageSize(value int32) error {
+	if value < 0 || value > util.MaxPageSize {
+		return fmt.Errorf("must be between 1 and %d, or 0 for the default", util.MaxPageSize)
+	}
+	return nil
+}
+
+func ValidateAccountOrderBy(value string) error {
+	switch value {
+	case "", "created_at", "balance":
+		return nil
+	}
+	return fmt.Errorf("must be either created_at or balance")
+}
+
+func ValidateTransferID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateHoldID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateRiskDecisionID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateSessionID(value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return fmt.Errorf("must be a valid UUID")
+	}
+	return nil
+}
+
+func ValidateSearchQuery(value string) error {
+	return ValidateString(value, 2, 100)
+}
+
+func ValidateReason(value string) error {
+	return ValidateString(value, 3, 200)
+}
+
+func ValidateFxRate(value string) error {
+	_, err := util.ParseFXRate(value)
+	return err
+}
+
+func ValidateFxQuoteID(value string) error {
+	if _, err := uuid.Parse(value); err != nil {
+		return fmt.Errorf("must be a valid UUID")
+	}
+	return nil
+}
+
+func ValidateScheduledTransferID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateFrequency(value string) error {
+	switch value {
+	case util.FrequencyOnce, util.FrequencyDaily, util.FrequencyWeekly, util.FrequencyMonthly:
+		return nil
+	}
+	return fmt.Errorf("must be once, daily, weekly or monthly")
+}
+
+func ValidateInterval(value int32) error {
+	if value < 1 || value > 366 {
+		return fmt.Errorf("must be between 1 and 366")
+	}
+	return nil
+}
+
+func ValidateDayOfMonth(frequency string, value int32) error {
+	if frequency != util.FrequencyMonthly {
+		if value != 0 {
+			return fmt.Errorf("only applies to monthly transfers")
+		}
+		return nil
+	}
+	if value != util.LastDayOfMonth && (value < 1 || value > 31) {
+		return fmt.Errorf("must be between 1 and 31, or %d for the last day of the month", util.LastDayOfMonth)
+	}
+	return nil
+}
+
+func ValidateFailurePolicy(value string) error {
+	switch value {
+	case util.FailurePolicySkip, util.FailurePolicyRetry:
+		return nil
+	}
+	return fmt.Errorf("must be either skip or retry")
+}
+
+func ValidateScheduleStatus(value string) error {
+	switch value {
+	case util.ScheduleActive, util.SchedulePaused:
+		return nil
+	}
+	return fmt.Errorf("must be either active or paused")
+}
+
+func ValidateMemo(value string) error {
+	if err := ValidateString(value, 1, 200); err != nil {
+		return err
+	}
+	if !utf8.ValidString(value) || strings.IndexFunc(value, unicode.IsControl) >= 0 {
+		return fmt.Errorf("must not contain control characters")
+	}
+	return nil
+}
+
+func ValidateReference(value string) error {
+	if err := ValidateString(value, 1, 64); err != nil {
+		return err
+	}
+	if !isValidReference(value) {
+		return fmt.Errorf("must contain only letters, digits, or the characters _-:./#")
+	}
+	return nil
+}
+
+func ValidateCategory(value string) error {
+	if err := ValidateString(value, 1, 32); err != nil {
+		return err
+	}
+	if !isValidCategory(value) {
+		return fmt.Errorf("must contain only lowercase letters, digits, underscore, or hyphen")
+	}
+	return nil
+}
+
+func ValidateStatementFormat(value string) error {
+	switch value {
+	case util.StatementCSV, util.StatementOFX, util.StatementPDF:
+		return nil
+	}
+	return fmt.Errorf("must be csv, ofx or pdf")
+}
+
+func ValidateStatementPeriod(fromTime time.Time, toTime time.Time) error {
+	if !fromTime.Before(toTime) {
+		return fmt.Errorf("must be after from_time")
+	}
+	if toTime.Sub(fromTime) > util.MaxStatementPeriod {
+		return fmt.Errorf("must be at most %d days after from_time", util.MaxStatementPeriod/(24*time.Hour))
+	}
+	return nil
+}
+
+func ValidateFeeOperation(value string) error {
+	switch value {
+	case util.FeeTransfer, util.FeeWithdrawal:
+		return nil
+	}
+	return fmt.Errorf("must be either transfer or withdrawal")
+}
+
+func ValidateSpendingLimit(value int64) error {
+	if value < 0 {
+		return fmt.Errorf("must not be negative, 0 is no limit")
+	}
+	return nil
+}
+
+func ValidateRiskReviewStatus(value string) error {
+	switch value {
+	case util.RiskReviewPending, util.RiskReviewApproved, util.RiskReviewRejected:
+		return nil
+	}
+	return fmt.Errorf("must be pending, approved or rejected")
+}
+
+func ValidatePayeeID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidateNickname(value string) error {
+	if err := ValidateString(strings.TrimSpace(value), 1, 50); err != nil {
+		return err
+	}
+	if !utf8.ValidString(value) || strings.IndexFunc(value, unicode.IsControl) >= 0 {
+		return fmt.Errorf("must not contain control characters")
+	}
+	return nil
+}
+
+func ValidatePaymentRequestID(value int64) error {
+	if value <= 0 {
+		return fmt.Errorf("must be a positive integer")
+	}
+	return nil
+}
+
+func ValidatePaymentRequestStatus(value string) error {
+	switch value {
+	case util.PaymentRequestPending, util.PaymentRequestAccepted, util.PaymentRequestDeclined, util.PaymentRequestExpired:
+		return nil
+	}
+	return fmt.Errorf("must be pending, accepted, declined or expired")
+}
+
+func ValidatePaymentRequestDirection(value string) error {
+	switch value {
+	case util.PaymentRequestIncoming, util.PaymentRequestOutgoing:
+		return nil
+	}
+	return fmt.Errorf("must be incoming or outgoing")
+}
@@ -12,6 +12,21 @@ type TaskDistributor interface {
 		payload *PayloadSendVerifyEmail,
 		opts ...asynq.Option,
 	) error
+	DistributeTaskSendStatement(
+		ctx context.Context,
+		payload *PayloadSendStatement,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskAccrueInterest(
+		ctx context.Context,
+		payload *PayloadAccrueInterest,
+		opts ...asynq.Option,
+	) error
+	DistributeTaskSendPaymentRequestEmail(
+		ctx context.Context,
+		payload *PayloadSendPaymentRequestEmail,
+		opts ...asynq.Option,
+	) error
 }
 
 type RedisTaskDistributor struct {
@@ -36,6 +36,63 @@ func (m *MockTaskDistributor) EXPECT() *MockTaskDistributorMockRecorder {
 	return m.recorder
 }
 
+// DistributeTaskAccrueInterest mocks base method.
+func (m *MockTaskDistributor) DistributeTaskAccrueInterest(arg0 context.Context, arg1 *worker.PayloadAccrueInterest, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskAccrueInterest", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskAccrueInterest indicates an expected call of DistributeTaskAccrueInterest.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskAccrueInterest(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskAccrueInterest", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskAccrueInterest), varargs...)
+}
+
+// DistributeTaskSendPaymentRequestEmail mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendPaymentRequestEmail(arg0 context.Context, arg1 *worker.PayloadSendPaymentRequestEmail, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendPaymentRequestEmail", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendPaymentRequestEmail indicates an expected call of DistributeTaskSendPaymentRequestEmail.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendPaymentRequestEmail(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendPaymentRequestEmail", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendPaymentRequestEmail), varargs...)
+}
+
+// DistributeTaskSendStatement mocks base method.
+func (m *MockTaskDistributor) DistributeTaskSendStatement(arg0 context.Context, arg1 *worker.PayloadSendStatement, arg2 ...asynq.Option) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DistributeTaskSendStatement", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DistributeTaskSendStatement indicates an expected call of DistributeTaskSendStatement.
+func (mr *MockTaskDistributorMockRecorder) DistributeTaskSendStatement(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DistributeTaskSendStatement", reflect.TypeOf((*MockTaskDistributor)(nil).DistributeTaskSendStatement), varargs...)
+}
+
 // DistributeTaskSendVerifyEmail mocks base method.
 func (m *MockTaskDistributor) DistributeTaskSendVerifyEmail(arg0 context.Context, arg1 *worker.PayloadSendVerifyEmail, arg2 ...asynq.Option) error {
 	m.ctrl.T.Helper()
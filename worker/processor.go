@@ -5,6 +5,8 @@ import (
 
 	db "github.com/JaidenShall/simplebank/db/sqlc"
 	"github.com/JaidenShall/simplebank/mail"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
 	"github.com/hibiken/asynq"
 	"github.com/rs/zerolog/log"
 )
@@ -17,15 +19,35 @@ const (
 type TaskProcessor interface {
 	Start() error
 	ProcessTaskSendVerifyEmail(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReleaseExpiredHolds(ctx context.Context, task *asynq.Task) error
+	ProcessTaskRunScheduledTransfers(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendMonthlyStatements(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendStatement(ctx context.Context, task *asynq.Task) error
+	ProcessTaskReconcileBalances(ctx context.Context, task *asynq.Task) error
+	ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskCapitalizeInterest(ctx context.Context, task *asynq.Task) error
+	ProcessTaskExpirePaymentRequests(ctx context.Context, task *asynq.Task) error
+	ProcessTaskSendPaymentRequestEmail(ctx context.Context, task *asynq.Task) error
 }
 
 type RedisTaskProcessor struct {
+	config util.Config
 	server *asynq.Server
 	store  db.Store
 	mailer mail.EmailSender
+	// distributor fans the periodic tasks out into a task per item
+	distributor TaskDistributor
+	// riskEngine screens the runs of scheduled transfers, nil when no risk rule is enabled
+	riskEngine *risk.Engine
 }
 
-func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer mail.EmailSender) TaskProcessor {
+func NewRedisTaskProcessor(
+	config util.Config,
+	redisOpt asynq.RedisClientOpt,
+	store db.Store,
+	mailer mail.EmailSender,
+	distributor TaskDistributor,
+) TaskProcessor {
 	server := asynq.NewServer(
 		redisOpt,
 		asynq.Config{
@@ -41,10 +63,18 @@ func NewRedisTaskProcessor(redisOpt asynq.RedisClientOpt, store db.Store, mailer
 		},
 	)
 
+	// a run has no device and nobody picks the hour it happens at, so those rules don't apply
+	runConfig := config
+	runConfig.RiskNewDeviceAmount = 0
+	runConfig.RiskOddHoursAmount = 0
+
 	return &RedisTaskProcessor{
-		server: server,
-		store:  store,
-		mailer: mailer,
+		config:      config,
+		server:      server,
+		store:       store,
+		mailer:      mailer,
+		distributor: distributor,
+		riskEngine:  risk.NewEngineFromConfig(runConfig),
 	}
 }
 
@@ -52,6 +82,15 @@ func (processor *RedisTaskProcessor) Start() error {
 	mux := asynq.NewServeMux()
 
 	mux.HandleFunc(TaskSendVerifyEmail, processor.ProcessTaskSendVerifyEmail)
+	mux.HandleFunc(TaskReleaseExpiredHolds, processor.ProcessTaskReleaseExpiredHolds)
+	mux.HandleFunc(TaskRunScheduledTransfers, processor.ProcessTaskRunScheduledTransfers)
+	mux.HandleFunc(TaskSendMonthlyStatements, processor.ProcessTaskSendMonthlyStatements)
+	mux.HandleFunc(TaskSendStatement, processor.ProcessTaskSendStatement)
+	mux.HandleFunc(TaskReconcileBalances, processor.ProcessTaskReconcileBalances)
+	mux.HandleFunc(TaskAccrueInterest, processor.ProcessTaskAccrueInterest)
+	mux.HandleFunc(TaskCapitalizeInterest, processor.ProcessTaskCapitalizeInterest)
+	mux.HandleFunc(TaskExpirePaymentRequests, processor.ProcessTaskExpirePaymentRequests)
+	mux.HandleFunc(TaskSendPaymentRequestEmail, processor.ProcessTaskSendPaymentRequestEmail)
 
 	return processor.server.Start(mux)
 }
@@ -0,0 +1,95 @@
+package worker
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+// TaskScheduler enqueues the periodic tasks for the task processor
+type TaskScheduler interface {
+	Start() error
+}
+
+type RedisTaskScheduler struct {
+	config    util.Config
+	scheduler *asynq.Scheduler
+}
+
+func NewRedisTaskScheduler(config util.Config, redisOpt asynq.RedisClientOpt) TaskScheduler {
+	scheduler := asynq.NewScheduler(
+		redisOpt,
+		&asynq.SchedulerOpts{
+			EnqueueErrorHandler: func(task *asynq.Task, opts []asynq.Option, err error) {
+				log.Error().Err(err).Str("type", task.Type()).Msg("enqueue periodic task failed")
+			},
+			Logger: NewLogger(),
+		},
+	)
+
+	return &RedisTaskScheduler{
+		config:    config,
+		scheduler: scheduler,
+	}
+}
+
+func (scheduler *RedisTaskScheduler) Start() error {
+	err := scheduler.register(TaskReleaseExpiredHolds, scheduler.config.HoldReleaseInterval)
+	if err != nil {
+		return err
+	}
+
+	err = scheduler.register(TaskRunScheduledTransfers, scheduler.config.ScheduledTransferInterval)
+	if err != nil {
+		return err
+	}
+
+	err = scheduler.register(TaskExpirePaymentRequests, scheduler.config.PaymentRequestExpiryInterval)
+	if err != nil {
+		return err
+	}
+
+	// the statements of a month are sent once, and a run takes far less than a month
+	err = scheduler.registerCron(TaskSendMonthlyStatements, scheduler.config.StatementEmailSchedule, 24*time.Hour)
+	if err != nil {
+		return err
+	}
+
+	// a second run within the hour would only reconcile the same day again
+	err = scheduler.registerCron(TaskReconcileBalances, scheduler.config.ReconciliationSchedule, time.Hour)
+	if err != nil {
+		return err
+	}
+
+	// interest accrues after the reconciliation, capitalizing at the start of a month
+	err = scheduler.registerCron(TaskCapitalizeInterest, scheduler.config.InterestCapitalizeSchedule, 24*time.Hour)
+	if err != nil {
+		return err
+	}
+
+	return scheduler.scheduler.Start()
+}
+
+// register enqueues a task without payload every interval
+func (scheduler *RedisTaskScheduler) register(taskType string, interval time.Duration) error {
+	// a run that is still going makes the next one redundant
+	return scheduler.registerCron(taskType, fmt.Sprintf("@every %s", interval), interval)
+}
+
+// registerCron enqueues a task without payload on the cron spec. The task is not enqueued
+// again while a previous one is pending for less than unique.
+func (scheduler *RedisTaskScheduler) registerCron(taskType string, cronspec string, unique time.Duration) error {
+	_, err := scheduler.scheduler.Register(
+		cronspec,
+		asynq.NewTask(taskType, nil),
+		asynq.Queue(QueueDefault),
+		asynq.Unique(unique),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to register task %s: %w", taskType, err)
+	}
+	return nil
+}
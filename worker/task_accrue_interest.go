@@ -0,0 +1,113 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskAccrueInterest     = "task:accrue_interest"
+	TaskCapitalizeInterest = "task:capitalize_interest"
+)
+
+// capitalizeInterestBatchSize is how many accounts are fetched at a time
+const capitalizeInterestBatchSize = 100
+
+type PayloadAccrueInterest struct {
+	Date time.Time `json:"date"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskAccrueInterest(
+	ctx context.Context,
+	payload *PayloadAccrueInterest,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskAccrueInterest, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskAccrueInterest accrues the interest of the savings accounts for the day of the payload.
+// It is enqueued by the reconciliation once the balance snapshots of the day are written.
+func (processor *RedisTaskProcessor) ProcessTaskAccrueInterest(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadAccrueInterest
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	result, err := processor.store.AccrueInterest(ctx, db.AccrueInterestParams{
+		Date: payload.Date,
+		Rate: processor.config.SavingsInterestRateBPS,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to accrue interest: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Time("date", result.Date).Int("accounts", result.Accounts).
+		Int64("amount", result.Amount).Msg("processed task")
+	return nil
+}
+
+// ProcessTaskCapitalizeInterest credits every savings account with the interest it accrued
+// before the current UTC month. An account that fails is logged and the task is retried,
+// the accounts already credited have nothing left to capitalize.
+func (processor *RedisTaskProcessor) ProcessTaskCapitalizeInterest(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	before := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	capitalized, failed := 0, 0
+	var afterID int64
+	for {
+		accountIDs, err := processor.store.ListCapitalizableAccounts(ctx, db.ListCapitalizableAccountsParams{
+			Before:   before,
+			AfterID:  afterID,
+			PageSize: capitalizeInterestBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list capitalizable accounts: %w", err)
+		}
+
+		for _, accountID := range accountIDs {
+			_, err := processor.store.CapitalizeInterestTx(ctx, db.CapitalizeInterestTxParams{
+				AccountID: accountID,
+				Before:    before,
+			})
+			if err != nil {
+				log.Error().Err(err).Int64("account_id", accountID).Msg("failed to capitalize interest")
+				failed++
+				continue
+			}
+			capitalized++
+		}
+
+		if len(accountIDs) < capitalizeInterestBatchSize {
+			break
+		}
+		afterID = accountIDs[len(accountIDs)-1]
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to capitalize interest of %d accounts", failed)
+	}
+
+	log.Info().Str("type", task.Type()).Time("before", before).
+		Int("capitalized", capitalized).Msg("processed task")
+	return nil
+}
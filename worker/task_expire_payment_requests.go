@@ -0,0 +1,54 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskExpirePaymentRequests = "task:expire_payment_requests"
+
+// expirePaymentRequestsBatchSize is how many expired payment requests are fetched at a time
+const expirePaymentRequestsBatchSize = 100
+
+// ProcessTaskExpirePaymentRequests marks every pending payment request past its expiry as
+// expired and tells both users. Expired requests already can't be answered, this brings
+// their status in line so they stop showing up as pending.
+func (processor *RedisTaskProcessor) ProcessTaskExpirePaymentRequests(ctx context.Context, task *asynq.Task) error {
+	expired := 0
+	for {
+		requests, err := processor.store.ListExpiredPaymentRequests(ctx, expirePaymentRequestsBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list expired payment requests: %w", err)
+		}
+
+		for _, request := range requests {
+			_, err := processor.store.ExpirePaymentRequestTx(ctx, db.ClosePaymentRequestTxParams{
+				ID: request.ID,
+				AfterClose: func(request db.PaymentRequest) error {
+					DistributePaymentRequestEmails(ctx, processor.distributor, request)
+					return nil
+				},
+			})
+			if err != nil {
+				// answered by the payer in the meantime
+				if errors.Is(err, db.ErrPaymentRequestNotPending) {
+					continue
+				}
+				return fmt.Errorf("failed to expire payment request %d: %w", request.ID, err)
+			}
+			expired++
+		}
+
+		if len(requests) < expirePaymentRequestsBatchSize {
+			break
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int("expired", expired).Msg("processed task")
+	return nil
+}
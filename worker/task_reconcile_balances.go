@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskReconcileBalances = "task:reconcile_balances"
+
+// ProcessTaskReconcileBalances snapshots the balances at the end of the previous UTC day and
+// reconciles them against the entries, then enqueues the interest accrual on the snapshots.
+// Discrepancies are emailed to the reconciliation alert addresses. Running it again for the same
+// day replaces what was recorded, so a failed alert email is retried with the whole task.
+func (processor *RedisTaskProcessor) ProcessTaskReconcileBalances(ctx context.Context, task *asynq.Task) error {
+	result, err := processor.store.ReconcileBalances(ctx, db.ReconcileBalancesParams{
+		Date: time.Now().UTC().AddDate(0, 0, -1),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to reconcile balances: %w", err)
+	}
+
+	err = processor.distributor.DistributeTaskAccrueInterest(ctx, &PayloadAccrueInterest{Date: result.Date},
+		asynq.TaskID(fmt.Sprintf("interest:%s", result.Date.Format(time.DateOnly))),
+		asynq.Retention(24*time.Hour),
+		asynq.MaxRetry(10),
+		asynq.Queue(QueueDefault),
+	)
+	// a retry of this task finds the accrual already enqueued
+	if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+		return err
+	}
+
+	if len(result.Discrepancies) > 0 {
+		log.Error().Str("type", task.Type()).Time("date", result.Date).
+			Int("discrepancies", len(result.Discrepancies)).Msg("balance discrepancies found")
+
+		if len(processor.config.ReconciliationAlertEmails) > 0 {
+			subject, content := reconciliationAlert(result)
+			err = processor.mailer.SendEmail(subject, content, processor.config.ReconciliationAlertEmails, nil, nil, nil)
+			if err != nil {
+				return fmt.Errorf("failed to send reconciliation alert: %w", err)
+			}
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Time("date", result.Date).Int("accounts", result.Accounts).
+		Int("discrepancies", len(result.Discrepancies)).Msg("processed task")
+	return nil
+}
+
+// reconciliationAlert returns the subject and content of the email listing the discrepancies
+func reconciliationAlert(result db.ReconcileBalancesResult) (string, string) {
+	date := result.Date.Format("2006-01-02")
+	subject := fmt.Sprintf("Simple Bank reconciliation: %d discrepancies on %s", len(result.Discrepancies), date)
+
+	var content strings.Builder
+	fmt.Fprintf(&content, `Reconciliation of %d accounts at the end of %s found balances that don't match their entries.<br/>
+	<table>
+	<tr><th>Account</th><th>Balance</th><th>Entry sum</th><th>Difference</th></tr>
+	`, result.Accounts, date)
+	for _, discrepancy := range result.Discrepancies {
+		fmt.Fprintf(&content, "<tr><td>%d</td><td>%d</td><td>%d</td><td>%d</td></tr>\n\t",
+			discrepancy.AccountID, discrepancy.Balance, discrepancy.EntrySum, discrepancy.Balance-discrepancy.EntrySum)
+	}
+	content.WriteString("</table>\n")
+
+	return subject, content.String()
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskReleaseExpiredHolds = "task:release_expired_holds"
+
+// releaseExpiredHoldsBatchSize is how many expired holds are fetched at a time
+const releaseExpiredHoldsBatchSize = 100
+
+// ProcessTaskReleaseExpiredHolds settles every active hold past its expiry as expired.
+// Expired holds already stop counting against the available balance, this only
+// brings their status in line so they stop showing up as active.
+func (processor *RedisTaskProcessor) ProcessTaskReleaseExpiredHolds(ctx context.Context, task *asynq.Task) error {
+	released := 0
+	for {
+		holds, err := processor.store.ListExpiredHolds(ctx, releaseExpiredHoldsBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list expired holds: %w", err)
+		}
+
+		for _, hold := range holds {
+			_, err := processor.store.ReleaseHoldTx(ctx, db.ReleaseHoldTxParams{HoldID: hold.ID})
+			if err != nil {
+				// captured or released by its owner in the meantime
+				if errors.Is(err, db.ErrHoldNotActive) {
+					continue
+				}
+				return fmt.Errorf("failed to release hold %d: %w", hold.ID, err)
+			}
+			released++
+		}
+
+		if len(holds) < releaseExpiredHoldsBatchSize {
+			break
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int("released", released).Msg("processed task")
+	return nil
+}
@@ -0,0 +1,121 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/risk"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskRunScheduledTransfers = "task:run_scheduled_transfers"
+
+// runScheduledTransfersBatchSize is how many due scheduled transfers are fetched at a time
+const runScheduledTransfersBatchSize = 100
+
+// ProcessTaskRunScheduledTransfers runs every scheduled transfer that is due.
+// Insufficient funds, inactive accounts, the new payee limit and risk denials are recorded
+// on the run by RunScheduledTransferTx, so an error here means the schedule couldn't be run
+// at all. It is logged and the schedule is left due for the next task.
+func (processor *RedisTaskProcessor) ProcessTaskRunScheduledTransfers(ctx context.Context, task *asynq.Task) error {
+	ran, failed := 0, 0
+	for {
+		scheduledTransfers, err := processor.store.ListDueScheduledTransfers(ctx, runScheduledTransfersBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list due scheduled transfers: %w", err)
+		}
+
+		for _, scheduled := range scheduledTransfers {
+			_, err := processor.store.RunScheduledTransferTx(ctx, db.RunScheduledTransferTxParams{
+				ID:            scheduled.ID,
+				MaxRetries:    processor.config.ScheduledTransferMaxRetries,
+				RetryDelay:    processor.config.ScheduledTransferRetryDelay,
+				NewPayeeLimit: db.NewPayeeLimitFromConfig(processor.config),
+				Screen:        processor.screenScheduledTransfer(ctx),
+			})
+			if err != nil {
+				// paused, cancelled or run by another worker in the meantime
+				if errors.Is(err, db.ErrScheduledTransferNotDue) {
+					continue
+				}
+				log.Error().Err(err).Int64("scheduled_transfer_id", scheduled.ID).Msg("failed to run scheduled transfer")
+				failed++
+				continue
+			}
+			ran++
+		}
+
+		// failed ones stay due, fetching another batch would only return them again
+		if failed > 0 || len(scheduledTransfers) < runScheduledTransfersBatchSize {
+			break
+		}
+	}
+
+	log.Info().Str("type", task.Type()).Int("ran", ran).Int("failed", failed).Msg("processed task")
+	return nil
+}
+
+// screenScheduledTransfer returns the screen of the runs, nil when no risk rule is enabled.
+// It records the decision of a denied run and hands back the one of an allowed run,
+// which the transaction records with the transfer. Nobody is there to wait on a review
+// of a run, so a review denies it.
+func (processor *RedisTaskProcessor) screenScheduledTransfer(ctx context.Context) func(q db.Querier, scheduled db.ScheduledTransfer) (*db.CreateRiskDecisionParams, error) {
+	if processor.riskEngine == nil {
+		return nil
+	}
+
+	return func(q db.Querier, scheduled db.ScheduledTransfer) (*db.CreateRiskDecisionParams, error) {
+		fromAccount, err := q.GetAccount(ctx, scheduled.FromAccountID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get from account: %w", err)
+		}
+
+		op := risk.Operation{
+			Kind:        util.RiskTransfer,
+			Username:    scheduled.Owner,
+			AccountID:   scheduled.FromAccountID,
+			ToAccountID: scheduled.ToAccountID,
+			Amount:      scheduled.Amount,
+			Currency:    fromAccount.Currency,
+			Time:        time.Now(),
+		}
+		decision, err := processor.riskEngine.Evaluate(ctx, op, db.NewRiskHistory(q))
+		if err != nil {
+			return nil, fmt.Errorf("failed to evaluate risk rules: %w", err)
+		}
+		if decision.Action == util.RiskReview {
+			decision.Action = util.RiskDeny
+		}
+
+		arg := db.CreateRiskDecisionParams{
+			Username:    op.Username,
+			Operation:   op.Kind,
+			AccountID:   op.AccountID,
+			ToAccountID: sql.NullInt64{Int64: op.ToAccountID, Valid: true},
+			Amount:      op.Amount,
+			Currency:    op.Currency,
+			Action:      decision.Action,
+			Rules:       decision.Rules,
+			Request:     []byte("{}"),
+		}
+		if arg.Rules == nil {
+			arg.Rules = []string{}
+		}
+		if decision.Action != util.RiskDeny {
+			return &arg, nil
+		}
+
+		_, err = q.CreateRiskDecision(ctx, arg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record risk decision: %w", err)
+		}
+		return nil, fmt.Errorf("%w: rules %s", db.ErrRiskDenied, strings.Join(decision.Rules, ", "))
+	}
+}
@@ -0,0 +1,178 @@
+package worker
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"time"
+
+	"github.com/JaidenShall/simplebank/currency"
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const TaskSendPaymentRequestEmail = "task:send_payment_request_email"
+
+type PayloadSendPaymentRequestEmail struct {
+	PaymentRequestID int64 `json:"payment_request_id"`
+	// Status the request moved to, the email tells about that change
+	Status   string `json:"status"`
+	Username string `json:"username"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendPaymentRequestEmail(
+	ctx context.Context,
+	payload *PayloadSendPaymentRequestEmail,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendPaymentRequestEmail, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// DistributePaymentRequestEmails enqueues an email to both the requester and the payer
+// about the status the request just moved to. It is meant to run inside the transaction
+// changing the request, so the emails are delayed until it has committed.
+// The task id of each email is derived from the request, its status and the user,
+// so a status change is never told twice. An email that can't be enqueued is only logged,
+// so the change to the request, which may have moved money, still commits.
+func DistributePaymentRequestEmails(ctx context.Context, distributor TaskDistributor, request db.PaymentRequest) {
+	for _, username := range []string{request.Requester, request.Payer} {
+		err := distributor.DistributeTaskSendPaymentRequestEmail(ctx, &PayloadSendPaymentRequestEmail{
+			PaymentRequestID: request.ID,
+			Status:           request.Status,
+			Username:         username,
+		},
+			asynq.TaskID(fmt.Sprintf("payment_request:%d:%s:%s", request.ID, request.Status, username)),
+			asynq.ProcessIn(10*time.Second),
+			asynq.MaxRetry(10),
+			asynq.Queue(QueueDefault),
+		)
+		if err != nil && !errors.Is(err, asynq.ErrTaskIDConflict) {
+			log.Error().Err(err).
+				Int64("payment_request_id", request.ID).
+				Str("status", request.Status).
+				Str("username", username).
+				Msg("failed to enqueue payment request email")
+		}
+	}
+}
+
+// ProcessTaskSendPaymentRequestEmail tells the requester or the payer of a payment request
+// about its new status. Users who haven't verified their email address are skipped, and so
+// are changes that were rolled back.
+func (processor *RedisTaskProcessor) ProcessTaskSendPaymentRequestEmail(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendPaymentRequestEmail
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	request, err := processor.store.GetPaymentRequest(ctx, payload.PaymentRequestID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("payment request doesn't exist: %w", asynq.SkipRetry)
+		}
+		return fmt.Errorf("failed to get payment request: %w", err)
+	}
+
+	// a new request is told about even if it was answered since,
+	// any other status it no longer has was rolled back
+	if payload.Status != util.PaymentRequestPending && request.Status != payload.Status {
+		log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+			Str("status", request.Status).Msg("skipped task, status change rolled back")
+		return nil
+	}
+
+	user, err := processor.store.GetUser(ctx, payload.Username)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !user.IsEmailVerified {
+		log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+			Str("username", user.Username).Msg("skipped task, email not verified")
+		return nil
+	}
+
+	amount, err := currency.Format(request.Amount, request.Currency)
+	if err != nil {
+		return fmt.Errorf("failed to format amount: %w", asynq.SkipRetry)
+	}
+
+	subject, message, err := paymentRequestEmail(request, payload.Status, user.Username, amount)
+	if err != nil {
+		return fmt.Errorf("%s: %w", err, asynq.SkipRetry)
+	}
+	content := fmt.Sprintf(`Hello %s,<br/>
+	%s<br/>
+	`, user.FullName, message)
+	to := []string{user.Email}
+
+	err = processor.mailer.SendEmail(subject, content, to, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("failed to send payment request email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("email", user.Email).Msg("processed task")
+	return nil
+}
+
+// paymentRequestEmail writes the subject and message telling username about the status
+func paymentRequestEmail(request db.PaymentRequest, status string, username string, amount string) (string, string, error) {
+	isPayer := username == request.Payer
+	what := fmt.Sprintf("%s %s", amount, request.Currency)
+	if request.Memo != "" {
+		what = fmt.Sprintf("%s for &quot;%s&quot;", what, html.EscapeString(request.Memo))
+	}
+
+	switch status {
+	case util.PaymentRequestPending:
+		expiresAt := request.ExpiresAt.UTC().Format("2006-01-02 15:04 MST")
+		if isPayer {
+			return fmt.Sprintf("%s is asking you for money", request.Requester),
+				fmt.Sprintf("%s is asking you to pay %s. You can accept or decline the request until %s.",
+					request.Requester, what, expiresAt), nil
+		}
+		return "Your payment request was sent",
+			fmt.Sprintf("You asked %s to pay %s. The request expires on %s.", request.Payer, what, expiresAt), nil
+	case util.PaymentRequestAccepted:
+		if isPayer {
+			return "You paid a payment request",
+				fmt.Sprintf("You paid %s %s into account %d.", request.Requester, what, request.ToAccountID), nil
+		}
+		return fmt.Sprintf("%s paid your payment request", request.Payer),
+			fmt.Sprintf("%s paid you %s into account %d.", request.Payer, what, request.ToAccountID), nil
+	case util.PaymentRequestDeclined:
+		if isPayer {
+			return "You declined a payment request",
+				fmt.Sprintf("You declined the request of %s to pay %s.", request.Requester, what), nil
+		}
+		return fmt.Sprintf("%s declined your payment request", request.Payer),
+			fmt.Sprintf("%s declined your request to pay %s.", request.Payer, what), nil
+	case util.PaymentRequestExpired:
+		if isPayer {
+			return "A payment request expired",
+				fmt.Sprintf("The request of %s to pay %s expired without being paid.", request.Requester, what), nil
+		}
+		return "Your payment request expired",
+			fmt.Sprintf("Your request to %s to pay %s expired without being paid.", request.Payer, what), nil
+	}
+	return "", "", fmt.Errorf("unknown payment request status %s", status)
+}
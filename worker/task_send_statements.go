@@ -0,0 +1,177 @@
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	db "github.com/JaidenShall/simplebank/db/sqlc"
+	"github.com/JaidenShall/simplebank/statement"
+	"github.com/JaidenShall/simplebank/util"
+	"github.com/hibiken/asynq"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	TaskSendMonthlyStatements = "task:send_monthly_statements"
+	TaskSendStatement         = "task:send_statement"
+)
+
+// sendStatementsBatchSize is how many accounts are fetched at a time
+const sendStatementsBatchSize = 100
+
+type PayloadSendStatement struct {
+	AccountID int64     `json:"account_id"`
+	FromTime  time.Time `json:"from_time"`
+	ToTime    time.Time `json:"to_time"`
+}
+
+func (distributor *RedisTaskDistributor) DistributeTaskSendStatement(
+	ctx context.Context,
+	payload *PayloadSendStatement,
+	opts ...asynq.Option,
+) error {
+	jsonPayload, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal task payload: %w", err)
+	}
+
+	task := asynq.NewTask(TaskSendStatement, jsonPayload, opts...)
+	info, err := distributor.client.EnqueueContext(ctx, task)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue task: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("queue", info.Queue).Int("max_retry", info.MaxRetry).Msg("enqueued task")
+	return nil
+}
+
+// ProcessTaskSendMonthlyStatements enqueues a statement email for the previous calendar month,
+// in UTC, for every account that isn't closed. The task id of each email is derived from the
+// account and the month, so running this task again for the same month sends nothing twice.
+func (processor *RedisTaskProcessor) ProcessTaskSendMonthlyStatements(ctx context.Context, task *asynq.Task) error {
+	now := time.Now().UTC()
+	toTime := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	fromTime := toTime.AddDate(0, -1, 0)
+
+	enqueued := 0
+	var afterID int64
+	for {
+		accounts, err := processor.store.ListStatementAccounts(ctx, db.ListStatementAccountsParams{
+			AfterID:  afterID,
+			PageSize: sendStatementsBatchSize,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list statement accounts: %w", err)
+		}
+
+		for _, account := range accounts {
+			err := processor.distributor.DistributeTaskSendStatement(ctx, &PayloadSendStatement{
+				AccountID: account.ID,
+				FromTime:  fromTime,
+				ToTime:    toTime,
+			},
+				asynq.TaskID(fmt.Sprintf("statement:%d:%s", account.ID, fromTime.Format("2006-01"))),
+				asynq.Retention(24*time.Hour),
+				asynq.MaxRetry(10),
+				asynq.Queue(QueueDefault),
+			)
+			if err != nil {
+				// already enqueued by a previous run for this month
+				if errors.Is(err, asynq.ErrTaskIDConflict) {
+					continue
+				}
+				return err
+			}
+			enqueued++
+		}
+
+		if len(accounts) < sendStatementsBatchSize {
+			break
+		}
+		afterID = accounts[len(accounts)-1].ID
+	}
+
+	log.Info().Str("type", task.Type()).Time("from_time", fromTime).
+		Int("enqueued", enqueued).Msg("processed task")
+	return nil
+}
+
+// ProcessTaskSendStatement emails the statement of an account to its owner as a PDF attachment.
+// Owners who haven't verified their email address are skipped.
+func (processor *RedisTaskProcessor) ProcessTaskSendStatement(ctx context.Context, task *asynq.Task) error {
+	var payload PayloadSendStatement
+	if err := json.Unmarshal(task.Payload(), &payload); err != nil {
+		return fmt.Errorf("failed to unmarshal payload: %w", asynq.SkipRetry)
+	}
+
+	st, err := processor.store.GetStatement(ctx, db.GetStatementParams{
+		AccountID: payload.AccountID,
+		FromTime:  payload.FromTime,
+		ToTime:    payload.ToTime,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get statement: %w", err)
+	}
+
+	user, err := processor.store.GetUser(ctx, st.Account.Owner)
+	if err != nil {
+		return fmt.Errorf("failed to get user: %w", err)
+	}
+
+	if !user.IsEmailVerified {
+		log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+			Str("username", user.Username).Msg("skipped task, email not verified")
+		return nil
+	}
+
+	dir, err := os.MkdirTemp("", "statement")
+	if err != nil {
+		return fmt.Errorf("failed to create temp dir: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, statement.FileName(st, util.StatementPDF))
+	err = writeStatementFile(path, st)
+	if err != nil {
+		return err
+	}
+
+	// the statement covers [from, to), the last day shown is the one before to
+	period := fmt.Sprintf("%s to %s",
+		st.FromTime.Format("2006-01-02"), st.ToTime.AddDate(0, 0, -1).Format("2006-01-02"))
+	subject := fmt.Sprintf("Your Simple Bank statement for account %d", st.Account.ID)
+	content := fmt.Sprintf(`Hello %s,<br/>
+	Please find attached the statement of your account %d for %s.<br/>
+	`, user.FullName, st.Account.ID, period)
+	to := []string{user.Email}
+
+	err = processor.mailer.SendEmail(subject, content, to, nil, nil, []string{path})
+	if err != nil {
+		return fmt.Errorf("failed to send statement email: %w", err)
+	}
+
+	log.Info().Str("type", task.Type()).Bytes("payload", task.Payload()).
+		Str("email", user.Email).Msg("processed task")
+	return nil
+}
+
+func writeStatementFile(path string, st db.Statement) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create statement file: %w", err)
+	}
+
+	err = statement.Write(file, util.StatementPDF, st)
+	if err != nil {
+		file.Close()
+		return fmt.Errorf("failed to write statement: %w", err)
+	}
+
+	return file.Close()
+}